@@ -0,0 +1,70 @@
+package chain_selectors
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// AddChainParams is the parameter of the wallet_addEthereumChain method defined by EIP-3085,
+// JSON encoded the way wallets expect it.
+type AddChainParams struct {
+	// ChainID is the EVM chain id as a 0x prefixed hexadecimal string.
+	ChainID           string         `json:"chainId"`
+	ChainName         string         `json:"chainName"`
+	NativeCurrency    NativeCurrency `json:"nativeCurrency"`
+	RPCURLs           []string       `json:"rpcUrls"`
+	BlockExplorerURLs []string       `json:"blockExplorerUrls,omitempty"`
+	IconURLs          []string       `json:"iconUrls,omitempty"`
+}
+
+// BuildAddChainParams builds the wallet_addEthereumChain parameter of the EVM chain identified by
+// the selector from its metadata, so dapps can offer to add any known chain to a wallet. Only the
+// https RPCs and explorers are included, wallets refusing others, and the chain must have at
+// least one such RPC and a native currency.
+func BuildAddChainParams(selector uint64) (AddChainParams, error) {
+	info, err := getChainInfo(selector)
+	if err != nil {
+		return AddChainParams{}, err
+	}
+	if info.Family != FamilyEVM {
+		return AddChainParams{}, fmt.Errorf("chain %d is a %s chain, wallet_addEthereumChain only supports EVM chains", selector, info.Family)
+	}
+	chainID, err := strconv.ParseUint(info.ChainID, 10, 64)
+	if err != nil {
+		return AddChainParams{}, fmt.Errorf("invalid chain id %q of selector %d: %w", info.ChainID, selector, err)
+	}
+	metadata, err := GetChainMetadata(selector)
+	if err != nil {
+		return AddChainParams{}, err
+	}
+	name, err := DisplayName(selector)
+	if err != nil {
+		return AddChainParams{}, err
+	}
+
+	params := AddChainParams{
+		ChainID:           "0x" + strconv.FormatUint(chainID, 16),
+		ChainName:         name,
+		NativeCurrency:    metadata.NativeCurrency,
+		RPCURLs:           httpsURLs(metadata.RPCs),
+		BlockExplorerURLs: httpsURLs(metadata.Explorers),
+		IconURLs:          httpsURLs([]string{metadata.LogoURI}),
+	}
+	if len(params.RPCURLs) == 0 {
+		return AddChainParams{}, fmt.Errorf("chain %s has no https rpc", name)
+	}
+	if params.NativeCurrency.Symbol == "" {
+		return AddChainParams{}, fmt.Errorf("chain %s has no native currency", name)
+	}
+	return params, nil
+}
+
+func httpsURLs(urls []string) []string {
+	var filtered []string
+	for _, url := range urls {
+		if url != "" && validateURL(url) == nil {
+			filtered = append(filtered, url)
+		}
+	}
+	return filtered
+}
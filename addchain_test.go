@@ -0,0 +1,39 @@
+package chain_selectors
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildAddChainParams(t *testing.T) {
+	params, err := BuildAddChainParams(ETHEREUM_MAINNET_ARBITRUM_1.Selector)
+	require.NoError(t, err)
+
+	encoded, err := json.Marshal(params)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"chainId": "0xa4b1",
+		"chainName": "Arbitrum One",
+		"nativeCurrency": {"name": "Ether", "symbol": "ETH", "decimals": 18},
+		"rpcUrls": ["https://arb1.arbitrum.io/rpc"],
+		"blockExplorerUrls": ["https://arbiscan.io"]
+	}`, string(encoded))
+
+	tests := []struct {
+		name     string
+		selector uint64
+	}{
+		{name: "unknown chain", selector: 1},
+		{name: "non evm chain", selector: SOLANA_MAINNET.Selector},
+		{name: "chain without metadata", selector: ZORA_TESTNET.Selector},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := BuildAddChainParams(test.selector)
+			require.Error(t, err)
+		})
+	}
+}
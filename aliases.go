@@ -0,0 +1,34 @@
+package chain_selectors
+
+import (
+	_ "embed"
+
+	"gopkg.in/yaml.v3"
+)
+
+//go:embed aliases.yml
+var aliasesYml []byte
+
+var nameByAlias = onceValue(func() map[string]string { return parseAliasesYml(aliasesYml) })
+
+func parseAliasesYml(ymlFile []byte) map[string]string {
+	type ymlData struct {
+		Aliases map[string]string `yaml:"aliases"`
+	}
+
+	var data ymlData
+	err := yaml.Unmarshal(ymlFile, &data)
+	if err != nil {
+		panic(err)
+	}
+
+	return data.Aliases
+}
+
+// ResolveAlias returns the canonical chain name for an alias, or the name itself if it is not an alias.
+func ResolveAlias(name string) string {
+	if canonical, exist := nameByAlias()[name]; exist {
+		return canonical
+	}
+	return name
+}
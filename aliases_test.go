@@ -0,0 +1,23 @@
+package chain_selectors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAliasesResolveToKnownChains(t *testing.T) {
+	for alias, name := range nameByAlias() {
+		_, collides := evmChainsByName()[alias]
+		assert.False(t, collides, "alias %s collides with a canonical chain name", alias)
+
+		_, exists := evmChainsByName()[name]
+		assert.True(t, exists, "alias %s points to unknown chain %s", alias, name)
+	}
+}
+
+func Test_ResolveAlias(t *testing.T) {
+	assert.Equal(t, "ethereum-testnet-sepolia", ResolveAlias("sepolia"))
+	assert.Equal(t, "ethereum-mainnet", ResolveAlias("ethereum-mainnet"))
+	assert.Equal(t, "unknown", ResolveAlias("unknown"))
+}
@@ -0,0 +1,17 @@
+// Command hardcodedchain reports hardcoded chain ids and selectors of known EVM chains, run it
+// standalone or through go vet, -fix replaces them with the variables of the selectors package
+// where the file already imports it:
+//
+//	go install github.com/fravlaca/chain-selectors/analysis/cmd/hardcodedchain@latest
+//	hardcodedchain ./...
+package main
+
+import (
+	"golang.org/x/tools/go/analysis/singlechecker"
+
+	"github.com/fravlaca/chain-selectors/analysis/hardcodedchain"
+)
+
+func main() {
+	singlechecker.Main(hardcodedchain.Analyzer)
+}
@@ -0,0 +1,16 @@
+// Command selectorarith reports arithmetic and ordering comparisons on chain selectors, run it
+// standalone or through go vet:
+//
+//	go install github.com/fravlaca/chain-selectors/analysis/cmd/selectorarith@latest
+//	go vet -vettool=$(which selectorarith) ./...
+package main
+
+import (
+	"golang.org/x/tools/go/analysis/singlechecker"
+
+	"github.com/fravlaca/chain-selectors/analysis/selectorarith"
+)
+
+func main() {
+	singlechecker.Main(selectorarith.Analyzer)
+}
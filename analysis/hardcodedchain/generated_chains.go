@@ -0,0 +1,642 @@
+// Code generated by go generate please DO NOT EDIT
+package hardcodedchain
+
+// varNameByChainID maps the chain id of every EVM chain to the name of its variable.
+var varNameByChainID = map[uint64]string{
+	1:          "ETHEREUM_MAINNET",
+	10:         "ETHEREUM_MAINNET_OPTIMISM_1",
+	25:         "CRONOS_MAINNET",
+	30:         "ROOTSTOCK_MAINNET",
+	31:         "BITCOIN_TESTNET_ROOTSTOCK",
+	40:         "TELOS_EVM_MAINNET",
+	41:         "TELOS_EVM_TESTNET",
+	45:         "POLKADOT_TESTNET_DARWINIA_PANGORO",
+	46:         "POLKADOT_MAINNET_DARWINIA",
+	52:         "COINEX_SMART_CHAIN_MAINNET",
+	53:         "COINEX_SMART_CHAIN_TESTNET",
+	56:         "BINANCE_SMART_CHAIN_MAINNET",
+	81:         "POLKADOT_TESTNET_ASTAR_SHIBUYA",
+	97:         "BINANCE_SMART_CHAIN_TESTNET",
+	100:        "GNOSIS_CHAIN_MAINNET",
+	106:        "VELAS_MAINNET",
+	109:        "SHIBARIUM_MAINNET",
+	111:        "VELAS_TESTNET",
+	130:        "ETHEREUM_MAINNET_UNICHAIN_1",
+	133:        "ETHEREUM_TESTNET_SEPOLIA_HASHKEY_1",
+	137:        "POLYGON_MAINNET",
+	146:        "SONIC_MAINNET",
+	157:        "SHIBARIUM_TESTNET_PUPPYNET",
+	177:        "ETHEREUM_MAINNET_HASHKEY_1",
+	185:        "MINT_MAINNET",
+	195:        "ETHEREUM_TESTNET_SEPOLIA_XLAYER_1",
+	196:        "ETHEREUM_MAINNET_XLAYER_1",
+	199:        "BITTORRENT_CHAIN_MAINNET",
+	204:        "BINANCE_SMART_CHAIN_MAINNET_OPBNB_1",
+	223:        "BITCOIN_MAINNET_BSQUARED_1",
+	228:        "MIND_MAINNET",
+	232:        "LENS_MAINNET",
+	240:        "CRONOS_ZKEVM_TESTNET_SEPOLIA",
+	250:        "FANTOM_MAINNET",
+	252:        "FRAXTAL_MAINNET",
+	255:        "ETHEREUM_MAINNET_KROMA_1",
+	259:        "NEONLINK_MAINNET",
+	280:        "ETHEREUM_TESTNET_GOERLI_ZKSYNC_1",
+	282:        "CRONOS_TESTNET_ZKEVM_1",
+	295:        "HEDERA_MAINNET",
+	296:        "HEDERA_TESTNET",
+	300:        "ETHEREUM_TESTNET_SEPOLIA_ZKSYNC_1",
+	314:        "FILECOIN_MAINNET",
+	324:        "ETHEREUM_MAINNET_ZKSYNC_1",
+	338:        "CRONOS_TESTNET",
+	388:        "CRONOS_ZKEVM_MAINNET",
+	397:        "NEAR_MAINNET",
+	398:        "NEAR_TESTNET",
+	420:        "ETHEREUM_TESTNET_GOERLI_OPTIMISM_1",
+	462:        "AREON_TESTNET",
+	463:        "AREON_MAINNET",
+	480:        "ETHEREUM_MAINNET_WORLDCHAIN_1",
+	592:        "POLKADOT_MAINNET_ASTAR",
+	678:        "JANCTION_MAINNET",
+	679:        "JANCTION_TESTNET_SEPOLIA",
+	919:        "ETHEREUM_TESTNET_SEPOLIA_MODE_1",
+	998:        "HYPERLIQUID_TESTNET",
+	999:        "HYPERLIQUID_MAINNET",
+	1000:       "TEST_1000",
+	1029:       "BITTORRENT_CHAIN_TESTNET",
+	1030:       "CONFLUX_MAINNET",
+	1088:       "ETHEREUM_MAINNET_METIS_1",
+	1101:       "ETHEREUM_MAINNET_POLYGON_ZKEVM_1",
+	1111:       "WEMIX_MAINNET",
+	1112:       "WEMIX_TESTNET",
+	1114:       "CORE_TESTNET",
+	1116:       "CORE_MAINNET",
+	1123:       "BITCOIN_TESTNET_BSQUARED_1",
+	1135:       "LISK_MAINNET",
+	1284:       "POLKADOT_MAINNET_MOONBEAM",
+	1285:       "KUSAMA_MAINNET_MOONRIVER",
+	1287:       "POLKADOT_TESTNET_MOONBEAM_MOONBASE",
+	1301:       "ETHEREUM_TESTNET_SEPOLIA_UNICHAIN_1",
+	1328:       "SEI_TESTNET_ATLANTIC",
+	1329:       "SEI_MAINNET",
+	1337:       "GETH_TESTNET",
+	1338:       "TEST_1338",
+	1442:       "ETHEREUM_TESTNET_GOERLI_POLYGON_ZKEVM_1",
+	1513:       "STORY_TESTNET",
+	1687:       "MINT_TESTNET",
+	1740:       "METAL_TESTNET",
+	1750:       "METAL_MAINNET",
+	1868:       "SONEIUM_MAINNET",
+	1907:       "BITCICHAIN_MAINNET",
+	1908:       "BITCICHAIN_TESTNET",
+	1946:       "ETHEREUM_TESTNET_SEPOLIA_SONEIUM_1",
+	2020:       "RONIN_MAINNET",
+	2021:       "RONIN_TESTNET_SAIGON",
+	2023:       "PRIVATE_TESTNET_GRANITE",
+	2024:       "PRIVATE_TESTNET_ANDESITE",
+	2031:       "POLKADOT_MAINNET_CENTRIFUGE",
+	2088:       "POLKADOT_TESTNET_CENTRIFUGE_ALTAIR",
+	2221:       "KAVA_TESTNET",
+	2222:       "KAVA_MAINNET",
+	2337:       "GETH_DEVNET_2",
+	2358:       "ETHEREUM_TESTNET_SEPOLIA_KROMA_1",
+	2442:       "ETHEREUM_TESTNET_SEPOLIA_POLYGON_ZKEVM_1",
+	2522:       "ETHEREUM_TESTNET_HOLESKY_FRAXTAL_1",
+	2741:       "ABSTRACT_MAINNET",
+	2810:       "ETHEREUM_TESTNET_HOLESKY_MORPH_1",
+	2818:       "MORPH_MAINNET",
+	3337:       "GETH_DEVNET_3",
+	3636:       "BITCOIN_TESTNET_BOTANIX",
+	3637:       "BITCOIN_MAINNET_BOTANIX",
+	3776:       "ETHEREUM_MAINNET_ASTAR_ZKEVM_1",
+	4002:       "FANTOM_TESTNET",
+	4200:       "BITCOIN_MERLIN_MAINNET",
+	4202:       "ETHEREUM_TESTNET_SEPOLIA_LISK_1",
+	4801:       "ETHEREUM_TESTNET_SEPOLIA_WORLDCHAIN_1",
+	5000:       "ETHEREUM_MAINNET_MANTLE_1",
+	5001:       "ETHEREUM_TESTNET_GOERLI_MANTLE_1",
+	5003:       "ETHEREUM_TESTNET_SEPOLIA_MANTLE_1",
+	5330:       "SUPERSEED_MAINNET",
+	5611:       "BINANCE_SMART_CHAIN_TESTNET_OPBNB_1",
+	5668:       "NEXON_DEV",
+	6342:       "MEGAETH_TESTNET",
+	6900:       "NIBIRU_MAINNET",
+	6930:       "NIBIRU_TESTNET",
+	7000:       "ZETACHAIN_MAINNET",
+	8453:       "ETHEREUM_MAINNET_BASE_1",
+	9000:       "ONDO_TESTNET",
+	9559:       "NEONLINK_TESTNET",
+	10143:      "MONAD_TESTNET",
+	10200:      "GNOSIS_CHAIN_TESTNET_CHIADO",
+	11124:      "ABSTRACT_TESTNET",
+	12324:      "ETHEREUM_MAINNET_ARBITRUM_1_L3X_1",
+	12325:      "ETHEREUM_TESTNET_SEPOLIA_ARBITRUM_1_L3X_1",
+	13371:      "ETHEREUM_MAINNET_IMMUTABLE_ZKEVM_1",
+	13473:      "ETHEREUM_TESTNET_SEPOLIA_IMMUTABLE_ZKEVM_1",
+	16600:      "TEST_0G_TESTNET_NEWTON",
+	16601:      "TEST_0G_TESTNET_GALILEO",
+	17000:      "ETHEREUM_TESTNET_HOLESKY",
+	31337:      "ANVIL_DEVNET",
+	33111:      "APECHAIN_TESTNET_CURTIS",
+	33139:      "APECHAIN_MAINNET",
+	34443:      "ETHEREUM_MAINNET_MODE_1",
+	37111:      "ETHEREUM_TESTNET_SEPOLIA_LENS_1",
+	42161:      "ETHEREUM_MAINNET_ARBITRUM_1",
+	42220:      "CELO_MAINNET",
+	42793:      "ETHERLINK_MAINNET",
+	43111:      "HEMI_MAINNET",
+	43113:      "AVALANCHE_TESTNET_FUJI",
+	43114:      "AVALANCHE_MAINNET",
+	44787:      "CELO_TESTNET_ALFAJORES",
+	45439:      "PRIVATE_TESTNET_OPALA",
+	47763:      "NEOX_MAINNET",
+	48898:      "ZIRCUIT_TESTNET_GARFIELD",
+	48899:      "ETHEREUM_TESTNET_SEPOLIA_ZIRCUIT_1",
+	48900:      "ETHEREUM_MAINNET_ZIRCUIT_1",
+	53302:      "SUPERSEED_TESTNET",
+	57054:      "SONIC_TESTNET_BLAZE",
+	57073:      "ETHEREUM_MAINNET_INK_1",
+	59140:      "ETHEREUM_TESTNET_GOERLI_LINEA_1",
+	59141:      "ETHEREUM_TESTNET_SEPOLIA_LINEA_1",
+	59144:      "ETHEREUM_MAINNET_LINEA_1",
+	59902:      "ETHEREUM_TESTNET_SEPOLIA_METIS_1",
+	60118:      "NEXON_MAINNET_LITH",
+	60808:      "BITCOIN_MAINNET_BOB_1",
+	61166:      "TREASURE_MAINNET",
+	68414:      "NEXON_MAINNET_HENESYS",
+	76578:      "TEST_76578",
+	80001:      "POLYGON_TESTNET_MUMBAI",
+	80002:      "POLYGON_TESTNET_AMOY",
+	80069:      "BERACHAIN_TESTNET_BEPOLIA",
+	80084:      "BERACHAIN_TESTNET_BARTIO",
+	80085:      "BERACHAIN_TESTNET_ARTIO",
+	80087:      "ZERO_G_TESTNET_GALILEO",
+	80094:      "BERACHAIN_MAINNET",
+	81457:      "ETHEREUM_MAINNET_BLAST_1",
+	84531:      "ETHEREUM_TESTNET_GOERLI_BASE_1",
+	84532:      "ETHEREUM_TESTNET_SEPOLIA_BASE_1",
+	98864:      "PLUME_DEVNET",
+	98865:      "TEST_98865",
+	98866:      "PLUME_MAINNET",
+	98867:      "PLUME_TESTNET_SEPOLIA",
+	128123:     "ETHERLINK_TESTNET",
+	129399:     "POLYGON_TESTNET_TATARA",
+	167000:     "ETHEREUM_MAINNET_TAIKO_1",
+	167009:     "ETHEREUM_TESTNET_HOLESKY_TAIKO_1",
+	192940:     "MIND_TESTNET",
+	200810:     "BITCOIN_TESTNET_BITLAYER_1",
+	200901:     "BITCOIN_MAINNET_BITLAYER_1",
+	421613:     "ETHEREUM_TESTNET_GOERLI_ARBITRUM_1",
+	421614:     "ETHEREUM_TESTNET_SEPOLIA_ARBITRUM_1",
+	424242:     "PRIVATE_TESTNET_MICA",
+	432201:     "AVALANCHE_SUBNET_DEXALOT_TESTNET",
+	432204:     "AVALANCHE_SUBNET_DEXALOT_MAINNET",
+	534351:     "ETHEREUM_TESTNET_SEPOLIA_SCROLL_1",
+	534352:     "ETHEREUM_MAINNET_SCROLL_1",
+	595581:     "AVALANCHE_TESTNET_NEXON",
+	686868:     "BITCOIN_TESTNET_MERLIN",
+	717160:     "ETHEREUM_TESTNET_SEPOLIA_POLYGON_VALIDIUM_1",
+	743111:     "HEMI_TESTNET_SEPOLIA",
+	747474:     "POLYGON_MAINNET_KATANA",
+	763373:     "INK_TESTNET_SEPOLIA",
+	807424:     "NEXON_QA",
+	808813:     "BITCOIN_TESTNET_SEPOLIA_BOB_1",
+	810180:     "ZKLINK_NOVA_MAINNET",
+	810181:     "ZKLINK_NOVA_TESTNET",
+	847799:     "NEXON_STAGE",
+	978657:     "ETHEREUM_TESTNET_SEPOLIA_ARBITRUM_1_TREASURE_1",
+	978658:     "TREASURE_TESTNET_TOPAZ",
+	978670:     "ETHEREUM_MAINNET_ARBITRUM_1_TREASURE_1",
+	7777777:    "ZORA_MAINNET",
+	11155111:   "ETHEREUM_TESTNET_SEPOLIA",
+	11155420:   "ETHEREUM_TESTNET_SEPOLIA_OPTIMISM_1",
+	12227332:   "NEOX_TESTNET_T4",
+	21000000:   "CORN_MAINNET",
+	21000001:   "ETHEREUM_TESTNET_SEPOLIA_CORN_1",
+	31415926:   "FILECOIN_TESTNET",
+	90000001:   "TEST_90000001",
+	90000002:   "TEST_90000002",
+	90000003:   "TEST_90000003",
+	90000004:   "TEST_90000004",
+	90000005:   "TEST_90000005",
+	90000006:   "TEST_90000006",
+	90000007:   "TEST_90000007",
+	90000008:   "TEST_90000008",
+	90000009:   "TEST_90000009",
+	90000010:   "TEST_90000010",
+	90000011:   "TEST_90000011",
+	90000012:   "TEST_90000012",
+	90000013:   "TEST_90000013",
+	90000014:   "TEST_90000014",
+	90000015:   "TEST_90000015",
+	90000016:   "TEST_90000016",
+	90000017:   "TEST_90000017",
+	90000018:   "TEST_90000018",
+	90000019:   "TEST_90000019",
+	90000020:   "TEST_90000020",
+	90000021:   "TEST_90000021",
+	90000022:   "TEST_90000022",
+	90000023:   "TEST_90000023",
+	90000024:   "TEST_90000024",
+	90000025:   "TEST_90000025",
+	90000026:   "TEST_90000026",
+	90000027:   "TEST_90000027",
+	90000028:   "TEST_90000028",
+	90000029:   "TEST_90000029",
+	90000030:   "TEST_90000030",
+	90000031:   "TEST_90000031",
+	90000032:   "TEST_90000032",
+	90000033:   "TEST_90000033",
+	90000034:   "TEST_90000034",
+	90000035:   "TEST_90000035",
+	90000036:   "TEST_90000036",
+	90000037:   "TEST_90000037",
+	90000038:   "TEST_90000038",
+	90000039:   "TEST_90000039",
+	90000040:   "TEST_90000040",
+	90000041:   "TEST_90000041",
+	90000042:   "TEST_90000042",
+	90000043:   "TEST_90000043",
+	90000044:   "TEST_90000044",
+	90000045:   "TEST_90000045",
+	90000046:   "TEST_90000046",
+	90000047:   "TEST_90000047",
+	90000048:   "TEST_90000048",
+	90000049:   "TEST_90000049",
+	90000050:   "TEST_90000050",
+	90000051:   "TEST_90000051",
+	90000052:   "TEST_90000052",
+	90000053:   "TEST_90000053",
+	90000054:   "TEST_90000054",
+	90000055:   "TEST_90000055",
+	90000056:   "TEST_90000056",
+	90000057:   "TEST_90000057",
+	90000058:   "TEST_90000058",
+	90000059:   "TEST_90000059",
+	90000060:   "TEST_90000060",
+	90000061:   "TEST_90000061",
+	90000062:   "TEST_90000062",
+	90000063:   "TEST_90000063",
+	90000064:   "TEST_90000064",
+	90000065:   "TEST_90000065",
+	90000066:   "TEST_90000066",
+	90000067:   "TEST_90000067",
+	90000068:   "TEST_90000068",
+	90000069:   "TEST_90000069",
+	90000070:   "TEST_90000070",
+	90000071:   "TEST_90000071",
+	90000072:   "TEST_90000072",
+	90000073:   "TEST_90000073",
+	90000074:   "TEST_90000074",
+	90000075:   "TEST_90000075",
+	90000076:   "TEST_90000076",
+	90000077:   "TEST_90000077",
+	90000078:   "TEST_90000078",
+	90000079:   "TEST_90000079",
+	90000080:   "TEST_90000080",
+	90000081:   "TEST_90000081",
+	90000082:   "TEST_90000082",
+	90000083:   "TEST_90000083",
+	90000084:   "TEST_90000084",
+	90000085:   "TEST_90000085",
+	90000086:   "TEST_90000086",
+	90000087:   "TEST_90000087",
+	90000088:   "TEST_90000088",
+	90000089:   "TEST_90000089",
+	90000090:   "TEST_90000090",
+	90000091:   "TEST_90000091",
+	90000092:   "TEST_90000092",
+	90000093:   "TEST_90000093",
+	90000094:   "TEST_90000094",
+	90000095:   "TEST_90000095",
+	90000096:   "TEST_90000096",
+	90000097:   "TEST_90000097",
+	90000098:   "TEST_90000098",
+	90000099:   "TEST_90000099",
+	90000100:   "TEST_90000100",
+	161221135:  "PLUME_TESTNET",
+	168587773:  "ETHEREUM_TESTNET_SEPOLIA_BLAST_1",
+	728126428:  "TRON_MAINNET_EVM",
+	999999999:  "ZORA_TESTNET",
+	2494104990: "TRON_TESTNET_SHASTA_EVM",
+	3448148188: "TRON_TESTNET_NILE_EVM",
+}
+
+// varNameBySelector maps the selector of every EVM chain to the name of its variable.
+var varNameBySelector = map[uint64]string{
+	5009297550715157269:  "ETHEREUM_MAINNET",
+	3734403246176062136:  "ETHEREUM_MAINNET_OPTIMISM_1",
+	1456215246176062136:  "CRONOS_MAINNET",
+	11964252391146578476: "ROOTSTOCK_MAINNET",
+	8953668971247136127:  "BITCOIN_TESTNET_ROOTSTOCK",
+	1477345371608778000:  "TELOS_EVM_MAINNET",
+	729797994450396300:   "TELOS_EVM_TESTNET",
+	4340886533089894000:  "POLKADOT_TESTNET_DARWINIA_PANGORO",
+	8866418665544333000:  "POLKADOT_MAINNET_DARWINIA",
+	1761333065194157300:  "COINEX_SMART_CHAIN_MAINNET",
+	8955032871639343000:  "COINEX_SMART_CHAIN_TESTNET",
+	11344663589394136015: "BINANCE_SMART_CHAIN_MAINNET",
+	6955638871347136141:  "POLKADOT_TESTNET_ASTAR_SHIBUYA",
+	13264668187771770619: "BINANCE_SMART_CHAIN_TESTNET",
+	465200170687744372:   "GNOSIS_CHAIN_MAINNET",
+	374210358663784372:   "VELAS_MAINNET",
+	3993510008929295315:  "SHIBARIUM_MAINNET",
+	572210378683744374:   "VELAS_TESTNET",
+	1923510103922296319:  "ETHEREUM_MAINNET_UNICHAIN_1",
+	4356164186791070119:  "ETHEREUM_TESTNET_SEPOLIA_HASHKEY_1",
+	4051577828743386545:  "POLYGON_MAINNET",
+	1673871237479749969:  "SONIC_MAINNET",
+	17833296867764334567: "SHIBARIUM_TESTNET_PUPPYNET",
+	7613811247471741961:  "ETHEREUM_MAINNET_HASHKEY_1",
+	17164792800244661392: "MINT_MAINNET",
+	2066098519157881736:  "ETHEREUM_TESTNET_SEPOLIA_XLAYER_1",
+	3016212468291539606:  "ETHEREUM_MAINNET_XLAYER_1",
+	3776006016387883143:  "BITTORRENT_CHAIN_MAINNET",
+	465944652040885897:   "BINANCE_SMART_CHAIN_MAINNET_OPBNB_1",
+	5406759801798337480:  "BITCOIN_MAINNET_BSQUARED_1",
+	11690709103138290329: "MIND_MAINNET",
+	5608378062013572713:  "LENS_MAINNET",
+	16487132492576884721: "CRONOS_ZKEVM_TESTNET_SEPOLIA",
+	3768048213127883732:  "FANTOM_MAINNET",
+	1462016016387883143:  "FRAXTAL_MAINNET",
+	3719320017875267166:  "ETHEREUM_MAINNET_KROMA_1",
+	8239338020728974000:  "NEONLINK_MAINNET",
+	6802309497652714138:  "ETHEREUM_TESTNET_GOERLI_ZKSYNC_1",
+	3842103497652714138:  "CRONOS_TESTNET_ZKEVM_1",
+	3229138320728879060:  "HEDERA_MAINNET",
+	222782988166878823:   "HEDERA_TESTNET",
+	6898391096552792247:  "ETHEREUM_TESTNET_SEPOLIA_ZKSYNC_1",
+	4561443241176882990:  "FILECOIN_MAINNET",
+	1562403441176082196:  "ETHEREUM_MAINNET_ZKSYNC_1",
+	2995292832068775165:  "CRONOS_TESTNET",
+	8788096068760390840:  "CRONOS_ZKEVM_MAINNET",
+	2039744413822257700:  "NEAR_MAINNET",
+	5061593697262339000:  "NEAR_TESTNET",
+	2664363617261496610:  "ETHEREUM_TESTNET_GOERLI_OPTIMISM_1",
+	7317911323415911000:  "AREON_TESTNET",
+	1939936305787790600:  "AREON_MAINNET",
+	2049429975587534727:  "ETHEREUM_MAINNET_WORLDCHAIN_1",
+	6422105447186081193:  "POLKADOT_MAINNET_ASTAR",
+	9107126442626377432:  "JANCTION_MAINNET",
+	5059197667603797935:  "JANCTION_TESTNET_SEPOLIA",
+	829525985033418733:   "ETHEREUM_TESTNET_SEPOLIA_MODE_1",
+	4286062357653186312:  "HYPERLIQUID_TESTNET",
+	2442541497099098535:  "HYPERLIQUID_MAINNET",
+	11787463284727550157: "TEST_1000",
+	4459371029167934217:  "BITTORRENT_CHAIN_TESTNET",
+	3358365939762719202:  "CONFLUX_MAINNET",
+	8805746078405598895:  "ETHEREUM_MAINNET_METIS_1",
+	4348158687435793198:  "ETHEREUM_MAINNET_POLYGON_ZKEVM_1",
+	5142893604156789321:  "WEMIX_MAINNET",
+	9284632837123596123:  "WEMIX_TESTNET",
+	4264732132125536123:  "CORE_TESTNET",
+	1224752112135636129:  "CORE_MAINNET",
+	1948510578179542068:  "BITCOIN_TESTNET_BSQUARED_1",
+	15293031020466096408: "LISK_MAINNET",
+	1252863800116739621:  "POLKADOT_MAINNET_MOONBEAM",
+	1355020143337428062:  "KUSAMA_MAINNET_MOONRIVER",
+	5361632739113536121:  "POLKADOT_TESTNET_MOONBEAM_MOONBASE",
+	14135854469784514356: "ETHEREUM_TESTNET_SEPOLIA_UNICHAIN_1",
+	1216300075444106652:  "SEI_TESTNET_ATLANTIC",
+	9027416829622342829:  "SEI_MAINNET",
+	3379446385462418246:  "GETH_TESTNET",
+	2181150070347029680:  "TEST_1338",
+	11059667695644972511: "ETHEREUM_TESTNET_GOERLI_POLYGON_ZKEVM_1",
+	4237030917318060427:  "STORY_TESTNET",
+	10749384167430721561: "MINT_TESTNET",
+	6286293440461807648:  "METAL_TESTNET",
+	13447077090413146373: "METAL_MAINNET",
+	12505351618335765396: "SONEIUM_MAINNET",
+	4874388048629246000:  "BITCICHAIN_MAINNET",
+	4888058894222120000:  "BITCICHAIN_TESTNET",
+	686603546605904534:   "ETHEREUM_TESTNET_SEPOLIA_SONEIUM_1",
+	6916147374840168594:  "RONIN_MAINNET",
+	13116810400804392105: "RONIN_TESTNET_SAIGON",
+	3260900564719373474:  "PRIVATE_TESTNET_GRANITE",
+	6915682381028791124:  "PRIVATE_TESTNET_ANDESITE",
+	8175830712062617656:  "POLKADOT_MAINNET_CENTRIFUGE",
+	2333097300889804761:  "POLKADOT_TESTNET_CENTRIFUGE_ALTAIR",
+	2110537777356199208:  "KAVA_TESTNET",
+	7550000543357438061:  "KAVA_MAINNET",
+	12922642891491394802: "GETH_DEVNET_2",
+	5990477251245693094:  "ETHEREUM_TESTNET_SEPOLIA_KROMA_1",
+	1654667687261492630:  "ETHEREUM_TESTNET_SEPOLIA_POLYGON_ZKEVM_1",
+	8901520481741771655:  "ETHEREUM_TESTNET_HOLESKY_FRAXTAL_1",
+	3577778157919314504:  "ABSTRACT_MAINNET",
+	8304510386741731151:  "ETHEREUM_TESTNET_HOLESKY_MORPH_1",
+	18164309074156128038: "MORPH_MAINNET",
+	4793464827907405086:  "GETH_DEVNET_3",
+	1467223411771711614:  "BITCOIN_TESTNET_BOTANIX",
+	4560701533377838164:  "BITCOIN_MAINNET_BOTANIX",
+	1540201334317828111:  "ETHEREUM_MAINNET_ASTAR_ZKEVM_1",
+	4905564228793744293:  "FANTOM_TESTNET",
+	241851231317828981:   "BITCOIN_MERLIN_MAINNET",
+	5298399861320400553:  "ETHEREUM_TESTNET_SEPOLIA_LISK_1",
+	5299555114858065850:  "ETHEREUM_TESTNET_SEPOLIA_WORLDCHAIN_1",
+	1556008542357238666:  "ETHEREUM_MAINNET_MANTLE_1",
+	4168263376276232250:  "ETHEREUM_TESTNET_GOERLI_MANTLE_1",
+	8236463271206331221:  "ETHEREUM_TESTNET_SEPOLIA_MANTLE_1",
+	470401360549526817:   "SUPERSEED_MAINNET",
+	13274425992935471758: "BINANCE_SMART_CHAIN_TESTNET_OPBNB_1",
+	8911150974185440581:  "NEXON_DEV",
+	2443239559770384419:  "MEGAETH_TESTNET",
+	17349189558768828726: "NIBIRU_MAINNET",
+	305104239123120457:   "NIBIRU_TESTNET",
+	10817664450262215148: "ZETACHAIN_MAINNET",
+	15971525489660198786: "ETHEREUM_MAINNET_BASE_1",
+	344208382356656551:   "ONDO_TESTNET",
+	1113014352258747600:  "NEONLINK_TESTNET",
+	2183018362218727504:  "MONAD_TESTNET",
+	8871595565390010547:  "GNOSIS_CHAIN_TESTNET_CHIADO",
+	16235373811196386733: "ABSTRACT_TESTNET",
+	3162193654116181371:  "ETHEREUM_MAINNET_ARBITRUM_1_L3X_1",
+	3486622437121596122:  "ETHEREUM_TESTNET_SEPOLIA_ARBITRUM_1_L3X_1",
+	1237925231416731909:  "ETHEREUM_MAINNET_IMMUTABLE_ZKEVM_1",
+	4526165231216331901:  "ETHEREUM_TESTNET_SEPOLIA_IMMUTABLE_ZKEVM_1",
+	16088006396410204581: "TEST_0G_TESTNET_NEWTON",
+	2131427466778448014:  "TEST_0G_TESTNET_GALILEO",
+	7717148896336251131:  "ETHEREUM_TESTNET_HOLESKY",
+	7759470850252068959:  "ANVIL_DEVNET",
+	9900119385908781505:  "APECHAIN_TESTNET_CURTIS",
+	14894068710063348487: "APECHAIN_MAINNET",
+	7264351850409363825:  "ETHEREUM_MAINNET_MODE_1",
+	6827576821754315911:  "ETHEREUM_TESTNET_SEPOLIA_LENS_1",
+	4949039107694359620:  "ETHEREUM_MAINNET_ARBITRUM_1",
+	1346049177634351622:  "CELO_MAINNET",
+	13624601974233774587: "ETHERLINK_MAINNET",
+	1804312132722180201:  "HEMI_MAINNET",
+	14767482510784806043: "AVALANCHE_TESTNET_FUJI",
+	6433500567565415381:  "AVALANCHE_MAINNET",
+	3552045678561919002:  "CELO_TESTNET_ALFAJORES",
+	8446413392851542429:  "PRIVATE_TESTNET_OPALA",
+	7222032299962346917:  "NEOX_MAINNET",
+	13781831279385219069: "ZIRCUIT_TESTNET_GARFIELD",
+	4562743618362911021:  "ETHEREUM_TESTNET_SEPOLIA_ZIRCUIT_1",
+	17198166215261833993: "ETHEREUM_MAINNET_ZIRCUIT_1",
+	13694007683517087973: "SUPERSEED_TESTNET",
+	3676871237479449268:  "SONIC_TESTNET_BLAZE",
+	3461204551265785888:  "ETHEREUM_MAINNET_INK_1",
+	1355246678561316402:  "ETHEREUM_TESTNET_GOERLI_LINEA_1",
+	5719461335882077547:  "ETHEREUM_TESTNET_SEPOLIA_LINEA_1",
+	4627098889531055414:  "ETHEREUM_MAINNET_LINEA_1",
+	3777822886988675105:  "ETHEREUM_TESTNET_SEPOLIA_METIS_1",
+	15758750456714168963: "NEXON_MAINNET_LITH",
+	3849287863852499584:  "BITCOIN_MAINNET_BOB_1",
+	5214452172935136222:  "TREASURE_MAINNET",
+	12657445206920369324: "NEXON_MAINNET_HENESYS",
+	781901677223027175:   "TEST_76578",
+	12532609583862916517: "POLYGON_TESTNET_MUMBAI",
+	16281711391670634445: "POLYGON_TESTNET_AMOY",
+	7728255861635209484:  "BERACHAIN_TESTNET_BEPOLIA",
+	8999465244383784164:  "BERACHAIN_TESTNET_BARTIO",
+	12336603543561911511: "BERACHAIN_TESTNET_ARTIO",
+	2285225387454015855:  "ZERO_G_TESTNET_GALILEO",
+	1294465214383781161:  "BERACHAIN_MAINNET",
+	4411394078118774322:  "ETHEREUM_MAINNET_BLAST_1",
+	5790810961207155433:  "ETHEREUM_TESTNET_GOERLI_BASE_1",
+	10344971235874465080: "ETHEREUM_TESTNET_SEPOLIA_BASE_1",
+	3743020999916460931:  "PLUME_DEVNET",
+	3208172210661564830:  "TEST_98865",
+	17912061998839310979: "PLUME_MAINNET",
+	13874588925447303949: "PLUME_TESTNET_SEPOLIA",
+	1910019406958449359:  "ETHERLINK_TESTNET",
+	9090863410735740267:  "POLYGON_TESTNET_TATARA",
+	16468599424800719238: "ETHEREUM_MAINNET_TAIKO_1",
+	7248756420937879088:  "ETHEREUM_TESTNET_HOLESKY_TAIKO_1",
+	7189150270347329685:  "MIND_TESTNET",
+	3789623672476206327:  "BITCOIN_TESTNET_BITLAYER_1",
+	7937294810946806131:  "BITCOIN_MAINNET_BITLAYER_1",
+	6101244977088475029:  "ETHEREUM_TESTNET_GOERLI_ARBITRUM_1",
+	3478487238524512106:  "ETHEREUM_TESTNET_SEPOLIA_ARBITRUM_1",
+	4489326297382772450:  "PRIVATE_TESTNET_MICA",
+	1458281248224512906:  "AVALANCHE_SUBNET_DEXALOT_TESTNET",
+	5463201557265485081:  "AVALANCHE_SUBNET_DEXALOT_MAINNET",
+	2279865765895943307:  "ETHEREUM_TESTNET_SEPOLIA_SCROLL_1",
+	13204309965629103672: "ETHEREUM_MAINNET_SCROLL_1",
+	7837562506228496256:  "AVALANCHE_TESTNET_NEXON",
+	5269261765892944301:  "BITCOIN_TESTNET_MERLIN",
+	4418231248214522936:  "ETHEREUM_TESTNET_SEPOLIA_POLYGON_VALIDIUM_1",
+	16126893759944359622: "HEMI_TESTNET_SEPOLIA",
+	2459028469735686113:  "POLYGON_MAINNET_KATANA",
+	9763904284804119144:  "INK_TESTNET_SEPOLIA",
+	14632960069656270105: "NEXON_QA",
+	5535534526963509396:  "BITCOIN_TESTNET_SEPOLIA_BOB_1",
+	4350319965322101699:  "ZKLINK_NOVA_MAINNET",
+	5837261596322416298:  "ZKLINK_NOVA_TESTNET",
+	5556806327594153475:  "NEXON_STAGE",
+	10443705513486043421: "ETHEREUM_TESTNET_SEPOLIA_ARBITRUM_1_TREASURE_1",
+	3676916124122457866:  "TREASURE_TESTNET_TOPAZ",
+	1010349088906777999:  "ETHEREUM_MAINNET_ARBITRUM_1_TREASURE_1",
+	3555797439612589184:  "ZORA_MAINNET",
+	16015286601757825753: "ETHEREUM_TESTNET_SEPOLIA",
+	5224473277236331295:  "ETHEREUM_TESTNET_SEPOLIA_OPTIMISM_1",
+	2217764097022649312:  "NEOX_TESTNET_T4",
+	9043146809313071210:  "CORN_MAINNET",
+	1467427327723633929:  "ETHEREUM_TESTNET_SEPOLIA_CORN_1",
+	7060342227814389000:  "FILECOIN_TESTNET",
+	909606746561742123:   "TEST_90000001",
+	5548718428018410741:  "TEST_90000002",
+	789068866484373046:   "TEST_90000003",
+	5721565186521185178:  "TEST_90000004",
+	964127714438319834:   "TEST_90000005",
+	8966794841936584464:  "TEST_90000006",
+	8412806778050735057:  "TEST_90000007",
+	4066443121807923198:  "TEST_90000008",
+	6747736380229414777:  "TEST_90000009",
+	8694984074292254623:  "TEST_90000010",
+	328334718812072308:   "TEST_90000011",
+	7715160997071429212:  "TEST_90000012",
+	3574539439524578558:  "TEST_90000013",
+	4543928599863227519:  "TEST_90000014",
+	6443235356619661032:  "TEST_90000015",
+	13087962012083037329: "TEST_90000016",
+	11985232338641871056: "TEST_90000017",
+	7777066535355430289:  "TEST_90000018",
+	1273605685587320666:  "TEST_90000019",
+	17810359353458878177: "TEST_90000020",
+	13648736134397881410: "TEST_90000021",
+	6742472197519042017:  "TEST_90000022",
+	16702426279731183946: "TEST_90000023",
+	16449698933146693970: "TEST_90000024",
+	5614341928911841614:  "TEST_90000025",
+	9932483170498916221:  "TEST_90000026",
+	9248511054298050610:  "TEST_90000027",
+	15733873364998401606: "TEST_90000028",
+	10199579733509604193: "TEST_90000029",
+	11754399446572002459: "TEST_90000030",
+	15804983202763665802: "TEST_90000031",
+	8794884152664322911:  "TEST_90000032",
+	7005880874640146484:  "TEST_90000033",
+	15998314635132476942: "TEST_90000034",
+	6676710761873615962:  "TEST_90000035",
+	13973515790491921010: "TEST_90000036",
+	12226902941055802385: "TEST_90000037",
+	10547673735879567911: "TEST_90000038",
+	2953028829530698683:  "TEST_90000039",
+	3740583887329090549:  "TEST_90000040",
+	4716670523656754658:  "TEST_90000041",
+	12965905455277595820: "TEST_90000042",
+	6448403805635971860:  "TEST_90000043",
+	176199025415897437:   "TEST_90000044",
+	17251043223284625647: "TEST_90000045",
+	14943531413383612703: "TEST_90000046",
+	8015762103567576333:  "TEST_90000047",
+	2783890746839497525:  "TEST_90000048",
+	16591966440843528322: "TEST_90000049",
+	9156614022853705708:  "TEST_90000050",
+	10089241509396411113: "TEST_90000051",
+	7585715102059681757:  "TEST_90000052",
+	9574369650680012313:  "TEST_90000053",
+	15767478222558315144: "TEST_90000054",
+	928756709184343973:   "TEST_90000055",
+	13936493323944617843: "TEST_90000056",
+	9264503539336248559:  "TEST_90000057",
+	7032045258883126022:  "TEST_90000058",
+	13781595843667691007: "TEST_90000059",
+	6751512843227450641:  "TEST_90000060",
+	12027427861168955422: "TEST_90000061",
+	6690738652320128159:  "TEST_90000062",
+	12513826466599144030: "TEST_90000063",
+	7823363553221722351:  "TEST_90000064",
+	17759418850483131633: "TEST_90000065",
+	1488785539820432596:  "TEST_90000066",
+	12470167056735102403: "TEST_90000067",
+	6059917085984771915:  "TEST_90000068",
+	8698844633699288298:  "TEST_90000069",
+	11335955773964346155: "TEST_90000070",
+	15210860601736105873: "TEST_90000071",
+	15447447865219782832: "TEST_90000072",
+	7404045285477377670:  "TEST_90000073",
+	14506622911400094011: "TEST_90000074",
+	18316006852148771137: "TEST_90000075",
+	7961714422080771198:  "TEST_90000076",
+	15168140751097121912: "TEST_90000077",
+	8354317460459584308:  "TEST_90000078",
+	1974710175227680991:  "TEST_90000079",
+	15896959195233368219: "TEST_90000080",
+	13819071330241498802: "TEST_90000081",
+	3632230855428784129:  "TEST_90000082",
+	3330151784927722907:  "TEST_90000083",
+	973671184102733124:   "TEST_90000084",
+	7353384334508842175:  "TEST_90000085",
+	4174149892778961910:  "TEST_90000086",
+	10497629267361915835: "TEST_90000087",
+	10537986502862404866: "TEST_90000088",
+	10106333385848939617: "TEST_90000089",
+	2509173735760116798:  "TEST_90000090",
+	12499149790922928210: "TEST_90000091",
+	665284410079532457:   "TEST_90000092",
+	17514102371649734225: "TEST_90000093",
+	8211981504472319767:  "TEST_90000094",
+	15945074456050759193: "TEST_90000095",
+	17580537314894454709: "TEST_90000096",
+	13443138560923813712: "TEST_90000097",
+	9675086780529785020:  "TEST_90000098",
+	7431973150957944526:  "TEST_90000099",
+	6875898693582952601:  "TEST_90000100",
+	14684575664602284776: "PLUME_TESTNET",
+	2027362563942762617:  "ETHEREUM_TESTNET_SEPOLIA_BLAST_1",
+	1546563616611573946:  "TRON_MAINNET_EVM",
+	16244020411108056671: "ZORA_TESTNET",
+	13231703482326770598: "TRON_TESTNET_SHASTA_EVM",
+	2052925811360307749:  "TRON_TESTNET_NILE_EVM",
+}
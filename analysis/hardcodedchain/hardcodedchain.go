@@ -0,0 +1,212 @@
+// Package hardcodedchain defines an analyzer reporting integer literals that are the chain id
+// or the selector of a known EVM chain, suggesting the variable of the selectors package to use
+// instead, e.g. chainselectors.ETHEREUM_MAINNET_ARBITRUM_1.EvmChainID for 42161.
+//
+// Selectors are 64-bit hashes and always reported. Chain ids are small numbers that also appear
+// for unrelated reasons, so they are only reported from the -min-chain-id value up, or when the
+// literal is used as a chain id: assigned to, compared with or passed as something whose name
+// mentions a chain.
+package hardcodedchain
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+// selectorsPackage is the import path of the package defining the chain variables.
+const selectorsPackage = "github.com/fravlaca/chain-selectors"
+
+// DefaultMinChainID is the smallest chain id reported regardless of how it is used.
+const DefaultMinChainID = 1000
+
+var Analyzer = &analysis.Analyzer{
+	Name:     "hardcodedchain",
+	Doc:      "report hardcoded chain ids and selectors of known EVM chains",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+var minChainID uint64
+
+func init() {
+	Analyzer.Flags.Uint64Var(&minChainID, "min-chain-id", DefaultMinChainID,
+		"smallest chain id reported when the literal is not used as a chain id")
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	if pass.Pkg.Path() == selectorsPackage {
+		return nil, nil
+	}
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+
+	nodeFilter := []ast.Node{
+		(*ast.File)(nil),
+		(*ast.BasicLit)(nil),
+	}
+	var (
+		generated   bool
+		packageName string
+	)
+	inspect.WithStack(nodeFilter, func(n ast.Node, push bool, stack []ast.Node) bool {
+		if !push {
+			return true
+		}
+		switch n := n.(type) {
+		case *ast.File:
+			generated = ast.IsGenerated(n)
+			packageName = importName(n)
+			return !generated
+		case *ast.BasicLit:
+			if n.Kind == token.INT {
+				check(pass, n, stack, packageName)
+			}
+		}
+		return true
+	})
+	return nil, nil
+}
+
+func check(pass *analysis.Pass, lit *ast.BasicLit, stack []ast.Node, packageName string) {
+	value, ok := literalValue(pass, lit)
+	if !ok {
+		return
+	}
+
+	var kind, varName, field string
+	if name, exists := varNameBySelector[value]; exists {
+		kind, varName, field = "selector", name, "Selector"
+	} else if name, exists := varNameByChainID[value]; exists && (value >= minChainID || usedAsChain(pass, stack)) {
+		kind, varName, field = "chain id", name, "EvmChainID"
+	} else {
+		return
+	}
+
+	qualifier := packageName
+	if qualifier == "" {
+		qualifier = "chainselectors"
+	}
+	replacement := qualifier + "." + varName + "." + field
+	diagnostic := analysis.Diagnostic{
+		Pos:     lit.Pos(),
+		End:     lit.End(),
+		Message: "hardcoded " + kind + " " + lit.Value + ", use " + replacement,
+	}
+	if packageName != "" && isUint64(pass.TypesInfo.TypeOf(lit)) {
+		diagnostic.SuggestedFixes = []analysis.SuggestedFix{{
+			Message:   "Replace with " + replacement,
+			TextEdits: []analysis.TextEdit{{Pos: lit.Pos(), End: lit.End(), NewText: []byte(replacement)}},
+		}}
+	}
+	pass.Report(diagnostic)
+}
+
+func literalValue(pass *analysis.Pass, lit *ast.BasicLit) (uint64, bool) {
+	if tv, exists := pass.TypesInfo.Types[lit]; exists && tv.Value != nil {
+		return constant.Uint64Val(constant.ToInt(tv.Value))
+	}
+	value, err := strconv.ParseUint(lit.Value, 0, 64)
+	return value, err == nil
+}
+
+// usedAsChain reports whether the literal, last on the stack, is assigned to, compared with or
+// passed as something whose name mentions a chain.
+func usedAsChain(pass *analysis.Pass, stack []ast.Node) bool {
+	if len(stack) < 2 {
+		return false
+	}
+	lit := stack[len(stack)-1]
+	switch parent := stack[len(stack)-2].(type) {
+	case *ast.BinaryExpr:
+		return mentionsChain(parent.X) || mentionsChain(parent.Y)
+	case *ast.KeyValueExpr:
+		return parent.Value == lit && mentionsChain(parent.Key)
+	case *ast.AssignStmt:
+		for i, rhs := range parent.Rhs {
+			if rhs == lit && i < len(parent.Lhs) {
+				return mentionsChain(parent.Lhs[i])
+			}
+		}
+	case *ast.ValueSpec:
+		for _, name := range parent.Names {
+			if mentionsChain(name) {
+				return true
+			}
+		}
+	case *ast.CaseClause:
+		if len(stack) >= 4 {
+			if sw, ok := stack[len(stack)-4].(*ast.SwitchStmt); ok && sw.Tag != nil {
+				return mentionsChain(sw.Tag)
+			}
+		}
+	case *ast.CallExpr:
+		return mentionsChain(parent.Fun) || passedAsChain(pass, parent, lit)
+	}
+	return false
+}
+
+// passedAsChain reports whether the argument is passed as a parameter whose name mentions a chain.
+func passedAsChain(pass *analysis.Pass, call *ast.CallExpr, arg ast.Node) bool {
+	signature, ok := pass.TypesInfo.TypeOf(call.Fun).(*types.Signature)
+	if !ok {
+		return false
+	}
+	params := signature.Params()
+	for i, a := range call.Args {
+		if a != arg || params.Len() == 0 {
+			continue
+		}
+		if i >= params.Len() {
+			i = params.Len() - 1
+		}
+		return strings.Contains(strings.ToLower(params.At(i).Name()), "chain")
+	}
+	return false
+}
+
+func mentionsChain(expr ast.Expr) bool {
+	var name string
+	switch e := expr.(type) {
+	case *ast.Ident:
+		name = e.Name
+	case *ast.SelectorExpr:
+		name = e.Sel.Name
+	case *ast.CallExpr:
+		return mentionsChain(e.Fun)
+	case *ast.IndexExpr:
+		return mentionsChain(e.X)
+	default:
+		return false
+	}
+	return strings.Contains(strings.ToLower(name), "chain")
+}
+
+// importName returns the name the file imports the selectors package under, empty if it does
+// not import it.
+func importName(file *ast.File) string {
+	for _, spec := range file.Imports {
+		path, err := strconv.Unquote(spec.Path.Value)
+		if err != nil || path != selectorsPackage {
+			continue
+		}
+		if spec.Name != nil {
+			if spec.Name.Name == "_" || spec.Name.Name == "." {
+				return ""
+			}
+			return spec.Name.Name
+		}
+		return "chain_selectors"
+	}
+	return ""
+}
+
+func isUint64(t types.Type) bool {
+	return t != nil && types.Identical(t, types.Typ[types.Uint64])
+}
@@ -0,0 +1,17 @@
+package hardcodedchain_test
+
+import (
+	"testing"
+
+	"golang.org/x/tools/go/analysis/analysistest"
+
+	"github.com/fravlaca/chain-selectors/analysis/hardcodedchain"
+)
+
+func TestAnalyzer(t *testing.T) {
+	analysistest.Run(t, analysistest.TestData(), hardcodedchain.Analyzer, "a")
+}
+
+func TestAnalyzerSuggestedFixes(t *testing.T) {
+	analysistest.RunWithSuggestedFixes(t, analysistest.TestData(), hardcodedchain.Analyzer, "b")
+}
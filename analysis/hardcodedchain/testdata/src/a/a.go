@@ -0,0 +1,25 @@
+package a
+
+func chainName(chainID uint64) string { return "" }
+
+func deploy(id int, chain uint64) {}
+
+type config struct {
+	ChainID uint64
+	Port    int
+}
+
+func hardcoded(chainID uint64) {
+	_ = chainID == 42161            // want `hardcoded chain id 42161, use chainselectors.ETHEREUM_MAINNET_ARBITRUM_1.EvmChainID`
+	_ = uint64(4949039107694359620) // want `hardcoded selector 4949039107694359620, use chainselectors.ETHEREUM_MAINNET_ARBITRUM_1.Selector`
+	_ = config{ChainID: 1, Port: 1} // want `hardcoded chain id 1, use chainselectors.ETHEREUM_MAINNET.EvmChainID`
+	_ = chainName(137)              // want `hardcoded chain id 137, use chainselectors.POLYGON_MAINNET.EvmChainID`
+	deploy(56, 56)                  // want `hardcoded chain id 56, use chainselectors.BINANCE_SMART_CHAIN_MAINNET.EvmChainID`
+	switch chainID {
+	case 10: // want `hardcoded chain id 10, use chainselectors.ETHEREUM_MAINNET_OPTIMISM_1.EvmChainID`
+	}
+
+	timeout := 10
+	_ = timeout * 56
+	_ = make([]byte, 137)
+}
@@ -0,0 +1,15 @@
+package b
+
+import (
+	chainselectors "github.com/fravlaca/chain-selectors"
+)
+
+var arbitrum = chainselectors.ETHEREUM_MAINNET_ARBITRUM_1
+
+func isArbitrum(chainID uint64) bool {
+	return chainID == 42161 // want `hardcoded chain id 42161, use chainselectors.ETHEREUM_MAINNET_ARBITRUM_1.EvmChainID`
+}
+
+func port() int {
+	return 42161 // want `hardcoded chain id 42161`
+}
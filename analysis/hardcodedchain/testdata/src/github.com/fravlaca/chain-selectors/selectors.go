@@ -0,0 +1,8 @@
+package chain_selectors
+
+type Chain struct {
+	EvmChainID uint64
+	Selector   uint64
+}
+
+var ETHEREUM_MAINNET_ARBITRUM_1 = Chain{EvmChainID: 42161, Selector: 4949039107694359620}
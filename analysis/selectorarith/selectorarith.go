@@ -0,0 +1,87 @@
+// Package selectorarith defines an analyzer reporting arithmetic and ordering comparisons on
+// chain selectors. Selectors are identifiers derived from a hash, their numeric value means
+// nothing: adding to one does not give the selector of another chain, and sorting them by value
+// does not order the chains in any way. Chains should be ordered with CompareByName or
+// CompareByChainID instead.
+package selectorarith
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+// selectorsPackage is the import path of the package defining the Selector type.
+const selectorsPackage = "github.com/fravlaca/chain-selectors"
+
+var Analyzer = &analysis.Analyzer{
+	Name:     "selectorarith",
+	Doc:      "report arithmetic and ordering comparisons on chain selectors",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+
+	nodeFilter := []ast.Node{
+		(*ast.BinaryExpr)(nil),
+		(*ast.AssignStmt)(nil),
+		(*ast.IncDecStmt)(nil),
+	}
+	inspect.Preorder(nodeFilter, func(n ast.Node) {
+		switch n := n.(type) {
+		case *ast.BinaryExpr:
+			if !isSelector(pass, n.X) && !isSelector(pass, n.Y) {
+				return
+			}
+			switch {
+			case isOrdering(n.Op):
+				pass.Reportf(n.OpPos, "chain selectors are not ordinal, compare chains with CompareByName or CompareByChainID")
+			case isArithmetic(n.Op):
+				pass.Reportf(n.OpPos, "arithmetic on a chain selector does not give a meaningful selector")
+			}
+		case *ast.AssignStmt:
+			if n.Tok == token.ASSIGN || n.Tok == token.DEFINE || len(n.Lhs) != 1 || !isSelector(pass, n.Lhs[0]) {
+				return
+			}
+			pass.Reportf(n.TokPos, "arithmetic on a chain selector does not give a meaningful selector")
+		case *ast.IncDecStmt:
+			if isSelector(pass, n.X) {
+				pass.Reportf(n.TokPos, "arithmetic on a chain selector does not give a meaningful selector")
+			}
+		}
+	})
+	return nil, nil
+}
+
+// isSelector reports whether the expression is of the Selector type of the selectors package.
+func isSelector(pass *analysis.Pass, expr ast.Expr) bool {
+	named, ok := types.Unalias(pass.TypesInfo.TypeOf(expr)).(*types.Named)
+	if !ok {
+		return false
+	}
+	obj := named.Obj()
+	return obj.Pkg() != nil && obj.Pkg().Path() == selectorsPackage && obj.Name() == "Selector"
+}
+
+func isOrdering(op token.Token) bool {
+	switch op {
+	case token.LSS, token.LEQ, token.GTR, token.GEQ:
+		return true
+	}
+	return false
+}
+
+func isArithmetic(op token.Token) bool {
+	switch op {
+	case token.ADD, token.SUB, token.MUL, token.QUO, token.REM,
+		token.AND, token.OR, token.XOR, token.SHL, token.SHR, token.AND_NOT:
+		return true
+	}
+	return false
+}
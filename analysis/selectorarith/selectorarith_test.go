@@ -0,0 +1,13 @@
+package selectorarith_test
+
+import (
+	"testing"
+
+	"golang.org/x/tools/go/analysis/analysistest"
+
+	"github.com/fravlaca/chain-selectors/analysis/selectorarith"
+)
+
+func TestAnalyzer(t *testing.T) {
+	analysistest.Run(t, analysistest.TestData(), selectorarith.Analyzer, "a")
+}
@@ -0,0 +1,32 @@
+package a
+
+import (
+	"sort"
+
+	chainselectors "github.com/fravlaca/chain-selectors"
+)
+
+func misuse(a, b chainselectors.Selector, raw uint64) {
+	_ = a + 1       // want "arithmetic on a chain selector"
+	_ = b - a       // want "arithmetic on a chain selector"
+	_ = a < b       // want "chain selectors are not ordinal"
+	_ = a >= 10     // want "chain selectors are not ordinal"
+	a += 1          // want "arithmetic on a chain selector"
+	b++             // want "arithmetic on a chain selector"
+	_ = a ^ b       // want "arithmetic on a chain selector"
+	_ = raw < raw+1 // plain integers are not checked
+}
+
+func sorting(selectors []chainselectors.Selector) {
+	sort.Slice(selectors, func(i, j int) bool {
+		return selectors[i] < selectors[j] // want "chain selectors are not ordinal"
+	})
+	sort.Slice(selectors, func(i, j int) bool {
+		return chainselectors.CompareByName(selectors[i], selectors[j]) < 0
+	})
+}
+
+func fine(a, b chainselectors.Selector) bool {
+	a = b
+	return a == b || a != 0
+}
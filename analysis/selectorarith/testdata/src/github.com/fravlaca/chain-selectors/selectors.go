@@ -0,0 +1,5 @@
+package chain_selectors
+
+type Selector uint64
+
+func CompareByName(a, b Selector) int { return 0 }
@@ -1,28 +1,28 @@
 package chain_selectors
 
 import (
-	_ "embed"
 	"fmt"
 
 	"gopkg.in/yaml.v3"
+
+	"github.com/fravlaca/chain-selectors/aptos"
 )
 
 //go:generate go run genchains_aptos.go
 
-//go:embed selectors_aptos.yml
-var aptosSelectorsYml []byte
+var aptosSelectorsYml = aptos.SelectorsYAML()
 
 var (
-	aptosSelectorsMap     = parseAptosYml(aptosSelectorsYml)
-	aptosChainsBySelector = make(map[uint64]AptosChain)
+	aptosSelectorsMap     = onceValue(func() map[uint64]ChainDetails { return parseAptosYml(aptosSelectorsYml) })
+	aptosChainsBySelector = onceValue(func() map[uint64]AptosChain {
+		output := make(map[uint64]AptosChain, len(AptosALL))
+		for _, v := range AptosALL {
+			output[v.Selector] = v
+		}
+		return output
+	})
 )
 
-func init() {
-	for _, v := range AptosALL {
-		aptosChainsBySelector[v.Selector] = v
-	}
-}
-
 func parseAptosYml(ymlFile []byte) map[uint64]ChainDetails {
 	type ymlData struct {
 		SelectorsByAptosChainId map[uint64]ChainDetails `yaml:"selectors"`
@@ -43,17 +43,17 @@ func validateAptosChainID(data map[uint64]ChainDetails) {
 }
 
 func AptosChainIdToChainSelector() map[uint64]uint64 {
-	copyMap := make(map[uint64]uint64, len(aptosSelectorsMap))
-	for k, v := range aptosSelectorsMap {
+	copyMap := make(map[uint64]uint64, len(aptosSelectorsMap()))
+	for k, v := range aptosSelectorsMap() {
 		copyMap[k] = v.ChainSelector
 	}
 	return copyMap
 }
 
 func AptosNameFromChainId(chainId uint64) (string, error) {
-	details, exist := aptosSelectorsMap[chainId]
+	details, exist := aptosSelectorsMap()[chainId]
 	if !exist {
-		return "", fmt.Errorf("chain name not found for chain %v", chainId)
+		return "", notFoundError(InputChainID, FamilyAptos, fmt.Sprint(chainId))
 	}
 	if details.ChainName == "" {
 		return fmt.Sprint(chainId), nil
@@ -62,15 +62,15 @@ func AptosNameFromChainId(chainId uint64) (string, error) {
 }
 
 func AptosChainIdFromSelector(selector uint64) (uint64, error) {
-	chain, exist := aptosChainsBySelector[selector]
+	chain, exist := aptosChainsBySelector()[selector]
 	if !exist {
-		return 0, fmt.Errorf("chain id not found for selector %d", selector)
+		return 0, selectorNotFoundError(FamilyAptos, selector)
 	}
 
 	return chain.ChainID, nil
 }
 
 func AptosChainBySelector(selector uint64) (AptosChain, bool) {
-	chain, exist := aptosChainsBySelector[selector]
+	chain, exist := aptosChainsBySelector()[selector]
 	return chain, exist
 }
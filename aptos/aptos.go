@@ -0,0 +1,57 @@
+// Package aptos holds the dataset of the Aptos chains, selectors_aptos.yml, for programs
+// resolving Aptos chains only: importing it does not embed the datasets of the other families,
+// which the root chain_selectors package aggregates.
+package aptos
+
+import (
+	_ "embed"
+
+	"github.com/fravlaca/chain-selectors/internal/familydata"
+)
+
+//go:embed selectors_aptos.yml
+var selectorsYml []byte
+
+var index = familydata.Lazy[uint64](selectorsYml, nil)
+
+// Chain is a Aptos chain of the dataset.
+type Chain struct {
+	ChainID  uint64
+	Selector uint64
+	Name     string
+	// Test is always false, the Aptos family has no test chains.
+	Test bool
+}
+
+// Chains returns the chains of the dataset sorted by chain id.
+func Chains() []Chain {
+	chains := index().Chains
+	output := make([]Chain, len(chains))
+	for i, ch := range chains {
+		output[i] = Chain(ch)
+	}
+	return output
+}
+
+// BySelector returns the chain identified by the selector.
+func BySelector(selector uint64) (Chain, bool) {
+	ch, exists := index().BySelector[selector]
+	return Chain(ch), exists
+}
+
+// ByChainID returns the chain with the chain id.
+func ByChainID(chainID uint64) (Chain, bool) {
+	ch, exists := index().ByChainID[chainID]
+	return Chain(ch), exists
+}
+
+// ByName returns the chain with the name.
+func ByName(name string) (Chain, bool) {
+	ch, exists := index().ByName[name]
+	return Chain(ch), exists
+}
+
+// SelectorsYAML returns the embedded selectors_aptos.yml. The returned slice must not be modified.
+func SelectorsYAML() []byte {
+	return selectorsYml
+}
@@ -48,7 +48,7 @@ func Test_AptosYmlAreValid(t *testing.T) {
 }
 
 func Test_AptosChainSelectors(t *testing.T) {
-	for selector, chain := range aptosChainsBySelector {
+	for selector, chain := range aptosChainsBySelector() {
 		family, err := GetSelectorFamily(selector)
 		require.NoError(t, err,
 			"selector %v should be returned as aptos family, but received %v",
@@ -68,7 +68,7 @@ func Test_AptosChainSelectors(t *testing.T) {
 }
 
 func Test_AptosGetChainDetailsByChainIDAndFamily(t *testing.T) {
-	for k, v := range aptosSelectorsMap {
+	for k, v := range aptosSelectorsMap() {
 		details, err := GetChainDetailsByChainIDAndFamily(fmt.Sprint(k), FamilyAptos)
 		assert.NoError(t, err)
 		assert.Equal(t, v, details)
@@ -76,7 +76,7 @@ func Test_AptosGetChainDetailsByChainIDAndFamily(t *testing.T) {
 }
 
 func Test_AptosGetChainIDByChainSelector(t *testing.T) {
-	for k, v := range aptosSelectorsMap {
+	for k, v := range aptosSelectorsMap() {
 		chainID, err := GetChainIDFromSelector(v.ChainSelector)
 		assert.NoError(t, err)
 		assert.Equal(t, chainID, fmt.Sprintf("%v", k))
@@ -0,0 +1,77 @@
+package chain_selectors
+
+import (
+	_ "embed"
+	"fmt"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+)
+
+//go:embed assets.yml
+var assetsYml []byte
+
+// Token is a token deployed on a chain.
+type Token struct {
+	Symbol   string `yaml:"-"`
+	Address  string `yaml:"address"`
+	Decimals uint8  `yaml:"decimals"`
+}
+
+var tokensBySelector = onceValue(func() map[uint64]map[string]Token { return parseAssetsYml(assetsYml) })
+
+func parseAssetsYml(ymlFile []byte) map[uint64]map[string]Token {
+	type ymlData struct {
+		Assets map[uint64]map[string]Token `yaml:"assets"`
+	}
+
+	var data ymlData
+	err := yaml.Unmarshal(ymlFile, &data)
+	if err != nil {
+		panic(err)
+	}
+
+	for selector, tokens := range data.Assets {
+		for symbol, token := range tokens {
+			if symbol == "" {
+				panic(fmt.Errorf("token without symbol for selector %d", selector))
+			}
+			if !evmAddressFormat.MatchString(token.Address) {
+				panic(fmt.Errorf("invalid %s address %q for selector %d", symbol, token.Address, selector))
+			}
+			token.Symbol = symbol
+			tokens[symbol] = token
+		}
+	}
+	return data.Assets
+}
+
+// GetToken returns the token with the symbol on the chain identified by the selector. Symbols
+// are case sensitive.
+func GetToken(selector uint64, symbol string) (Token, error) {
+	token, exist := tokensBySelector()[selector][symbol]
+	if !exist {
+		return Token{}, fmt.Errorf("token %s not found for selector %d", symbol, selector)
+	}
+	return token, nil
+}
+
+// GetTokenAddress returns the address of the token with the symbol on the chain identified by
+// the selector.
+func GetTokenAddress(selector uint64, symbol string) (string, error) {
+	token, err := GetToken(selector, symbol)
+	if err != nil {
+		return "", err
+	}
+	return token.Address, nil
+}
+
+// Tokens returns the tokens known on the chain identified by the selector, sorted by symbol.
+func Tokens(selector uint64) []Token {
+	tokens := make([]Token, 0, len(tokensBySelector()[selector]))
+	for _, token := range tokensBySelector()[selector] {
+		tokens = append(tokens, token)
+	}
+	sort.Slice(tokens, func(i, j int) bool { return tokens[i].Symbol < tokens[j].Symbol })
+	return tokens
+}
@@ -0,0 +1,71 @@
+package chain_selectors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAssetSelectorsAreKnownChains(t *testing.T) {
+	for selector := range tokensBySelector() {
+		family, err := GetSelectorFamily(selector)
+		require.NoError(t, err, "assets.yml references unknown selector %d", selector)
+		assert.Equal(t, FamilyEVM, family, "assets.yml only holds EVM tokens")
+	}
+}
+
+func TestGetTokenAddress(t *testing.T) {
+	tests := []struct {
+		name      string
+		selector  uint64
+		symbol    string
+		address   string
+		expectErr bool
+	}{
+		{
+			name:     "usdc on base",
+			selector: ETHEREUM_MAINNET_BASE_1.Selector,
+			symbol:   "USDC",
+			address:  "0x833589fCD6eDb6E08f4c7C32D4f71b54bdA02913",
+		},
+		{
+			name:     "link on sepolia",
+			selector: ETHEREUM_TESTNET_SEPOLIA.Selector,
+			symbol:   "LINK",
+			address:  "0x779877A7B0D9E8603169DdbD7836e478b4624789",
+		},
+		{
+			name:      "symbols are case sensitive",
+			selector:  ETHEREUM_MAINNET_BASE_1.Selector,
+			symbol:    "usdc",
+			expectErr: true,
+		},
+		{
+			name:      "chain without tokens",
+			selector:  ZORA_TESTNET.Selector,
+			symbol:    "USDC",
+			expectErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			address, err := GetTokenAddress(test.selector, test.symbol)
+			if test.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.address, address)
+		})
+	}
+}
+
+func TestTokens(t *testing.T) {
+	assert.Equal(t, []Token{
+		{Symbol: "LINK", Address: "0x514910771AF9Ca656af840dff83E8264EcF986CA", Decimals: 18},
+		{Symbol: "USDC", Address: "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48", Decimals: 6},
+	}, Tokens(ETHEREUM_MAINNET.Selector))
+	assert.Empty(t, Tokens(ZORA_TESTNET.Selector))
+}
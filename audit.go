@@ -0,0 +1,26 @@
+package chain_selectors
+
+// ChainAudit records who requested a chain entry and who approved it. Both fields are empty
+// for entries added before approvals were recorded.
+type ChainAudit struct {
+	Owner      string
+	ApprovedBy string
+}
+
+// Approved reports whether the entry records an approval.
+func (a ChainAudit) Approved() bool {
+	return a.ApprovedBy != ""
+}
+
+// AuditInfo returns the ownership and approval recorded for the chain identified by the selector.
+func AuditInfo(selector uint64) (ChainAudit, error) {
+	details, err := GetChainDetailsBySelector(selector)
+	if err != nil {
+		return ChainAudit{}, err
+	}
+	return chainAudit(details), nil
+}
+
+func chainAudit(details ChainDetails) ChainAudit {
+	return ChainAudit{Owner: details.Owner, ApprovedBy: details.ApprovedBy}
+}
@@ -0,0 +1,179 @@
+package chain_selectors
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// AuditOperation names the kind of mutation an AuditRecord records.
+type AuditOperation string
+
+const (
+	// AuditOverrideLoad records a chain loaded into a Registry with LoadYAML.
+	AuditOverrideLoad AuditOperation = "override_load"
+	// AuditRemoteMerge records a chain loaded into a Registry by a RemoteSync.
+	AuditRemoteMerge AuditOperation = "remote_merge"
+	// AuditRemoteRemoval records a chain removed from a Registry by a delta, see ApplyDelta.
+	AuditRemoteRemoval AuditOperation = "remote_removal"
+	// AuditCustomRegistration records a chain registered with RegisterCustomChain or
+	// RegisterCustomChains.
+	AuditCustomRegistration AuditOperation = "custom_registration"
+)
+
+// AuditChain is the state of a chain recorded by an AuditRecord.
+type AuditChain struct {
+	Selector    uint64 `json:"selector"`
+	Name        string `json:"name"`
+	NetworkID   uint64 `json:"network_id,omitempty"`
+	GenesisHash string `json:"genesis_hash,omitempty"`
+	Owner       string `json:"owner,omitempty"`
+	ApprovedBy  string `json:"approved_by,omitempty"`
+}
+
+func auditChain(details ChainDetails) AuditChain {
+	return AuditChain{
+		Selector:    details.ChainSelector,
+		Name:        details.ChainName,
+		NetworkID:   details.NetworkID,
+		GenesisHash: details.GenesisHash,
+		Owner:       details.Owner,
+		ApprovedBy:  details.ApprovedBy,
+	}
+}
+
+// AuditRecord records the change of a single chain by a mutation. Mutations leaving a chain
+// unchanged, e.g. loading the same file twice, are not recorded.
+type AuditRecord struct {
+	Time      time.Time      `json:"time"`
+	Operation AuditOperation `json:"operation"`
+	Family    string         `json:"family"`
+	ChainID   string         `json:"chain_id"`
+	// Before is nil for chains the mutation added.
+	Before *AuditChain `json:"before"`
+	// After is empty for chains the mutation removed.
+	After AuditChain `json:"after"`
+	// DatasetVersion identifies the loaded file, it is empty for custom registrations.
+	DatasetVersion string `json:"dataset_version,omitempty"`
+}
+
+// AuditSink receives the records of the mutations, in the order they were applied.
+type AuditSink interface {
+	Audit(record AuditRecord) error
+}
+
+// AuditSinkFunc adapts a function to the AuditSink interface.
+type AuditSinkFunc func(record AuditRecord) error
+
+func (f AuditSinkFunc) Audit(record AuditRecord) error {
+	return f(record)
+}
+
+// jsonLinesAuditSink writes every record as a line of JSON.
+type jsonLinesAuditSink struct {
+	mu      sync.Mutex
+	encoder *json.Encoder
+}
+
+// NewJSONLinesAuditSink returns a sink writing every record to w as a line of JSON, e.g.
+// {"time":"...","operation":"override_load","family":"evm","chain_id":"77001","before":null,"after":{...}}.
+// It is safe for concurrent use.
+func NewJSONLinesAuditSink(w io.Writer) AuditSink {
+	return &jsonLinesAuditSink{encoder: json.NewEncoder(w)}
+}
+
+func (s *jsonLinesAuditSink) Audit(record AuditRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.encoder.Encode(record)
+}
+
+// WithAuditSink records every chain the registry loads, with LoadYAML or a RemoteSync, to the
+// sink. The sink is called while the load holds the registry, so records are in load order.
+func WithAuditSink(sink AuditSink) RegistryOption {
+	return func(r *Registry) {
+		r.audit = sink
+	}
+}
+
+// auditLoad records the chains a load changed, see diffStates.
+func (r *Registry) auditLoad(previous, next *registryState, source ProvenanceSource, provenance Provenance) error {
+	if r.audit == nil {
+		return nil
+	}
+	operation := AuditOverrideLoad
+	if source == ProvenanceRemote {
+		operation = AuditRemoteMerge
+	}
+
+	changes := diffStates(previous, next, source)
+	for _, ch := range append(changes.Added, changes.Updated...) {
+		record := AuditRecord{
+			Time:           provenance.LoadedAt,
+			Operation:      operation,
+			Family:         FamilyEVM,
+			ChainID:        strconv.FormatUint(ch.EvmChainID, 10),
+			After:          auditChain(next.evmDetails[ch.EvmChainID]),
+			DatasetVersion: provenance.DatasetVersion,
+		}
+		if before, existed := previous.evmDetails[ch.EvmChainID]; existed {
+			state := auditChain(before)
+			record.Before = &state
+		}
+		if err := r.audit.Audit(record); err != nil {
+			return fmt.Errorf("chains loaded but not audited: %w", err)
+		}
+	}
+	for _, ch := range changes.Removed {
+		before := auditChain(previous.evmDetails[ch.EvmChainID])
+		record := AuditRecord{
+			Time:           provenance.LoadedAt,
+			Operation:      AuditRemoteRemoval,
+			Family:         FamilyEVM,
+			ChainID:        strconv.FormatUint(ch.EvmChainID, 10),
+			Before:         &before,
+			DatasetVersion: provenance.DatasetVersion,
+		}
+		if err := r.audit.Audit(record); err != nil {
+			return fmt.Errorf("chains removed but not audited: %w", err)
+		}
+	}
+	return nil
+}
+
+var customChainAuditSink atomic.Pointer[AuditSink]
+
+// SetAuditSink records every custom chain registration of the process to the sink, nil stops
+// recording. RegisterCustomChain cannot report errors of the sink, RegisterCustomChains does.
+func SetAuditSink(sink AuditSink) {
+	if sink == nil {
+		customChainAuditSink.Store(nil)
+		return
+	}
+	customChainAuditSink.Store(&sink)
+}
+
+// auditCustomRegistration records the registration of the custom chain, previous is its name
+// before, if it was registered already.
+func auditCustomRegistration(chainID uint64, name, previous string, existed bool) error {
+	sink := customChainAuditSink.Load()
+	if sink == nil || (existed && previous == name) {
+		return nil
+	}
+	selector := generateCustomChainSelector(chainID)
+	record := AuditRecord{
+		Time:      time.Now(),
+		Operation: AuditCustomRegistration,
+		Family:    FamilyEVM,
+		ChainID:   strconv.FormatUint(chainID, 10),
+		After:     AuditChain{Selector: selector, Name: name},
+	}
+	if existed {
+		record.Before = &AuditChain{Selector: selector, Name: previous}
+	}
+	return (*sink).Audit(record)
+}
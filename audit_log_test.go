@@ -0,0 +1,82 @@
+package chain_selectors
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditSinkRecordsRegistryLoads(t *testing.T) {
+	var buf bytes.Buffer
+	registry := NewRegistry(WithAuditSink(NewJSONLinesAuditSink(&buf)))
+
+	require.NoError(t, registry.LoadYAML([]byte("selectors:\n  77001:\n    selector: 11\n    name: devnet-a\n")))
+	require.NoError(t, registry.LoadYAML([]byte("selectors:\n  77001:\n    selector: 11\n    name: devnet-a\n")))
+	require.NoError(t, registry.LoadYAML([]byte("selectors:\n  77001:\n    selector: 12\n    name: devnet-b\n")))
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 2, "loads leaving the chain unchanged are not recorded")
+
+	var added, replaced AuditRecord
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &added))
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &replaced))
+
+	assert.Equal(t, AuditOverrideLoad, added.Operation)
+	assert.Equal(t, FamilyEVM, added.Family)
+	assert.Equal(t, "77001", added.ChainID)
+	assert.Nil(t, added.Before)
+	assert.Equal(t, AuditChain{Selector: 11, Name: "devnet-a"}, added.After)
+	assert.False(t, added.Time.IsZero())
+
+	require.NotNil(t, replaced.Before)
+	assert.Equal(t, AuditChain{Selector: 11, Name: "devnet-a"}, *replaced.Before)
+	assert.Equal(t, AuditChain{Selector: 12, Name: "devnet-b"}, replaced.After)
+}
+
+func TestAuditSinkFailureIsReported(t *testing.T) {
+	sinkErr := errors.New("disk full")
+	registry := NewRegistry(WithAuditSink(AuditSinkFunc(func(AuditRecord) error { return sinkErr })))
+
+	err := registry.LoadYAML([]byte("selectors:\n  77001:\n    selector: 11\n    name: devnet-a\n"))
+	require.ErrorIs(t, err, sinkErr)
+
+	_, exists := registry.ChainBySelector(11)
+	assert.True(t, exists, "the chains are loaded even if the sink fails")
+}
+
+func TestAuditSinkRecordsCustomRegistrations(t *testing.T) {
+	restoreCustomChains(t)
+	previousSink := customChainAuditSink.Load()
+	t.Cleanup(func() { customChainAuditSink.Store(previousSink) })
+
+	var records []AuditRecord
+	SetAuditSink(AuditSinkFunc(func(record AuditRecord) error {
+		records = append(records, record)
+		return nil
+	}))
+
+	RegisterCustomChain(5500000301, "audited-devnet")
+	RegisterCustomChain(5500000301, "audited-devnet-renamed")
+	_, err := RegisterCustomChains([]CustomChainSpec{
+		{ChainID: 5500000302, Name: "audited-bulk"},
+		{ChainID: 5500000301, Name: "audited-devnet-renamed"},
+	})
+	require.NoError(t, err)
+
+	require.Len(t, records, 3)
+	for _, record := range records {
+		assert.Equal(t, AuditCustomRegistration, record.Operation)
+	}
+	assert.Nil(t, records[0].Before)
+	assert.Equal(t, "audited-devnet", records[0].After.Name)
+	require.NotNil(t, records[1].Before)
+	assert.Equal(t, "audited-devnet", records[1].Before.Name)
+	assert.Equal(t, "audited-devnet-renamed", records[1].After.Name)
+	assert.Equal(t, "5500000302", records[2].ChainID)
+	assert.Equal(t, generateCustomChainSelector(5500000302), records[2].After.Selector)
+}
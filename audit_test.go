@@ -0,0 +1,68 @@
+package chain_selectors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditInfo(t *testing.T) {
+	tests := []struct {
+		name      string
+		selector  uint64
+		expected  ChainAudit
+		expectErr bool
+	}{
+		{
+			name:     "entry without audit fields",
+			selector: ETHEREUM_MAINNET.Selector,
+		},
+		{
+			name:     "non evm entry",
+			selector: SOLANA_MAINNET.Selector,
+		},
+		{
+			name:      "unknown selector",
+			selector:  120398123,
+			expectErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			audit, err := AuditInfo(test.selector)
+			if test.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, audit)
+			assert.Equal(t, test.expected.ApprovedBy != "", audit.Approved())
+		})
+	}
+}
+
+func TestChainAuditFromSelectorsYml(t *testing.T) {
+	chains, err := decodeSelectorsYml([]byte(`
+selectors:
+  1:
+    selector: 1
+    name: requested-chain
+    owner: "requesting-team"
+  2:
+    selector: 2
+    name: approved-chain
+    owner: "requesting-team"
+    approved_by: "approving-team"
+`))
+	require.NoError(t, err)
+
+	requested := chainAudit(chains[1])
+	assert.Equal(t, ChainAudit{Owner: "requesting-team"}, requested)
+	assert.False(t, requested.Approved())
+
+	approved := chainAudit(chains[2])
+	assert.Equal(t, ChainAudit{Owner: "requesting-team", ApprovedBy: "approving-team"}, approved)
+	assert.True(t, approved.Approved())
+}
@@ -0,0 +1,182 @@
+package chain_selectors
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWarmUpIndexesEveryChain(t *testing.T) {
+	WarmUp()
+
+	for _, family := range allFamilies {
+		selectors := knownSelectors(family)
+		require.NotEmpty(t, selectors, family)
+		for _, selector := range selectors {
+			info, exists := lookupIndex().infoBySelector[selector]
+			require.True(t, exists, "selector %d of %s is not indexed", selector, family)
+			assert.Equal(t, family, info.Family)
+			assert.Equal(t, info, familyIndex(family).infoBySelector[selector])
+
+			resolved, err := resolveChainInfo(selector)
+			require.NoError(t, err)
+			assert.Equal(t, resolved, info)
+		}
+	}
+}
+
+func BenchmarkSelectorFromChainId(b *testing.B) {
+	WarmUp()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = selectorFromChainId(ETHEREUM_MAINNET.EvmChainID)
+	}
+}
+
+func BenchmarkChainIdFromSelector(b *testing.B) {
+	WarmUp()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = chainIdFromSelector(ETHEREUM_MAINNET.Selector)
+	}
+}
+
+func BenchmarkChainBySelector(b *testing.B) {
+	WarmUp()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = ChainBySelector(ETHEREUM_MAINNET.Selector)
+	}
+}
+
+func BenchmarkChainByEvmChainID(b *testing.B) {
+	WarmUp()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = ChainByEvmChainID(ETHEREUM_MAINNET.EvmChainID)
+	}
+}
+
+func BenchmarkChainByName(b *testing.B) {
+	WarmUp()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = ChainByName(ETHEREUM_MAINNET.Name)
+	}
+}
+
+func BenchmarkGetSelectorFamily(b *testing.B) {
+	WarmUp()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = GetSelectorFamily(SOLANA_MAINNET.Selector)
+	}
+}
+
+func BenchmarkGetChainIDFromSelector(b *testing.B) {
+	WarmUp()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = GetChainIDFromSelector(ETHEREUM_MAINNET.Selector)
+	}
+}
+
+func BenchmarkGetChainDetailsBySelector(b *testing.B) {
+	WarmUp()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = GetChainDetailsBySelector(ETHEREUM_MAINNET.Selector)
+	}
+}
+
+func BenchmarkGetChainDetailsByChainIDAndFamily(b *testing.B) {
+	WarmUp()
+	chainID := strconv.FormatUint(ETHEREUM_MAINNET.EvmChainID, 10)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = GetChainDetailsByChainIDAndFamily(chainID, FamilyEVM)
+	}
+}
+
+func BenchmarkIsEvm(b *testing.B) {
+	WarmUp()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = IsEvm(ETHEREUM_MAINNET.Selector)
+	}
+}
+
+func BenchmarkCustomChainBySelector(b *testing.B) {
+	WarmUp()
+	selector := generateCustomChainSelector(9388201)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = ChainBySelector(selector)
+	}
+}
+
+func BenchmarkRegistryChainBySelector(b *testing.B) {
+	WarmUp()
+	registry := NewRegistry(WithTestChains(false))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = registry.ChainBySelector(ETHEREUM_MAINNET.Selector)
+	}
+}
+
+func BenchmarkBuildLookupIndexes(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = buildLookupIndexes()
+	}
+}
+
+func TestHotPathLookupsDoNotAllocate(t *testing.T) {
+	WarmUp()
+
+	tests := []struct {
+		name   string
+		lookup func()
+	}{
+		{
+			name:   "SelectorFromChainId",
+			lookup: func() { _, _ = selectorFromChainId(ETHEREUM_MAINNET.EvmChainID) },
+		},
+		{
+			name:   "ChainIdFromSelector",
+			lookup: func() { _, _ = chainIdFromSelector(ETHEREUM_MAINNET.Selector) },
+		},
+		{
+			name:   "ChainBySelector",
+			lookup: func() { _, _ = ChainBySelector(ETHEREUM_MAINNET.Selector) },
+		},
+		{
+			name:   "GetChainIDFromSelector",
+			lookup: func() { _, _ = GetChainIDFromSelector(SOLANA_MAINNET.Selector) },
+		},
+		{
+			name:   "GetSelectorFamily",
+			lookup: func() { _, _ = GetSelectorFamily(APTOS_MAINNET.Selector) },
+		},
+		{
+			name:   "GetChainDetailsBySelector",
+			lookup: func() { _, _ = GetChainDetailsBySelector(ETHEREUM_MAINNET.Selector) },
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Zero(t, testing.AllocsPerRun(100, test.lookup))
+		})
+	}
+}
+
+func TestChainIdFromSelectorCoversEveryEvmChain(t *testing.T) {
+	for chainID, details := range evmChainIdToChainSelector() {
+		resolved, err := chainIdFromSelector(details.ChainSelector)
+		require.NoError(t, err)
+		assert.Equal(t, chainID, resolved)
+	}
+}
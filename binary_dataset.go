@@ -0,0 +1,196 @@
+package chain_selectors
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"sort"
+)
+
+// binaryDatasetMagic starts a dataset in the binary encoding, its last byte is the version of
+// the encoding.
+var binaryDatasetMagic = []byte("CSB\x01")
+
+// Flags of the optional fields of a chain in the binary encoding.
+const (
+	binaryHasNetworkID = 1 << iota
+	binaryHasGenesisHash
+	binaryHasOwner
+	binaryHasApprovedBy
+)
+
+// EncodeBinaryDataset encodes EVM chains, keyed by chain id like in the selectors.yml format, in
+// a compact binary encoding that decodes an order of magnitude faster than YAML, for
+// constrained environments such as mobile or WASM. See LoadBinary.
+//
+// The encoding is the magic "CSB\x01", the number of chains as an uvarint and every chain in
+// chain id order: the chain id as an uvarint delta to the previous one, the selector as 8 big
+// endian bytes, a byte of flags, the name and the optional fields flagged. Strings are uvarint
+// length prefixed, the genesis hash is its 32 bytes. A big endian CRC-32 of everything before
+// ends the encoding.
+func EncodeBinaryDataset(chains map[uint64]ChainDetails) ([]byte, error) {
+	chainIDs := make([]uint64, 0, len(chains))
+	for chainID := range chains {
+		chainIDs = append(chainIDs, chainID)
+	}
+	sort.Slice(chainIDs, func(i, j int) bool { return chainIDs[i] < chainIDs[j] })
+
+	buf := append([]byte(nil), binaryDatasetMagic...)
+	buf = binary.AppendUvarint(buf, uint64(len(chainIDs)))
+	var previous uint64
+	for _, chainID := range chainIDs {
+		details := chains[chainID]
+		buf = binary.AppendUvarint(buf, chainID-previous)
+		previous = chainID
+		buf = binary.BigEndian.AppendUint64(buf, details.ChainSelector)
+
+		var flags byte
+		var genesisHash []byte
+		if details.NetworkID != 0 {
+			flags |= binaryHasNetworkID
+		}
+		if details.GenesisHash != "" {
+			if !genesisHashFormat.MatchString(details.GenesisHash) {
+				return nil, fmt.Errorf("chain %d: invalid genesis hash %q", chainID, details.GenesisHash)
+			}
+			genesisHash, _ = hex.DecodeString(details.GenesisHash[2:])
+			flags |= binaryHasGenesisHash
+		}
+		if details.Owner != "" {
+			flags |= binaryHasOwner
+		}
+		if details.ApprovedBy != "" {
+			flags |= binaryHasApprovedBy
+		}
+		buf = append(buf, flags)
+		buf = appendBinaryString(buf, details.ChainName)
+		if flags&binaryHasNetworkID != 0 {
+			buf = binary.AppendUvarint(buf, details.NetworkID)
+		}
+		buf = append(buf, genesisHash...)
+		if flags&binaryHasOwner != 0 {
+			buf = appendBinaryString(buf, details.Owner)
+		}
+		if flags&binaryHasApprovedBy != 0 {
+			buf = appendBinaryString(buf, details.ApprovedBy)
+		}
+	}
+	return binary.BigEndian.AppendUint32(buf, crc32.ChecksumIEEE(buf)), nil
+}
+
+func appendBinaryString(buf []byte, s string) []byte {
+	buf = binary.AppendUvarint(buf, uint64(len(s)))
+	return append(buf, s...)
+}
+
+// errTruncatedDataset is returned for binary datasets ending before their last chain.
+var errTruncatedDataset = errors.New("truncated binary dataset")
+
+// DecodeBinaryDataset decodes EVM chains encoded by EncodeBinaryDataset, keyed by chain id.
+func DecodeBinaryDataset(data []byte) (map[uint64]ChainDetails, error) {
+	if len(data) < len(binaryDatasetMagic)+4 || !bytes.Equal(data[:len(binaryDatasetMagic)], binaryDatasetMagic) {
+		return nil, errors.New("not a binary dataset")
+	}
+	body, checksum := data[:len(data)-4], binary.BigEndian.Uint32(data[len(data)-4:])
+	if crc32.ChecksumIEEE(body) != checksum {
+		return nil, errors.New("binary dataset does not match its checksum")
+	}
+
+	d := binaryDecoder{data: body[len(binaryDatasetMagic):]}
+	count := d.uvarint()
+	if d.err == nil && count > uint64(len(d.data)) {
+		// every chain takes more than a byte, so the count cannot exceed the data left
+		return nil, errTruncatedDataset
+	}
+	chains := make(map[uint64]ChainDetails, count)
+	var chainID uint64
+	for i := uint64(0); i < count && d.err == nil; i++ {
+		chainID += d.uvarint()
+		details := ChainDetails{ChainSelector: d.uint64()}
+		flags := d.byte()
+		details.ChainName = d.string()
+		if flags&binaryHasNetworkID != 0 {
+			details.NetworkID = d.uvarint()
+		}
+		if flags&binaryHasGenesisHash != 0 {
+			details.GenesisHash = "0x" + hex.EncodeToString(d.bytes(32))
+		}
+		if flags&binaryHasOwner != 0 {
+			details.Owner = d.string()
+		}
+		if flags&binaryHasApprovedBy != 0 {
+			details.ApprovedBy = d.string()
+		}
+		chains[chainID] = details
+	}
+	if d.err != nil {
+		return nil, d.err
+	}
+	if len(d.data) != 0 {
+		return nil, errors.New("binary dataset has trailing data")
+	}
+	return chains, nil
+}
+
+// binaryDecoder reads the fields of a binary dataset, the first error stops it.
+type binaryDecoder struct {
+	data []byte
+	err  error
+}
+
+func (d *binaryDecoder) bytes(n uint64) []byte {
+	if d.err != nil {
+		return nil
+	}
+	if uint64(len(d.data)) < n {
+		d.err = errTruncatedDataset
+		return nil
+	}
+	b := d.data[:n]
+	d.data = d.data[n:]
+	return b
+}
+
+func (d *binaryDecoder) uvarint() uint64 {
+	if d.err != nil {
+		return 0
+	}
+	v, n := binary.Uvarint(d.data)
+	if n <= 0 {
+		d.err = errTruncatedDataset
+		return 0
+	}
+	d.data = d.data[n:]
+	return v
+}
+
+func (d *binaryDecoder) uint64() uint64 {
+	if b := d.bytes(8); b != nil {
+		return binary.BigEndian.Uint64(b)
+	}
+	return 0
+}
+
+func (d *binaryDecoder) byte() byte {
+	if b := d.bytes(1); b != nil {
+		return b[0]
+	}
+	return 0
+}
+
+func (d *binaryDecoder) string() string {
+	return string(d.bytes(d.uvarint()))
+}
+
+// LoadBinary loads EVM chains encoded with EncodeBinaryDataset, e.g. the selectors.bin written
+// by the generator, into the registry like LoadYAML.
+func (r *Registry) LoadBinary(data []byte) error {
+	chains, err := DecodeBinaryDataset(data)
+	if err != nil {
+		return fmt.Errorf("failed to decode binary dataset: %w", err)
+	}
+	return r.loadChains(chains, ProvenanceOverride, datasetVersion(data))
+}
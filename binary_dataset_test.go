@@ -0,0 +1,87 @@
+package chain_selectors
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_BinaryDatasetRoundTrip(t *testing.T) {
+	encoded, err := EncodeBinaryDataset(evmSelectorsMap())
+	require.NoError(t, err)
+
+	decoded, err := DecodeBinaryDataset(encoded)
+	require.NoError(t, err)
+	assert.Equal(t, evmSelectorsMap(), decoded)
+}
+
+func Test_BinaryDatasetIsUpToDate(t *testing.T) {
+	committed, err := os.ReadFile("selectors.bin")
+	require.NoError(t, err)
+
+	encoded, err := EncodeBinaryDataset(evmSelectorsMap())
+	require.NoError(t, err)
+	assert.Equal(t, encoded, committed, "selectors.bin is outdated, run go generate")
+}
+
+func Test_BinaryDatasetRejectsCorruptData(t *testing.T) {
+	encoded, err := EncodeBinaryDataset(map[uint64]ChainDetails{
+		77001: {ChainSelector: 11, ChainName: "devnet-a", GenesisHash: "0x" + strings.Repeat("ab", 32)},
+	})
+	require.NoError(t, err)
+
+	_, err = DecodeBinaryDataset(encoded[:len(encoded)-1])
+	assert.Error(t, err)
+
+	flipped := append([]byte(nil), encoded...)
+	flipped[len(binaryDatasetMagic)+2] ^= 0xff
+	_, err = DecodeBinaryDataset(flipped)
+	assert.ErrorContains(t, err, "checksum")
+
+	_, err = DecodeBinaryDataset([]byte("selectors:\n"))
+	assert.ErrorContains(t, err, "not a binary dataset")
+
+	_, err = EncodeBinaryDataset(map[uint64]ChainDetails{77001: {ChainSelector: 11, GenesisHash: "0x12"}})
+	assert.ErrorContains(t, err, "invalid genesis hash")
+}
+
+func Test_RegistryLoadBinary(t *testing.T) {
+	encoded, err := EncodeBinaryDataset(map[uint64]ChainDetails{
+		77001: {ChainSelector: 11, ChainName: "devnet-a", Owner: "team-a"},
+	})
+	require.NoError(t, err)
+
+	registry := NewRegistry(WithEmbeddedChains(false))
+	require.NoError(t, registry.LoadBinary(encoded))
+
+	ch, exists := registry.ChainBySelector(11)
+	require.True(t, exists)
+	assert.Equal(t, "devnet-a", ch.Name)
+
+	_, provenance, err := registry.GetChainDetailsWithProvenance(11)
+	require.NoError(t, err)
+	assert.Equal(t, ProvenanceOverride, provenance.Source)
+	assert.Equal(t, datasetVersion(encoded), provenance.DatasetVersion)
+
+	assert.Error(t, registry.LoadBinary(encoded[:10]))
+}
+
+func BenchmarkDecodeSelectorsYml(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = decodeSelectorsYml(selectorsYml)
+	}
+}
+
+func BenchmarkDecodeBinaryDataset(b *testing.B) {
+	encoded, err := EncodeBinaryDataset(evmSelectorsMap())
+	require.NoError(b, err)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = DecodeBinaryDataset(encoded)
+	}
+}
@@ -0,0 +1,92 @@
+package chain_selectors
+
+import (
+	"fmt"
+	"runtime/debug"
+	"sort"
+	"strings"
+	"time"
+)
+
+// modulePath is the import path of the module of the package.
+const modulePath = "github.com/fravlaca/chain-selectors"
+
+// generatedStamp is written by genbuildinfo.go, see BuildInfo.
+type generatedStamp struct {
+	GeneratedAt      string
+	GeneratorVersion string
+	DatasetFiles     map[string]string
+}
+
+// DatasetBuildInfo describes the datasets and version of the package a binary carries, see
+// BuildInfo.
+type DatasetBuildInfo struct {
+	// ModuleVersion is the version of the module the binary was built with, e.g. "v1.42.0",
+	// "(devel)" when built from a checkout and empty if the binary has no build information.
+	ModuleVersion string
+	// DatasetVersion is EmbeddedDatasetVersion.
+	DatasetVersion string
+	// DatasetFiles maps the path of every selector dataset in the repository to the git object
+	// id of its content, `git log --find-object=<id>` lists the commits that had it.
+	DatasetFiles map[string]string
+	// GeneratedAt is when the generated code last changed, zero if unknown.
+	GeneratedAt time.Time
+	// GeneratorVersion identifies the generators the code was generated with.
+	GeneratorVersion string
+}
+
+// BuildInfo returns which datasets and version of the package the binary carries, as stamped
+// by go generate, so support can tell exactly which chains a binary knows. Its String is meant
+// to be pasted in support requests, e.g. from a -version flag.
+func BuildInfo() DatasetBuildInfo {
+	info := DatasetBuildInfo{
+		DatasetVersion:   embeddedDatasetVersion(),
+		DatasetFiles:     make(map[string]string, len(generatedBuildInfo.DatasetFiles)),
+		GeneratorVersion: generatedBuildInfo.GeneratorVersion,
+	}
+	for path, id := range generatedBuildInfo.DatasetFiles {
+		info.DatasetFiles[path] = id
+	}
+	if generatedAt, err := time.Parse(time.RFC3339, generatedBuildInfo.GeneratedAt); err == nil {
+		info.GeneratedAt = generatedAt
+	}
+	if build, ok := debug.ReadBuildInfo(); ok {
+		info.ModuleVersion = moduleVersion(build)
+	}
+	return info
+}
+
+// moduleVersion returns the version of the module of the package in the build.
+func moduleVersion(build *debug.BuildInfo) string {
+	if build.Main.Path == modulePath {
+		return build.Main.Version
+	}
+	for _, dep := range build.Deps {
+		if dep.Path != modulePath {
+			continue
+		}
+		if dep.Replace != nil {
+			return dep.Replace.Version
+		}
+		return dep.Version
+	}
+	return ""
+}
+
+// String formats the build information over several lines, dataset files sorted by path.
+func (i DatasetBuildInfo) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "module: %s %s\n", modulePath, i.ModuleVersion)
+	fmt.Fprintf(&b, "dataset version: %s\n", i.DatasetVersion)
+	fmt.Fprintf(&b, "generated at: %s\n", i.GeneratedAt.Format(time.RFC3339))
+	fmt.Fprintf(&b, "generator version: %s\n", i.GeneratorVersion)
+	paths := make([]string, 0, len(i.DatasetFiles))
+	for path := range i.DatasetFiles {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	for _, path := range paths {
+		fmt.Fprintf(&b, "%s: %s\n", path, i.DatasetFiles[path])
+	}
+	return b.String()
+}
@@ -0,0 +1,47 @@
+package chain_selectors
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"runtime/debug"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildInfo(t *testing.T) {
+	info := BuildInfo()
+	assert.Equal(t, EmbeddedDatasetVersion(), info.DatasetVersion)
+	assert.False(t, info.GeneratedAt.IsZero())
+	assert.NotEmpty(t, info.GeneratorVersion)
+
+	// the stamp must match the embedded datasets, or go generate was not run
+	embedded := map[string][]byte{
+		"evm/selectors.yml":                selectorsYml,
+		"evm/test_selectors.yml":           testSelectorsYml,
+		"evm/selectors_forks.yml":          forksSelectorsYml,
+		"solana/selectors_solana.yml":      solanaSelectorsYml,
+		"solana/test_selectors_solana.yml": testSelectorsSolanaYml,
+		"aptos/selectors_aptos.yml":        aptosSelectorsYml,
+		"sui/selectors_sui.yml":            suiSelectorsYml,
+		"tron/selectors_tron.yml":          tronSelectorsYml,
+		"ton/selectors_ton.yml":            tonSelectorsYml,
+	}
+	assert.Len(t, info.DatasetFiles, len(embedded))
+	for path, content := range embedded {
+		h := sha1.New()
+		fmt.Fprintf(h, "blob %d\x00", len(content))
+		h.Write(content)
+		assert.Equal(t, hex.EncodeToString(h.Sum(nil)), info.DatasetFiles[path], path)
+	}
+
+	assert.Contains(t, info.String(), "evm/selectors.yml: "+info.DatasetFiles["evm/selectors.yml"]+"\n")
+}
+
+func TestModuleVersion(t *testing.T) {
+	assert.Equal(t, "v1.2.3", moduleVersion(&debug.BuildInfo{Deps: []*debug.Module{{Path: modulePath, Version: "v1.2.3"}}}))
+	assert.Equal(t, "v1.2.4", moduleVersion(&debug.BuildInfo{Deps: []*debug.Module{{Path: modulePath, Version: "v1.2.3", Replace: &debug.Module{Version: "v1.2.4"}}}}))
+	assert.Equal(t, "(devel)", moduleVersion(&debug.BuildInfo{Main: debug.Module{Path: modulePath, Version: "(devel)"}}))
+	assert.Empty(t, moduleVersion(&debug.BuildInfo{Main: debug.Module{Path: "example.com/service"}}))
+}
@@ -0,0 +1,95 @@
+package chain_selectors
+
+import (
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ErrBundleIntegrity is returned when a dataset bundle does not match the digest it is loaded
+// with.
+var ErrBundleIntegrity = errors.New("dataset bundle does not match its digest")
+
+// Bundle is a canonical dataset of EVM chains, in the encoding of EncodeBinaryDataset, with the
+// digests identifying it in content addressed storage. Encoding the same chains always gives
+// the same bundle.
+type Bundle struct {
+	Data []byte
+	// SHA256 is the hex encoded SHA-256 of Data.
+	SHA256 string
+	// CID is the IPFS CIDv1 of Data, see BundleCID.
+	CID string
+}
+
+// NewBundle encodes the EVM chains, keyed by chain id, as a bundle.
+func NewBundle(chains map[uint64]ChainDetails) (Bundle, error) {
+	data, err := EncodeBinaryDataset(chains)
+	if err != nil {
+		return Bundle{}, err
+	}
+	sum := sha256.Sum256(data)
+	return Bundle{Data: data, SHA256: hex.EncodeToString(sum[:]), CID: BundleCID(data)}, nil
+}
+
+// Bundle returns the EVM chains the registry resolves as a bundle: the embedded chains it does
+// not exclude merged with the chains loaded into it. Forks of selectors_forks.yml reuse the
+// chain id of another chain and are not part of the bundle.
+func (r *Registry) Bundle() (Bundle, error) {
+	chains := make(map[uint64]ChainDetails)
+	for chainID, details := range evmChainIdToChainSelector() {
+		if !r.excludesChainID(strconv.FormatUint(chainID, 10), FamilyEVM) {
+			chains[chainID] = details
+		}
+	}
+	for chainID, details := range r.loadState().evmDetails {
+		chains[chainID] = details
+	}
+	return NewBundle(chains)
+}
+
+// cidRawSHA256Prefix is the CIDv1 version, the raw codec and the sha2-256 multihash code and
+// length, all varints of a single byte.
+var cidRawSHA256Prefix = []byte{0x01, 0x55, 0x12, 0x20}
+
+// BundleCID returns the IPFS CIDv1 of the data with the raw codec and a sha2-256 multihash, in
+// base32 multibase, e.g. "bafkrei...". It is the CID `ipfs add --cid-version=1 --raw-leaves`
+// gives to files of up to 256 KiB, which bundles are far from reaching.
+func BundleCID(data []byte) string {
+	sum := sha256.Sum256(data)
+	cid := append(append([]byte(nil), cidRawSHA256Prefix...), sum[:]...)
+	return "b" + strings.ToLower(base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(cid))
+}
+
+// VerifyBundle checks the data against the digest, either a CID as returned by BundleCID or a
+// hex encoded SHA-256 prefixed with "sha256:". It returns an error wrapping ErrBundleIntegrity
+// if they do not match.
+func VerifyBundle(data []byte, digest string) error {
+	if sum, ok := strings.CutPrefix(digest, "sha256:"); ok {
+		actual := sha256.Sum256(data)
+		if !strings.EqualFold(hex.EncodeToString(actual[:]), sum) {
+			return fmt.Errorf("%w sha256:%s", ErrBundleIntegrity, sum)
+		}
+		return nil
+	}
+	if !strings.HasPrefix(digest, "b") {
+		return fmt.Errorf("unsupported digest %q, expected a base32 CID or sha256:<hex>", digest)
+	}
+	if BundleCID(data) != digest {
+		return fmt.Errorf("%w %s", ErrBundleIntegrity, digest)
+	}
+	return nil
+}
+
+// LoadBundle verifies the bundle, e.g. fetched from an IPFS gateway or object storage, against
+// the digest trusted by the caller, see VerifyBundle, and loads it like LoadBinary. Nothing is
+// loaded if the bundle does not match.
+func (r *Registry) LoadBundle(data []byte, digest string) error {
+	if err := VerifyBundle(data, digest); err != nil {
+		return err
+	}
+	return r.LoadBinary(data)
+}
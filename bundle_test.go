@@ -0,0 +1,57 @@
+package chain_selectors
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_BundleCID(t *testing.T) {
+	// ipfs add --cid-version=1 --raw-leaves of an empty file
+	assert.Equal(t, "bafkreihdwdcefgh4dqkjv67uzcmw7ojee6xedzdetojuzjevtenxquvyku", BundleCID(nil))
+}
+
+func Test_RegistryBundleRoundTrip(t *testing.T) {
+	registry := NewRegistry(WithTestChains(false))
+	require.NoError(t, registry.LoadYAML([]byte("selectors:\n  77001:\n    selector: 11\n    name: devnet-a\n")))
+
+	bundle, err := registry.Bundle()
+	require.NoError(t, err)
+	again, err := registry.Bundle()
+	require.NoError(t, err)
+	assert.Equal(t, bundle, again, "bundles are canonical")
+	assert.Equal(t, BundleCID(bundle.Data), bundle.CID)
+
+	chains, err := DecodeBinaryDataset(bundle.Data)
+	require.NoError(t, err)
+	assert.Equal(t, "devnet-a", chains[77001].ChainName)
+	assert.Equal(t, ETHEREUM_MAINNET.Selector, chains[ETHEREUM_MAINNET.EvmChainID].ChainSelector)
+	_, hasTestChain := chains[TEST_90000001.EvmChainID]
+	assert.False(t, hasTestChain)
+
+	replica := NewRegistry(WithEmbeddedChains(false))
+	require.NoError(t, replica.LoadBundle(bundle.Data, bundle.CID))
+	ch, exists := replica.ChainBySelector(11)
+	require.True(t, exists)
+	assert.Equal(t, "devnet-a", ch.Name)
+	require.NoError(t, NewRegistry().LoadBundle(bundle.Data, "sha256:"+bundle.SHA256))
+}
+
+func Test_LoadBundleRejectsTamperedData(t *testing.T) {
+	bundle, err := NewBundle(map[uint64]ChainDetails{77001: {ChainSelector: 11, ChainName: "devnet-a"}})
+	require.NoError(t, err)
+	tampered, err := NewBundle(map[uint64]ChainDetails{77001: {ChainSelector: 11, ChainName: "devnet-b"}})
+	require.NoError(t, err)
+
+	registry := NewRegistry()
+	err = registry.LoadBundle(tampered.Data, bundle.CID)
+	assert.True(t, errors.Is(err, ErrBundleIntegrity))
+	err = registry.LoadBundle(tampered.Data, "sha256:"+bundle.SHA256)
+	assert.True(t, errors.Is(err, ErrBundleIntegrity))
+	assert.ErrorContains(t, registry.LoadBundle(bundle.Data, "Qm"+bundle.SHA256), "unsupported digest")
+
+	_, exists := registry.ChainBySelector(11)
+	assert.False(t, exists)
+}
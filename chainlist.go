@@ -0,0 +1,94 @@
+package chain_selectors
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// ChainlistURL is the location of the ethereum-lists/chains dataset used by chainlist.org.
+const ChainlistURL = "https://chainid.network/chains.json"
+
+// ChainlistChain is a single entry of the ethereum-lists/chains dataset.
+type ChainlistChain struct {
+	Name           string              `json:"name"`
+	ChainID        uint64              `json:"chainId"`
+	NativeCurrency NativeCurrency      `json:"nativeCurrency"`
+	RPC            []string            `json:"rpc"`
+	Explorers      []ChainlistExplorer `json:"explorers"`
+	InfoURL        string              `json:"infoURL"`
+	Faucets        []string            `json:"faucets"`
+}
+
+// ChainlistExplorer is a block explorer listed in the ethereum-lists/chains dataset.
+type ChainlistExplorer struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+// ChainlistImport is the result of mapping the ethereum-lists/chains dataset onto the known EVM chains.
+type ChainlistImport struct {
+	// Metadata holds the imported metadata keyed by chain selector.
+	Metadata map[uint64]ChainMetadata
+	// Missing lists the upstream chains without a selector, sorted by chain id.
+	Missing []ChainlistChain
+}
+
+// ParseChainlist decodes the JSON encoded ethereum-lists/chains dataset.
+func ParseChainlist(r io.Reader) ([]ChainlistChain, error) {
+	var chains []ChainlistChain
+	if err := json.NewDecoder(r).Decode(&chains); err != nil {
+		return nil, fmt.Errorf("failed to decode chainlist dataset: %w", err)
+	}
+	return chains, nil
+}
+
+// ImportChainlist maps upstream chains onto known EVM selectors by chain id and reports
+// the upstream chains that are missing locally.
+func ImportChainlist(chains []ChainlistChain) ChainlistImport {
+	output := ChainlistImport{Metadata: make(map[uint64]ChainMetadata)}
+	for _, chain := range chains {
+		details, exist := evmChainIdToChainSelector()[chain.ChainID]
+		if !exist {
+			output.Missing = append(output.Missing, chain)
+			continue
+		}
+
+		metadata := ChainMetadata{
+			DisplayName:    chain.Name,
+			NativeCurrency: chain.NativeCurrency,
+		}
+		if validateURL(chain.InfoURL) == nil {
+			metadata.Website = chain.InfoURL
+		}
+		for _, faucet := range chain.Faucets {
+			if isPublicURL(faucet) && validateURL(faucet) == nil {
+				metadata.Faucets = append(metadata.Faucets, faucet)
+			}
+		}
+		for _, explorer := range chain.Explorers {
+			if isPublicURL(explorer.URL) {
+				metadata.Explorers = append(metadata.Explorers, explorer.URL)
+			}
+		}
+		for _, rpc := range chain.RPC {
+			if isPublicURL(rpc) {
+				metadata.RPCs = append(metadata.RPCs, rpc)
+			}
+		}
+		output.Metadata[details.ChainSelector] = metadata
+	}
+
+	sort.Slice(output.Missing, func(i, j int) bool { return output.Missing[i].ChainID < output.Missing[j].ChainID })
+	return output
+}
+
+// isPublicURL filters out entries requiring an API key, which upstream marks with ${...} placeholders.
+func isPublicURL(url string) bool {
+	if strings.Contains(url, "${") {
+		return false
+	}
+	return strings.HasPrefix(url, "https://") || strings.HasPrefix(url, "http://") || strings.HasPrefix(url, "wss://")
+}
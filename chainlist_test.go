@@ -0,0 +1,58 @@
+package chain_selectors
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const chainlistFixture = `[
+  {
+    "name": "Ethereum Mainnet",
+    "chainId": 1,
+    "nativeCurrency": {"name": "Ether", "symbol": "ETH", "decimals": 18},
+    "rpc": ["https://mainnet.infura.io/v3/${INFURA_API_KEY}", "https://cloudflare-eth.com"],
+    "explorers": [{"name": "etherscan", "url": "https://etherscan.io", "standard": "EIP3091"}],
+    "infoURL": "https://ethereum.org"
+  },
+  {
+    "name": "Some Unknown Chain",
+    "chainId": 987654321987,
+    "nativeCurrency": {"name": "Unknown", "symbol": "UNK", "decimals": 18},
+    "rpc": []
+  },
+  {
+    "name": "Another Unknown Chain",
+    "chainId": 123454312,
+    "nativeCurrency": {"name": "Unknown", "symbol": "UNK", "decimals": 18},
+    "rpc": []
+  }
+]`
+
+func Test_ImportChainlist(t *testing.T) {
+	chains, err := ParseChainlist(strings.NewReader(chainlistFixture))
+	require.NoError(t, err)
+	require.Len(t, chains, 3)
+
+	imported := ImportChainlist(chains)
+
+	require.Len(t, imported.Metadata, 1)
+	assert.Equal(t, ChainMetadata{
+		DisplayName:    "Ethereum Mainnet",
+		NativeCurrency: NativeCurrency{Name: "Ether", Symbol: "ETH", Decimals: 18},
+		Explorers:      []string{"https://etherscan.io"},
+		RPCs:           []string{"https://cloudflare-eth.com"},
+		Website:        "https://ethereum.org",
+	}, imported.Metadata[ETHEREUM_MAINNET.Selector])
+
+	require.Len(t, imported.Missing, 2)
+	assert.Equal(t, uint64(123454312), imported.Missing[0].ChainID)
+	assert.Equal(t, uint64(987654321987), imported.Missing[1].ChainID)
+}
+
+func Test_ParseChainlistInvalid(t *testing.T) {
+	_, err := ParseChainlist(strings.NewReader("{"))
+	require.Error(t, err)
+}
@@ -0,0 +1,134 @@
+// Package chainselclient resolves chains from a registry served by the server package, so a
+// service can use a shared registry by adding a single option:
+//
+//	registry := chainselectors.NewRegistry(
+//		chainselectors.WithRemoteResolver(chainselclient.New("https://registry.internal")),
+//	)
+//
+// Resolved chains are cached, and chains the server cannot be asked for are resolved from the
+// datasets embedded in the package.
+package chainselclient
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	chainselectors "github.com/fravlaca/chain-selectors"
+	"github.com/fravlaca/chain-selectors/server"
+)
+
+// ErrFingerprintMismatch is returned, wrapped, when the server runs a registry with another
+// fingerprint than the one given with WithFingerprint.
+var ErrFingerprintMismatch = errors.New("server registry fingerprint mismatch")
+
+// Client resolves chains from a server. It implements chainselectors.RemoteResolver and is
+// safe for concurrent use.
+type Client struct {
+	baseURL      string
+	httpClient   *http.Client
+	fingerprint  string
+	cacheOptions []chainselectors.CachingResolverOption
+	fallback     bool
+
+	cache *chainselectors.CachingResolver
+}
+
+// Option configures a Client.
+type Option func(*Client)
+
+// WithHTTPClient sets the client the server is called with, http.DefaultClient by default.
+func WithHTTPClient(client *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = client
+	}
+}
+
+// WithFingerprint rejects the answers of a server whose registry does not have the
+// fingerprint, see Registry.Fingerprint, so a server running a stale or diverging dataset is
+// never trusted. The lookups fall back to the embedded datasets instead.
+func WithFingerprint(fingerprint string) Option {
+	return func(c *Client) {
+		c.fingerprint = fingerprint
+	}
+}
+
+// WithCacheOptions configures the cache of resolved chains, see chainselectors.NewCachingResolver.
+func WithCacheOptions(opts ...chainselectors.CachingResolverOption) Option {
+	return func(c *Client) {
+		c.cacheOptions = append(c.cacheOptions, opts...)
+	}
+}
+
+// WithoutFallback returns the errors of the server instead of resolving the chain from the
+// embedded datasets.
+func WithoutFallback() Option {
+	return func(c *Client) {
+		c.fallback = false
+	}
+}
+
+// New creates a client of the server at baseURL, configured with the options.
+func New(baseURL string, opts ...Option) *Client {
+	c := &Client{
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		httpClient: http.DefaultClient,
+		fallback:   true,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	c.cache = chainselectors.NewCachingResolver(chainselectors.RemoteResolverFunc(c.fetch), c.cacheOptions...)
+	return c
+}
+
+// ResolveSelector resolves the chain from the cache or the server. If the server cannot be
+// asked, or runs another registry than required by WithFingerprint, the chain is resolved
+// from the embedded datasets. Chains the server does not know fail with an error wrapping
+// chainselectors.ErrChainNotFound.
+func (c *Client) ResolveSelector(ctx context.Context, selector uint64) (chainselectors.ChainDetails, error) {
+	details, err := c.cache.ResolveSelector(ctx, selector)
+	if err == nil || errors.Is(err, chainselectors.ErrChainNotFound) || !c.fallback {
+		return details, err
+	}
+	embedded, embeddedErr := chainselectors.GetChainDetailsBySelector(selector)
+	if embeddedErr != nil {
+		return chainselectors.ChainDetails{}, err
+	}
+	return embedded, nil
+}
+
+// fetch asks the server for the chain.
+func (c *Client) fetch(ctx context.Context, selector uint64) (chainselectors.ChainDetails, error) {
+	url := c.baseURL + server.ChainPath + strconv.FormatUint(selector, 10)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return chainselectors.ChainDetails{}, fmt.Errorf("invalid server url %q: %w", c.baseURL, err)
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return chainselectors.ChainDetails{}, err
+	}
+	defer resp.Body.Close()
+
+	if c.fingerprint != "" && resp.Header.Get("ETag") != strconv.Quote(c.fingerprint) {
+		return chainselectors.ChainDetails{}, fmt.Errorf("%w: got %s", ErrFingerprintMismatch, resp.Header.Get("ETag"))
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return chainselectors.ChainDetails{}, fmt.Errorf("selector %d: %w", selector, chainselectors.ErrChainNotFound)
+	default:
+		return chainselectors.ChainDetails{}, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+
+	var chain server.Chain
+	if err := json.NewDecoder(resp.Body).Decode(&chain); err != nil {
+		return chainselectors.ChainDetails{}, fmt.Errorf("invalid response: %w", err)
+	}
+	return chainselectors.ChainDetails{ChainSelector: chain.Selector, ChainName: chain.Name}, nil
+}
@@ -0,0 +1,65 @@
+package chainselclient
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	chainselectors "github.com/fravlaca/chain-selectors"
+	"github.com/fravlaca/chain-selectors/server"
+)
+
+func newServer(t *testing.T) (*chainselectors.Registry, string) {
+	registry := chainselectors.NewRegistry()
+	require.NoError(t, registry.LoadYAML([]byte("selectors:\n  77001:\n    selector: 11\n    name: devnet-a\n")))
+	srv := httptest.NewServer(server.New(registry))
+	t.Cleanup(srv.Close)
+	return registry, srv.URL
+}
+
+func TestClientResolvesFromServer(t *testing.T) {
+	_, url := newServer(t)
+	registry := chainselectors.NewRegistry(chainselectors.WithRemoteResolver(New(url)))
+
+	details, provenance, err := registry.ResolveSelector(context.Background(), 11)
+	require.NoError(t, err)
+	assert.Equal(t, chainselectors.ProvenanceRemote, provenance.Source)
+	assert.Equal(t, chainselectors.ChainDetails{ChainSelector: 11, ChainName: "devnet-a"}, details)
+
+	_, _, err = registry.ResolveSelector(context.Background(), 12)
+	require.ErrorIs(t, err, chainselectors.ErrChainNotFound)
+}
+
+func TestClientFingerprintValidation(t *testing.T) {
+	served, url := newServer(t)
+
+	details, err := New(url, WithFingerprint(served.Fingerprint())).ResolveSelector(context.Background(), 11)
+	require.NoError(t, err)
+	assert.Equal(t, "devnet-a", details.ChainName)
+
+	stale := chainselectors.NewRegistry().Fingerprint()
+	_, err = New(url, WithFingerprint(stale), WithoutFallback()).ResolveSelector(context.Background(), 11)
+	require.ErrorIs(t, err, ErrFingerprintMismatch)
+
+	details, err = New(url, WithFingerprint(stale)).ResolveSelector(context.Background(), chainselectors.ETHEREUM_MAINNET.Selector)
+	require.NoError(t, err, "chains are resolved from the embedded datasets instead")
+	assert.Equal(t, chainselectors.ETHEREUM_MAINNET.Name, details.ChainName)
+}
+
+func TestClientFallsBackToEmbeddedData(t *testing.T) {
+	srv := httptest.NewServer(nil)
+	url := srv.URL
+	srv.Close()
+
+	details, err := New(url).ResolveSelector(context.Background(), chainselectors.ETHEREUM_MAINNET.Selector)
+	require.NoError(t, err)
+	assert.Equal(t, chainselectors.ETHEREUM_MAINNET.Name, details.ChainName)
+
+	_, err = New(url).ResolveSelector(context.Background(), 11)
+	require.Error(t, err, "chains neither the server nor the embedded datasets resolve fail")
+	_, err = New(url, WithoutFallback()).ResolveSelector(context.Background(), chainselectors.ETHEREUM_MAINNET.Selector)
+	require.Error(t, err)
+}
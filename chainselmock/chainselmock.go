@@ -0,0 +1,164 @@
+// Package chainselmock provides an in-memory RemoteResolver for the unit tests of code resolving
+// chains through the chain selectors package, with scripted failures and latencies to simulate
+// unknown chains and a registry service that is slow or down.
+//
+//	mock := chainselmock.NewRegistry(chainselectors.ChainDetails{ChainSelector: 42, ChainName: "test-chain"})
+//	mock.FailNext(2, chainselmock.ErrUnavailable)
+//	registry := chainselectors.NewRegistry(chainselectors.WithRemoteResolver(mock))
+package chainselmock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	chainselectors "github.com/fravlaca/chain-selectors"
+)
+
+// ErrUnavailable is returned by a Registry taken down with Down and nil.
+var ErrUnavailable = errors.New("chainselmock: registry unavailable")
+
+// Registry is an in-memory chainselectors.RemoteResolver. Lookups of chains it does not know
+// fail with an error wrapping chainselectors.ErrChainNotFound. It is safe for concurrent use.
+type Registry struct {
+	mu         sync.Mutex
+	chains     map[uint64]chainselectors.ChainDetails
+	failures   map[uint64]error
+	scripted   []error
+	down       error
+	latency    time.Duration
+	calls      int
+	bySelector map[uint64]int
+}
+
+var _ chainselectors.RemoteResolver = (*Registry)(nil)
+
+// NewRegistry returns a Registry resolving the given chains.
+func NewRegistry(chains ...chainselectors.ChainDetails) *Registry {
+	r := &Registry{
+		chains:     make(map[uint64]chainselectors.ChainDetails),
+		failures:   make(map[uint64]error),
+		bySelector: make(map[uint64]int),
+	}
+	r.Add(chains...)
+	return r
+}
+
+// Add makes the registry resolve the chains, replacing chains with the same selector.
+func (r *Registry) Add(chains ...chainselectors.ChainDetails) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, details := range chains {
+		r.chains[details.ChainSelector] = details
+	}
+}
+
+// Remove makes lookups of the selector fail as unknown.
+func (r *Registry) Remove(selector uint64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.chains, selector)
+}
+
+// Fail makes every lookup of the selector fail with err, nil clears the failure.
+func (r *Registry) Fail(selector uint64, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err == nil {
+		delete(r.failures, selector)
+		return
+	}
+	r.failures[selector] = err
+}
+
+// FailNext makes the next n lookups fail with err, whatever their selector. Scripted failures
+// queue up: the failures of a second call follow the ones of the first.
+func (r *Registry) FailNext(n int, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i := 0; i < n; i++ {
+		r.scripted = append(r.scripted, err)
+	}
+}
+
+// Down makes every lookup fail with err, or ErrUnavailable if err is nil, until Up is called.
+func (r *Registry) Down(err error) {
+	if err == nil {
+		err = ErrUnavailable
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.down = err
+}
+
+// Up brings a registry taken down with Down back.
+func (r *Registry) Up() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.down = nil
+}
+
+// SetLatency delays every lookup, a lookup whose context is done first fails with the error of
+// the context.
+func (r *Registry) SetLatency(latency time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.latency = latency
+}
+
+// Calls returns the number of lookups, including the failed ones.
+func (r *Registry) Calls() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.calls
+}
+
+// CallsFor returns the number of lookups of the selector, including the failed ones.
+func (r *Registry) CallsFor(selector uint64) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.bySelector[selector]
+}
+
+// ResolveSelector resolves the chain, applying the latency and the failures scripted first.
+// Failures are applied in order: Down, then FailNext, then Fail.
+func (r *Registry) ResolveSelector(ctx context.Context, selector uint64) (chainselectors.ChainDetails, error) {
+	r.mu.Lock()
+	r.calls++
+	r.bySelector[selector]++
+	latency := r.latency
+	r.mu.Unlock()
+
+	if latency > 0 {
+		timer := time.NewTimer(latency)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return chainselectors.ChainDetails{}, ctx.Err()
+		case <-timer.C:
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.down != nil {
+		return chainselectors.ChainDetails{}, r.down
+	}
+	if len(r.scripted) > 0 {
+		err := r.scripted[0]
+		r.scripted = r.scripted[1:]
+		if err != nil {
+			return chainselectors.ChainDetails{}, err
+		}
+	}
+	if err, failing := r.failures[selector]; failing {
+		return chainselectors.ChainDetails{}, err
+	}
+	details, exists := r.chains[selector]
+	if !exists {
+		return chainselectors.ChainDetails{}, fmt.Errorf("chainselmock: selector %d: %w", selector, chainselectors.ErrChainNotFound)
+	}
+	return details, nil
+}
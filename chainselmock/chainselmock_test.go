@@ -0,0 +1,82 @@
+package chainselmock
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	chainselectors "github.com/fravlaca/chain-selectors"
+)
+
+var testChain = chainselectors.ChainDetails{ChainSelector: 42, ChainName: "test-chain"}
+
+func TestRegistryResolves(t *testing.T) {
+	mock := NewRegistry(testChain)
+
+	details, err := mock.ResolveSelector(context.Background(), 42)
+	require.NoError(t, err)
+	assert.Equal(t, testChain, details)
+
+	_, err = mock.ResolveSelector(context.Background(), 43)
+	require.ErrorIs(t, err, chainselectors.ErrChainNotFound)
+
+	mock.Remove(42)
+	_, err = mock.ResolveSelector(context.Background(), 42)
+	require.ErrorIs(t, err, chainselectors.ErrChainNotFound)
+
+	assert.Equal(t, 3, mock.Calls())
+	assert.Equal(t, 2, mock.CallsFor(42))
+}
+
+func TestRegistryScriptedFailures(t *testing.T) {
+	mock := NewRegistry(testChain)
+	flaky := errors.New("connection reset")
+
+	mock.FailNext(2, flaky)
+	for i := 0; i < 2; i++ {
+		_, err := mock.ResolveSelector(context.Background(), 42)
+		require.ErrorIs(t, err, flaky)
+	}
+	_, err := mock.ResolveSelector(context.Background(), 42)
+	require.NoError(t, err)
+
+	mock.Fail(42, flaky)
+	_, err = mock.ResolveSelector(context.Background(), 42)
+	require.ErrorIs(t, err, flaky)
+	mock.Fail(42, nil)
+
+	mock.Down(nil)
+	_, err = mock.ResolveSelector(context.Background(), 42)
+	require.ErrorIs(t, err, ErrUnavailable)
+	mock.Up()
+	_, err = mock.ResolveSelector(context.Background(), 42)
+	require.NoError(t, err)
+}
+
+func TestRegistryLatency(t *testing.T) {
+	mock := NewRegistry(testChain)
+	mock.SetLatency(time.Hour)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := mock.ResolveSelector(ctx, 42)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestRegistryBehindChainSelectorsRegistry(t *testing.T) {
+	mock := NewRegistry(testChain)
+	registry := chainselectors.NewRegistry(chainselectors.WithRemoteResolver(mock))
+
+	details, provenance, err := registry.ResolveSelector(context.Background(), 42)
+	require.NoError(t, err)
+	assert.Equal(t, chainselectors.ProvenanceRemote, provenance.Source)
+	assert.Equal(t, "test-chain", details.ChainName)
+
+	mock.Down(nil)
+	_, _, err = registry.ResolveSelector(context.Background(), 42)
+	require.ErrorIs(t, err, ErrUnavailable)
+}
@@ -0,0 +1,84 @@
+package chain_selectors
+
+import "sort"
+
+// ChangeSet lists the chains a load changed in a Registry.
+type ChangeSet struct {
+	// Source is where the loaded chains came from, ProvenanceOverride for LoadYAML and
+	// ProvenanceRemote for RemoteSync.
+	Source ProvenanceSource
+	// Added holds the chains the registry did not resolve from its loaded chains before.
+	Added []Chain
+	// Updated holds the loaded chains whose selector, name or details changed.
+	Updated []Chain
+	// Removed holds the loaded chains the registry no longer resolves, only ApplyDelta
+	// removes chains.
+	Removed []Chain
+}
+
+// Empty reports whether the change set holds no change.
+func (c ChangeSet) Empty() bool {
+	return len(c.Added) == 0 && len(c.Updated) == 0 && len(c.Removed) == 0
+}
+
+type changeListener struct {
+	id int
+	fn func(ChangeSet)
+}
+
+// OnChange registers fn to be called with the chains changed by every subsequent LoadYAML or
+// RemoteSync load into the registry that changes anything. fn is called synchronously by the
+// goroutine loading, after the change is visible to lookups; loads running concurrently may
+// call it concurrently. The returned function unregisters fn.
+func (r *Registry) OnChange(fn func(ChangeSet)) (cancel func()) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.nextID++
+	id := r.nextID
+	// listeners is replaced rather than appended to, loads notify the slice they copied
+	r.listeners = append(append([]changeListener(nil), r.listeners...), changeListener{id: id, fn: fn})
+
+	return func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		remaining := make([]changeListener, 0, len(r.listeners))
+		for _, l := range r.listeners {
+			if l.id != id {
+				remaining = append(remaining, l)
+			}
+		}
+		r.listeners = remaining
+	}
+}
+
+func (r *Registry) notify(listeners []changeListener, changes ChangeSet) {
+	if changes.Empty() {
+		return
+	}
+	for _, l := range listeners {
+		l.fn(changes)
+	}
+}
+
+// diffStates lists the chains loaded into next that are new or different compared to previous.
+func diffStates(previous, next *registryState, source ProvenanceSource) ChangeSet {
+	changes := ChangeSet{Source: source}
+	for chainID, ch := range next.evmByChainID {
+		old, existed := previous.evmByChainID[chainID]
+		switch {
+		case !existed:
+			changes.Added = append(changes.Added, ch)
+		case old != ch || previous.evmDetails[chainID] != next.evmDetails[chainID]:
+			changes.Updated = append(changes.Updated, ch)
+		}
+	}
+	for chainID, ch := range previous.evmByChainID {
+		if _, exists := next.evmByChainID[chainID]; !exists {
+			changes.Removed = append(changes.Removed, ch)
+		}
+	}
+	sort.Slice(changes.Added, func(i, j int) bool { return changes.Added[i].EvmChainID < changes.Added[j].EvmChainID })
+	sort.Slice(changes.Updated, func(i, j int) bool { return changes.Updated[i].EvmChainID < changes.Updated[j].EvmChainID })
+	sort.Slice(changes.Removed, func(i, j int) bool { return changes.Removed[i].EvmChainID < changes.Removed[j].EvmChainID })
+	return changes
+}
@@ -0,0 +1,53 @@
+package chain_selectors
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistryOnChange(t *testing.T) {
+	registry := NewRegistry()
+	var changes []ChangeSet
+	cancel := registry.OnChange(func(c ChangeSet) { changes = append(changes, c) })
+
+	require.NoError(t, registry.LoadYAML([]byte("selectors:\n  77001:\n    selector: 11\n    name: devnet-a\n  77002:\n    selector: 21\n    name: devnet-b\n")))
+	require.Len(t, changes, 1)
+	assert.Equal(t, ProvenanceOverride, changes[0].Source)
+	assert.Equal(t, []Chain{{EvmChainID: 77001, Selector: 11, Name: "devnet-a", VarName: "DEVNET_A"}, {EvmChainID: 77002, Selector: 21, Name: "devnet-b", VarName: "DEVNET_B"}}, changes[0].Added)
+	assert.Empty(t, changes[0].Updated)
+
+	// Reloading the same chains changes nothing and notifies nobody.
+	require.NoError(t, registry.LoadYAML([]byte("selectors:\n  77001:\n    selector: 11\n    name: devnet-a\n")))
+	require.Len(t, changes, 1)
+
+	require.NoError(t, registry.LoadYAML([]byte("selectors:\n  77001:\n    selector: 12\n    name: devnet-a\n")))
+	require.Len(t, changes, 2)
+	assert.Empty(t, changes[1].Added)
+	assert.Equal(t, []Chain{{EvmChainID: 77001, Selector: 12, Name: "devnet-a", VarName: "DEVNET_A"}}, changes[1].Updated)
+
+	// Rejected loads change nothing.
+	require.Error(t, registry.LoadYAML([]byte("selectors:\n  77003:\n    selector: 12\n    name: devnet-c\n")))
+	require.Len(t, changes, 2)
+
+	cancel()
+	require.NoError(t, registry.LoadYAML([]byte("selectors:\n  77004:\n    selector: 41\n    name: devnet-d\n")))
+	assert.Len(t, changes, 2, "cancelled listeners are not called")
+}
+
+func TestRegistryOnChangeRemoteSync(t *testing.T) {
+	registry := NewRegistry()
+	var changes []ChangeSet
+	registry.OnChange(func(c ChangeSet) { changes = append(changes, c) })
+
+	source := DatasetSourceFunc(func(context.Context) ([]byte, error) {
+		return []byte(remoteDataset), nil
+	})
+	require.NoError(t, NewRemoteSync(registry, source).Sync(context.Background()))
+	require.Len(t, changes, 1)
+	assert.Equal(t, ProvenanceRemote, changes[0].Source)
+	require.Len(t, changes[0].Added, 1)
+	assert.Equal(t, uint64(9910100000000000001), changes[0].Added[0].Selector)
+}
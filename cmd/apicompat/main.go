@@ -0,0 +1,70 @@
+// Command apicompat verifies that the package keeps the exported API of the upstream
+// smartcontractkit/chain-selectors package it was forked from, as pinned in upstream_api.txt.
+//
+// Run it from the repository root:
+//
+//	go run ./cmd/apicompat                       # fail if a pinned symbol is missing or changed
+//	go run ./cmd/apicompat -pin <upstream dir>   # pin the API of an upstream checkout
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/fravlaca/chain-selectors/internal/apicompat"
+)
+
+const pinnedFile = "upstream_api.txt"
+
+func main() {
+	pin := flag.String("pin", "", "directory of an upstream checkout whose API is pinned")
+	version := flag.String("version", "", "upstream version pinned with -pin, recorded in the header")
+	flag.Parse()
+
+	if err := run(*pin, *version); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(pinDir, version string) error {
+	if pinDir != "" {
+		api, err := apicompat.Extract(pinDir)
+		if err != nil {
+			return err
+		}
+		f, err := os.Create(pinnedFile)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		header := "Exported API of github.com/smartcontractkit/chain-selectors " + version + "\n" +
+			"Generated by go run ./cmd/apicompat -pin, DO NOT EDIT"
+		return apicompat.Write(f, header, api)
+	}
+
+	f, err := os.Open(pinnedFile)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	pinned, err := apicompat.Read(f)
+	if err != nil {
+		return err
+	}
+	current, err := apicompat.Extract(".")
+	if err != nil {
+		return err
+	}
+
+	missing := apicompat.Missing(pinned, current)
+	for _, line := range missing {
+		fmt.Println("missing:", line)
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("%d upstream symbols are missing or changed", len(missing))
+	}
+	fmt.Printf("✅ all %d upstream symbols are provided\n", len(pinned))
+	return nil
+}
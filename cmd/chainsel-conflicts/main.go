@@ -0,0 +1,79 @@
+// Command chainsel-conflicts compares the selectors.yml datasets of forks or teams of the
+// package and reports the selector, chain id and name conflicts between them, with a suggested
+// resolution for each, see chainselectors.DetectDatasetConflicts:
+//
+//	go run github.com/fravlaca/chain-selectors/cmd/chainsel-conflicts@latest team-a=a/selectors.yml team-b=b/selectors.yml
+//
+// A dataset is named after its path unless given as name=path. With -official the datasets are
+// also compared with the one embedded in the package, and with -json the report is written as
+// JSON. It exits with 1 if the datasets conflict.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	chainselectors "github.com/fravlaca/chain-selectors"
+	"github.com/fravlaca/chain-selectors/evm"
+)
+
+func main() {
+	official := flag.Bool("official", false, "also compare with the dataset embedded in the package")
+	asJSON := flag.Bool("json", false, "write the report as JSON")
+	flag.Parse()
+	if flag.NArg() == 0 || flag.NArg() == 1 && !*official {
+		fmt.Fprintln(os.Stderr, "usage: chainsel-conflicts [-official] [-json] [name=]<selectors.yml> ...")
+		os.Exit(2)
+	}
+
+	conflicts, err := run(flag.Args(), *official, *asJSON)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	if conflicts > 0 {
+		os.Exit(1)
+	}
+}
+
+func run(args []string, official, asJSON bool) (int, error) {
+	var datasets []chainselectors.DatasetFile
+	if official {
+		dataset, err := chainselectors.ParseDatasetFile("official", evm.SelectorsYAML())
+		if err != nil {
+			return 0, err
+		}
+		datasets = append(datasets, dataset)
+	}
+	for _, arg := range args {
+		name, path := arg, arg
+		if before, after, found := strings.Cut(arg, "="); found {
+			name, path = before, after
+		}
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return 0, err
+		}
+		dataset, err := chainselectors.ParseDatasetFile(name, content)
+		if err != nil {
+			return 0, err
+		}
+		datasets = append(datasets, dataset)
+	}
+
+	report := chainselectors.DetectDatasetConflicts(datasets...)
+	if asJSON {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return len(report.Conflicts), encoder.Encode(report)
+	}
+	if len(report.Conflicts) == 0 {
+		fmt.Printf("✅ %d datasets agree on %d chains\n", len(report.Datasets), report.Chains)
+		return 0, nil
+	}
+	fmt.Printf("❌ %s", report)
+	return len(report.Conflicts), nil
+}
@@ -0,0 +1,76 @@
+// Command chainsel-discover probes an EVM node to onboard the chain it runs: it prints the chain
+// id, client version and genesis hash reported by the node, checks whether the chain is known
+// and otherwise prints the entry to add to evm/selectors.yml, with a selector proposed by
+// chainselectors.ProposeSelector:
+//
+//	go run github.com/fravlaca/chain-selectors/cmd/chainsel-discover@latest -rpc https://rpc.example.org -name ethereum-mainnet-example-1
+//
+// Chains reusing the chain id of a known chain get an entry for evm/selectors_forks.yml instead.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	chainselectors "github.com/fravlaca/chain-selectors"
+	"github.com/fravlaca/chain-selectors/devtools"
+)
+
+func main() {
+	rpcURL := flag.String("rpc", "", "url of the JSON-RPC endpoint of the node")
+	name := flag.String("name", "", "name of the chain, required to print its entry")
+	timeout := flag.Duration("timeout", 10*time.Second, "timeout of the queries to the node")
+	flag.Parse()
+	if *rpcURL == "" || flag.NArg() != 0 {
+		fmt.Fprintln(os.Stderr, "usage: chainsel-discover -rpc <url> [-name <chain name>]")
+		os.Exit(2)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+	if err := run(ctx, *rpcURL, *name); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, rpcURL, name string) error {
+	info, err := devtools.ProbeNode(ctx, http.DefaultClient, rpcURL)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("chain id:       %d\n", info.ChainID)
+	if info.ClientVersion != "" {
+		fmt.Printf("client version: %s\n", info.ClientVersion)
+	}
+	fmt.Printf("genesis hash:   %s\n", info.GenesisHash)
+
+	if selector, err := chainselectors.SelectorFromChainIDAndGenesis(info.ChainID, info.GenesisHash); err == nil {
+		known, _ := chainselectors.ChainBySelector(selector)
+		fmt.Printf("✅ chain %d is known as %s (%d)\n", info.ChainID, known.Name, selector)
+		return nil
+	}
+	_, reused := chainselectors.EvmChainIdToChainSelector()[info.ChainID]
+
+	if name == "" {
+		return fmt.Errorf("chain %d is not known, pass -name to print its entry", info.ChainID)
+	}
+	if existing, exists := chainselectors.ChainByName(name); exists {
+		return fmt.Errorf("name %s is already used by chain %d", name, existing.EvmChainID)
+	}
+	if !reused {
+		fmt.Printf("\n# evm/selectors.yml\n  %d:\n    selector: %d\n    name: %q\n", info.ChainID, chainselectors.ProposeSelector(info.ChainID, ""), name)
+		return nil
+	}
+
+	if known, _ := chainselectors.ChainByEvmChainID(info.ChainID); known.Name != "" {
+		fmt.Printf("\nchain id %d is already used by %s, the node runs another chain\n", info.ChainID, known.Name)
+	}
+	fmt.Printf("\n# evm/selectors_forks.yml\n  - chain_id: %d\n    genesis_hash: %s\n    selector: %d\n    name: %q\n",
+		info.ChainID, info.GenesisHash, chainselectors.ProposeSelector(info.ChainID, info.GenesisHash), name)
+	return nil
+}
@@ -0,0 +1,125 @@
+// Command chainsel-migrate rewrites the calls of deprecated functions of the selectors package
+// in the Go files of a module, for instance GetChainIDFromSelectorWithCustom to
+// GetChainIDFromSelector and ChainIdFromSelector(selector) to
+// EVMChainIDOf(Lookup(Selector(selector))). Uses it cannot rewrite, such as a deprecated
+// function passed as a value, are listed with the function to use instead.
+//
+// Run it from the root of the module to migrate:
+//
+//	go run github.com/fravlaca/chain-selectors/cmd/chainsel-migrate@latest -dry-run ./...
+//	go run github.com/fravlaca/chain-selectors/cmd/chainsel-migrate@latest ./...
+//
+// With -dry-run the changes are printed as a diff and no file is written, -diff prints the
+// diff of the files written otherwise.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/fravlaca/chain-selectors/internal/migrate"
+)
+
+func main() {
+	dryRun := flag.Bool("dry-run", false, "print the changes as a diff without writing the files")
+	diff := flag.Bool("diff", false, "print the changes as a diff")
+	flag.Parse()
+
+	patterns := flag.Args()
+	if len(patterns) == 0 {
+		patterns = []string{"./..."}
+	}
+
+	var rewrites, findings int
+	for _, pattern := range patterns {
+		files, err := goFiles(pattern)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		for _, path := range files {
+			r, f, err := migrateFile(path, *dryRun, *diff || *dryRun)
+			if err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				os.Exit(1)
+			}
+			rewrites += r
+			findings += f
+		}
+	}
+
+	action := "rewrote"
+	if *dryRun {
+		action = "would rewrite"
+	}
+	fmt.Fprintf(os.Stderr, "%s %d calls, %d calls need a manual migration\n", action, rewrites, findings)
+	if findings > 0 {
+		os.Exit(1)
+	}
+}
+
+func migrateFile(path string, dryRun, diff bool) (rewrites, findings int, err error) {
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return 0, 0, err
+	}
+	result, err := migrate.File(path, src)
+	if err != nil {
+		return 0, 0, err
+	}
+	for _, finding := range result.Findings {
+		fmt.Fprintln(os.Stderr, finding)
+	}
+	if result.Rewrites == 0 {
+		return 0, len(result.Findings), nil
+	}
+
+	if diff {
+		fmt.Print(migrate.Diff(filepath.ToSlash(path), src, result.Source))
+	}
+	if !dryRun {
+		info, err := os.Stat(path)
+		if err != nil {
+			return 0, 0, err
+		}
+		if err := os.WriteFile(path, result.Source, info.Mode().Perm()); err != nil {
+			return 0, 0, err
+		}
+	}
+	return result.Rewrites, len(result.Findings), nil
+}
+
+// goFiles returns the Go files of a directory, or of a directory tree for patterns ending in
+// "/...". Directories go ignores, such as vendor and testdata, are skipped.
+func goFiles(pattern string) ([]string, error) {
+	root, recursive := strings.CutSuffix(pattern, "/...")
+	if root == "" {
+		root = "."
+	}
+
+	var files []string
+	err := filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			if path == root {
+				return nil
+			}
+			name := entry.Name()
+			if !recursive || name == "vendor" || name == "testdata" || strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if strings.HasSuffix(path, ".go") {
+			files = append(files, path)
+		}
+		return nil
+	})
+	return files, err
+}
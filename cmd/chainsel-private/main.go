@@ -0,0 +1,155 @@
+// Command chainsel-private validates the registrar file of the private chains of a consortium
+// against the public dataset embedded in the package, see chainselectors.ParsePrivateChains:
+//
+//	go run github.com/fravlaca/chain-selectors/cmd/chainsel-private@latest private.yml
+//
+// Run it with the latest version of the package in the CI of the registrar file, so a chain id
+// or name taken by a new public chain is reported. With -next it also prints the lowest selector
+// of the private band not assigned yet, for the next chain to register.
+//
+// With -import it adds the private chains of a CSV export of a spreadsheet to the file, see
+// chainselectors.ImportCSV, reporting every invalid row. The columns are named after the fields
+// of the file unless mapped with -columns, e.g. -columns "chain_id=Network ID,name=Network".
+// The file is rewritten in chain id order, without its comments.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	chainselectors "github.com/fravlaca/chain-selectors"
+)
+
+func main() {
+	next := flag.Bool("next", false, "print the next free selector of the private band")
+	importPath := flag.String("import", "", "csv file of private chains to add to the file")
+	columns := flag.String("columns", "", "comma separated field=column mapping of the csv file")
+	flag.Parse()
+	if flag.NArg() != 1 {
+		fmt.Fprintln(os.Stderr, "usage: chainsel-private [-next] [-import <csv file> [-columns <mapping>]] <private chains file>")
+		os.Exit(2)
+	}
+
+	if *importPath != "" {
+		if err := runImport(flag.Arg(0), *importPath, *columns); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	}
+	if err := run(flag.Arg(0), *next); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(path string, next bool) error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	chains, err := chainselectors.ParsePrivateChains(content)
+	var multi *chainselectors.MultiError
+	if errors.As(err, &multi) {
+		for _, itemErr := range multi.Errors {
+			fmt.Printf("❌ %s\n", itemErr)
+		}
+		return fmt.Errorf("%d invalid private chains in %s", len(multi.Errors), path)
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Printf("✅ %d private chains\n", len(chains))
+
+	if next {
+		selector, err := nextFreeSelector(chains)
+		if err != nil {
+			return err
+		}
+		fmt.Println(selector)
+	}
+	return nil
+}
+
+func runImport(path, csvPath, columns string) error {
+	mapping, err := parseMapping(columns)
+	if err != nil {
+		return err
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	existing, err := chainselectors.ParsePrivateChains(content)
+	if err != nil {
+		return fmt.Errorf("invalid private chains in %s: %w", path, err)
+	}
+	csvFile, err := os.Open(csvPath)
+	if err != nil {
+		return err
+	}
+	defer csvFile.Close()
+
+	imported, err := chainselectors.ImportCSV(csvFile, mapping, existing...)
+	var multi *chainselectors.MultiError
+	if errors.As(err, &multi) {
+		for _, itemErr := range multi.Errors {
+			fmt.Printf("❌ %s\n", itemErr)
+		}
+		return fmt.Errorf("%d invalid rows in %s", len(multi.Indexes()), csvPath)
+	}
+	if err != nil {
+		return err
+	}
+
+	yml, err := chainselectors.EncodePrivateChains(append(existing, imported...))
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(path, yml, 0o644); err != nil {
+		return err
+	}
+	fmt.Printf("✅ imported %d private chains from %s\n", len(imported), csvPath)
+	return nil
+}
+
+// parseMapping parses a comma separated list of field=column pairs overriding the columns of
+// chainselectors.DefaultCSVMapping, an empty column meaning the csv file has none.
+func parseMapping(columns string) (chainselectors.CSVMapping, error) {
+	mapping := chainselectors.DefaultCSVMapping
+	if columns == "" {
+		return mapping, nil
+	}
+	fields := map[string]*string{
+		"chain_id": &mapping.ChainID,
+		"selector": &mapping.Selector,
+		"name":     &mapping.Name,
+		"owner":    &mapping.Owner,
+		"contact":  &mapping.Contact,
+	}
+	for _, pair := range strings.Split(columns, ",") {
+		field, column, found := strings.Cut(pair, "=")
+		target, known := fields[strings.TrimSpace(field)]
+		if !found || !known {
+			return mapping, fmt.Errorf("invalid column mapping %q, expected <field>=<column> with a field among chain_id, selector, name, owner and contact", pair)
+		}
+		*target = column
+	}
+	return mapping, nil
+}
+
+// nextFreeSelector returns the lowest selector of the private band no chain uses.
+func nextFreeSelector(chains []chainselectors.PrivateChain) (uint64, error) {
+	used := make(map[uint64]bool, len(chains))
+	for _, ch := range chains {
+		used[ch.Selector] = true
+	}
+	for offset := uint64(0); offset < chainselectors.PrivateSelectorCount; offset++ {
+		if selector := chainselectors.PrivateSelectorStart + offset; !used[selector] {
+			return selector, nil
+		}
+	}
+	return 0, errors.New("the private band is full")
+}
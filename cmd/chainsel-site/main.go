@@ -0,0 +1,53 @@
+// Command chainsel-site generates a static chain directory: JSON documents and searchable HTML
+// pages of every chain, which can be published to object storage without running a server.
+// The chains are the embedded ones merged with the override files given as arguments, in the
+// selectors.yml format:
+//
+//	go run github.com/fravlaca/chain-selectors/cmd/chainsel-site@latest -out public overrides/*.yml
+//
+// Either every override file is loaded or, if one is invalid, nothing is generated.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	chainselectors "github.com/fravlaca/chain-selectors"
+	"github.com/fravlaca/chain-selectors/internal/site"
+)
+
+func main() {
+	out := flag.String("out", "site", "directory to generate the chain directory in")
+	testChains := flag.Bool("test-chains", false, "include the chains of the test selector files")
+	flag.Parse()
+
+	if err := run(*out, *testChains, flag.Args()); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(out string, testChains bool, overrides []string) error {
+	registry := chainselectors.NewRegistry(chainselectors.WithTestChains(testChains))
+	tx := registry.Begin()
+	defer tx.Rollback()
+	for _, path := range overrides {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		if err := tx.LoadYAML(content); err != nil {
+			return fmt.Errorf("failed to load %s: %w", path, err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	if err := site.Generate(out, registry); err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "generated the directory of %d chains in %s\n", len(registry.Chains()), out)
+	return nil
+}
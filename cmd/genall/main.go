@@ -0,0 +1,152 @@
+// Command genall regenerates every generated file of the repository in a single pass. Run it
+// from the repository root after editing a dataset:
+//
+//	go run ./cmd/genall          # regenerate
+//	go run ./cmd/genall -verify  # fail if a generated file is outdated, e.g. in CI
+//
+// The generators run in a fixed order, the constants of every family first, then the files
+// derived from the datasets as a whole and last the build stamp, see BuildInfo. Before running
+// them it checks that the order lists exactly the generators of the go:generate directives, so
+// a new generator cannot be left out. After regenerating, every generator is run again with
+// -verify to check the pass is stable.
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// generators lists the generators of the repository root in the order they run.
+var generators = []string{
+	"genchains_evm.go",
+	"genchains_solana.go",
+	"genchains_aptos.go",
+	"genchains_sui.go",
+	"genchains_tron.go",
+	"genchains_ton.go",
+	"genanalysis.go",
+	"genbinary.go",
+	"genbuildinfo.go",
+}
+
+func main() {
+	verify := flag.Bool("verify", false, "report outdated generated files instead of rewriting them")
+	flag.Parse()
+	if flag.NArg() != 0 {
+		fmt.Fprintln(os.Stderr, "usage: genall [-verify]")
+		os.Exit(2)
+	}
+
+	if err := run(*verify); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(verify bool) error {
+	directives, err := generateDirectives(".")
+	if err != nil {
+		return err
+	}
+	if err := checkGenerators(directives); err != nil {
+		return err
+	}
+
+	if !verify {
+		for _, generator := range generators {
+			if err := goRun(generator); err != nil {
+				return fmt.Errorf("❌ %s failed: %w", generator, err)
+			}
+		}
+	}
+
+	var outdated []string
+	for _, generator := range generators {
+		if err := goRun(generator, "-verify"); err != nil {
+			outdated = append(outdated, generator)
+		}
+	}
+	if len(outdated) > 0 {
+		if verify {
+			return fmt.Errorf("❌ outdated generated files, run go run ./cmd/genall: %s", strings.Join(outdated, ", "))
+		}
+		return fmt.Errorf("❌ generators are not stable, a second run changes their files: %s", strings.Join(outdated, ", "))
+	}
+	fmt.Printf("✅ %d generators up to date\n", len(generators))
+	return nil
+}
+
+// generateDirectives returns the generators run by the `//go:generate go run <file>` directives
+// of the Go files of dir.
+func generateDirectives(dir string) ([]string, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+	var directives []string
+	for _, path := range files {
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		scanner := bufio.NewScanner(file)
+		for scanner.Scan() {
+			if generator, found := strings.CutPrefix(scanner.Text(), "//go:generate go run "); found {
+				directives = append(directives, strings.TrimSpace(generator))
+			}
+		}
+		err = scanner.Err()
+		file.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", path, err)
+		}
+	}
+	if len(directives) == 0 {
+		return nil, fmt.Errorf("no go:generate directive in %s, run genall from the repository root", dir)
+	}
+	return directives, nil
+}
+
+// checkGenerators checks that the go:generate directives run exactly the listed generators.
+func checkGenerators(directives []string) error {
+	listed := make(map[string]bool, len(generators))
+	for _, generator := range generators {
+		listed[generator] = true
+	}
+	directed := make(map[string]bool, len(directives))
+	var missing, extra []string
+	for _, generator := range directives {
+		directed[generator] = true
+		if !listed[generator] {
+			missing = append(missing, generator)
+		}
+	}
+	for _, generator := range generators {
+		if !directed[generator] {
+			extra = append(extra, generator)
+		}
+	}
+	sort.Strings(missing)
+	sort.Strings(extra)
+	if len(missing) > 0 {
+		return fmt.Errorf("❌ go:generate runs generators genall does not: %s", strings.Join(missing, ", "))
+	}
+	if len(extra) > 0 {
+		return fmt.Errorf("❌ genall runs generators without a go:generate directive: %s", strings.Join(extra, ", "))
+	}
+	return nil
+}
+
+// goRun runs the generator, its output going to the output of genall.
+func goRun(generator string, args ...string) error {
+	cmd := exec.Command("go", append([]string{"run", generator}, args...)...)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
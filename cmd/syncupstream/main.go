@@ -0,0 +1,117 @@
+// Command syncupstream merges the selector datasets of the upstream
+// smartcontractkit/chain-selectors repository into this fork and regenerates the code.
+//
+// Run it from the repository root:
+//
+//	go run ./cmd/syncupstream                          # merge upstream main
+//	go run ./cmd/syncupstream -upstream ../upstream    # merge a local upstream checkout
+//
+// Each dataset is three-way merged: the upstream datasets merged last, kept in the upstream
+// directory, are the base. If the fork and upstream changed a chain differently, for instance
+// gave the same chain id different selectors, the conflicts are reported and nothing is written.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/fravlaca/chain-selectors/internal/upstreamsync"
+)
+
+const (
+	defaultUpstream = "https://raw.githubusercontent.com/smartcontractkit/chain-selectors/main"
+	baseDir         = "upstream"
+)
+
+// datasets maps the datasets merged, named like at the root of the upstream repository and in
+// the upstream directory, to their path in the fork.
+var datasets = []struct{ name, path string }{
+	{"selectors.yml", filepath.Join("evm", "selectors.yml")},
+	{"test_selectors.yml", filepath.Join("evm", "test_selectors.yml")},
+}
+
+func main() {
+	upstream := flag.String("upstream", defaultUpstream, "raw file URL prefix or directory of the upstream repository")
+	generate := flag.Bool("generate", true, "regenerate the code after merging")
+	flag.Parse()
+
+	if err := run(*upstream, *generate); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(upstream string, generate bool) error {
+	merged := make(map[string][]byte, len(datasets))
+	fetched := make(map[string][]byte, len(datasets))
+	var conflicts int
+	for _, dataset := range datasets {
+		name := dataset.name
+		theirs, err := fetch(upstream, name)
+		if err != nil {
+			return fmt.Errorf("failed to fetch upstream %s: %w", name, err)
+		}
+		base, err := os.ReadFile(filepath.Join(baseDir, name))
+		if err != nil {
+			return err
+		}
+		ours, err := os.ReadFile(dataset.path)
+		if err != nil {
+			return err
+		}
+
+		result, fileConflicts, err := upstreamsync.Merge(base, ours, theirs)
+		if err != nil {
+			return fmt.Errorf("failed to merge %s: %w", name, err)
+		}
+		for _, conflict := range fileConflicts {
+			fmt.Printf("❌ %s: %s\n", name, conflict)
+		}
+		conflicts += len(fileConflicts)
+		merged[name] = result
+		fetched[name] = theirs
+	}
+	if conflicts > 0 {
+		return fmt.Errorf("%d conflicts, resolve them in the fork datasets and sync again", conflicts)
+	}
+
+	for _, dataset := range datasets {
+		name := dataset.name
+		if err := os.WriteFile(dataset.path, merged[name], 0o644); err != nil {
+			return err
+		}
+		if err := os.WriteFile(filepath.Join(baseDir, name), fetched[name], 0o644); err != nil {
+			return err
+		}
+	}
+	fmt.Println("✅ merged upstream datasets")
+
+	if !generate {
+		return nil
+	}
+	cmd := exec.Command("go", "generate", ".")
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
+
+func fetch(upstream, name string) ([]byte, error) {
+	if !strings.HasPrefix(upstream, "http://") && !strings.HasPrefix(upstream, "https://") {
+		return os.ReadFile(filepath.Join(upstream, name))
+	}
+	resp, err := http.Get(strings.TrimSuffix(upstream, "/") + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
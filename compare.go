@@ -0,0 +1,83 @@
+package chain_selectors
+
+import (
+	"strconv"
+	"strings"
+)
+
+// CompareByName orders selectors by the names of their chains, returning a negative number,
+// zero or a positive number like strings.Compare, e.g. to sort selectors with slices.SortFunc.
+// Selectors are identifiers, their numeric order says nothing about the chains. Selectors of
+// unknown chains are ordered after every known chain.
+func CompareByName(a, b Selector) int {
+	infoA, errA := getChainInfo(uint64(a))
+	infoB, errB := getChainInfo(uint64(b))
+	if c := compareKnown(a, b, errA == nil, errB == nil); c != 0 || errA != nil || errB != nil {
+		return c
+	}
+	if c := strings.Compare(infoA.ChainDetails.ChainName, infoB.ChainDetails.ChainName); c != 0 {
+		return c
+	}
+	return compareSelectorValues(a, b)
+}
+
+// CompareByChainID orders selectors by the family and then the chain id of their chains,
+// numerically for numeric chain ids. Selectors of unknown chains are ordered after every known
+// chain.
+func CompareByChainID(a, b Selector) int {
+	infoA, errA := getChainInfo(uint64(a))
+	infoB, errB := getChainInfo(uint64(b))
+	if c := compareKnown(a, b, errA == nil, errB == nil); c != 0 || errA != nil || errB != nil {
+		return c
+	}
+	if c := strings.Compare(infoA.Family, infoB.Family); c != 0 {
+		return c
+	}
+	if c := compareChainIDs(infoA.ChainID, infoB.ChainID); c != 0 {
+		return c
+	}
+	return compareSelectorValues(a, b)
+}
+
+// compareKnown orders known chains first, and unknown selectors between themselves by value
+// so the order is deterministic.
+func compareKnown(a, b Selector, knownA, knownB bool) int {
+	switch {
+	case knownA && !knownB:
+		return -1
+	case !knownA && knownB:
+		return 1
+	case !knownA && !knownB:
+		return compareSelectorValues(a, b)
+	}
+	return 0
+}
+
+func compareChainIDs(a, b string) int {
+	if unsignedA, errA := strconv.ParseUint(a, 10, 64); errA == nil {
+		if unsignedB, errB := strconv.ParseUint(b, 10, 64); errB == nil {
+			return compareNumbers(unsignedA, unsignedB)
+		}
+	}
+	if signedA, errA := strconv.ParseInt(a, 10, 64); errA == nil {
+		if signedB, errB := strconv.ParseInt(b, 10, 64); errB == nil {
+			return compareNumbers(signedA, signedB)
+		}
+	}
+	return strings.Compare(a, b)
+}
+
+func compareNumbers[T int64 | uint64 | Selector](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// compareSelectorValues is the tie-breaker keeping comparisons total, it carries no meaning.
+func compareSelectorValues(a, b Selector) int {
+	return compareNumbers(a, b)
+}
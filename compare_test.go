@@ -0,0 +1,48 @@
+package chain_selectors
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompareByName(t *testing.T) {
+	selectors := []Selector{
+		Selector(ETHEREUM_TESTNET_SEPOLIA.Selector),
+		999,
+		Selector(ETHEREUM_MAINNET.Selector),
+		Selector(BINANCE_SMART_CHAIN_MAINNET.Selector),
+		998,
+	}
+	sort.Slice(selectors, func(i, j int) bool { return CompareByName(selectors[i], selectors[j]) < 0 })
+
+	assert.Equal(t, []Selector{
+		Selector(BINANCE_SMART_CHAIN_MAINNET.Selector),
+		Selector(ETHEREUM_MAINNET.Selector),
+		Selector(ETHEREUM_TESTNET_SEPOLIA.Selector),
+		998,
+		999,
+	}, selectors)
+	assert.Zero(t, CompareByName(Selector(ETHEREUM_MAINNET.Selector), Selector(ETHEREUM_MAINNET.Selector)))
+}
+
+func TestCompareByChainID(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b uint64
+		want int
+	}{
+		{"numeric chain ids", ETHEREUM_MAINNET.Selector, BINANCE_SMART_CHAIN_MAINNET.Selector, -1},
+		{"numeric not lexicographic", POLYGON_MAINNET.Selector, BINANCE_SMART_CHAIN_MAINNET.Selector, 1},
+		{"families first", SOLANA_MAINNET.Selector, ETHEREUM_MAINNET.Selector, 1},
+		{"known before unknown", ETHEREUM_MAINNET.Selector, 1, -1},
+		{"equal", ETHEREUM_MAINNET.Selector, ETHEREUM_MAINNET.Selector, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, CompareByChainID(Selector(tt.a), Selector(tt.b)))
+			assert.Equal(t, -tt.want, CompareByChainID(Selector(tt.b), Selector(tt.a)))
+		})
+	}
+}
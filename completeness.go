@@ -0,0 +1,162 @@
+package chain_selectors
+
+import (
+	"sort"
+)
+
+// MetadataField names a piece of optional chain data a chain may lack.
+type MetadataField string
+
+const (
+	FieldDisplayName    MetadataField = "display_name"
+	FieldNativeCurrency MetadataField = "native_currency"
+	FieldExplorer       MetadataField = "explorer"
+	FieldRPC            MetadataField = "rpc"
+	FieldFinality       MetadataField = "finality"
+	FieldCoinType       MetadataField = "coin_type"
+	FieldLogo           MetadataField = "logo"
+	FieldBrandColor     MetadataField = "brand_color"
+	FieldWebsite        MetadataField = "website"
+	FieldDocs           MetadataField = "docs"
+	FieldFaucet         MetadataField = "faucet"
+	FieldGasConfig      MetadataField = "gas_config"
+)
+
+// MetadataFields lists every field checked by Completeness.
+var MetadataFields = []MetadataField{
+	FieldDisplayName,
+	FieldNativeCurrency,
+	FieldExplorer,
+	FieldRPC,
+	FieldFinality,
+	FieldCoinType,
+	FieldLogo,
+	FieldBrandColor,
+	FieldWebsite,
+	FieldDocs,
+	FieldFaucet,
+	FieldGasConfig,
+}
+
+// ChainCompleteness lists the optional data a chain lacks.
+type ChainCompleteness struct {
+	Selector uint64
+	Family   string
+	Name     string
+	Missing  []MetadataField
+}
+
+// Complete reports whether the chain lacks nothing.
+func (c ChainCompleteness) Complete() bool {
+	return len(c.Missing) == 0
+}
+
+// Has reports whether the chain has the field.
+func (c ChainCompleteness) Has(field MetadataField) bool {
+	for _, missing := range c.Missing {
+		if missing == field {
+			return false
+		}
+	}
+	return true
+}
+
+// CompletenessReport lists the optional data every official and test chain lacks.
+type CompletenessReport struct {
+	// Chains is ordered by family and then name.
+	Chains []ChainCompleteness
+	// MissingByField counts the chains lacking each field.
+	MissingByField map[MetadataField]int
+
+	bySelector map[uint64]int
+}
+
+// Chain returns the completeness of the chain identified by the selector, false if the chain is
+// not in the report.
+func (r CompletenessReport) Chain(selector uint64) (ChainCompleteness, bool) {
+	i, exists := r.bySelector[selector]
+	if !exists {
+		return ChainCompleteness{}, false
+	}
+	return r.Chains[i], true
+}
+
+// Has reports whether the chain identified by the selector has the field, so services can
+// degrade gracefully, e.g. hide explorer links. Chains not in the report have nothing.
+func (r CompletenessReport) Has(selector uint64, field MetadataField) bool {
+	chain, exists := r.Chain(selector)
+	return exists && chain.Has(field)
+}
+
+// Completeness reports, for every official and test chain, which optional data of metadata.yml
+// and gas.yml it lacks, so dataset maintainers can prioritise enrichment. The report is a
+// snapshot, build it once rather than per request.
+func Completeness() CompletenessReport {
+	report := CompletenessReport{
+		MissingByField: make(map[MetadataField]int, len(MetadataFields)),
+		bySelector:     make(map[uint64]int),
+	}
+	for _, family := range allFamilies {
+		for _, selector := range knownSelectors(family) {
+			info, err := getChainInfo(selector)
+			if err != nil {
+				continue
+			}
+			chain := ChainCompleteness{
+				Selector: selector,
+				Family:   family,
+				Name:     info.ChainDetails.ChainName,
+				Missing:  missingFields(selector, family),
+			}
+			for _, field := range chain.Missing {
+				report.MissingByField[field]++
+			}
+			report.Chains = append(report.Chains, chain)
+		}
+	}
+
+	sort.Slice(report.Chains, func(i, j int) bool {
+		a, b := report.Chains[i], report.Chains[j]
+		if a.Family != b.Family {
+			return a.Family < b.Family
+		}
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		return a.Selector < b.Selector
+	})
+	for i, chain := range report.Chains {
+		report.bySelector[chain.Selector] = i
+	}
+	return report
+}
+
+// missingFields returns the fields the chain lacks. Gas configurations only apply to EVM chains
+// and faucets to chains that are not mainnets.
+func missingFields(selector uint64, family string) []MetadataField {
+	metadata := metadataBySelector()[selector]
+	_, hasGasConfig := gasConfigsBySelector()[selector]
+	environment, _ := GetSelectorEnvironment(selector)
+
+	present := map[MetadataField]bool{
+		FieldDisplayName:    metadata.DisplayName != "",
+		FieldNativeCurrency: metadata.NativeCurrency.Symbol != "",
+		FieldExplorer:       len(metadata.Explorers) > 0,
+		FieldRPC:            len(metadata.RPCs) > 0,
+		FieldFinality:       metadata.FinalityDepth > 0,
+		FieldCoinType:       metadata.CoinType > 0,
+		FieldLogo:           metadata.LogoURI != "",
+		FieldBrandColor:     metadata.BrandColor != "",
+		FieldWebsite:        metadata.Website != "",
+		FieldDocs:           metadata.Docs != "",
+		FieldFaucet:         len(metadata.Faucets) > 0 || environment == EnvironmentMainnet,
+		FieldGasConfig:      hasGasConfig || family != FamilyEVM,
+	}
+	var missing []MetadataField
+	for _, field := range MetadataFields {
+		if !present[field] {
+			missing = append(missing, field)
+		}
+	}
+	return missing
+}
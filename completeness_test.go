@@ -0,0 +1,41 @@
+package chain_selectors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompleteness(t *testing.T) {
+	report := Completeness()
+	assert.Len(t, report.Chains, Stats().Total)
+
+	optimism, exists := report.Chain(ETHEREUM_MAINNET_OPTIMISM_1.Selector)
+	require.True(t, exists)
+	assert.Equal(t, FamilyEVM, optimism.Family)
+	assert.Equal(t, ETHEREUM_MAINNET_OPTIMISM_1.Name, optimism.Name)
+	for _, field := range []MetadataField{FieldDisplayName, FieldNativeCurrency, FieldExplorer, FieldRPC} {
+		assert.True(t, report.Has(optimism.Selector, field), field)
+	}
+	assert.False(t, report.Has(optimism.Selector, FieldFinality))
+	assert.False(t, optimism.Complete())
+
+	solana, exists := report.Chain(SOLANA_MAINNET.Selector)
+	require.True(t, exists)
+	assert.True(t, solana.Has(FieldGasConfig), "gas configurations only apply to EVM chains")
+
+	_, exists = report.Chain(42)
+	assert.False(t, exists)
+	assert.False(t, report.Has(42, FieldRPC))
+
+	for _, field := range MetadataFields {
+		count := 0
+		for _, chain := range report.Chains {
+			if !chain.Has(field) {
+				count++
+			}
+		}
+		assert.Equal(t, count, report.MissingByField[field], field)
+	}
+}
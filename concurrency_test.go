@@ -0,0 +1,58 @@
+package chain_selectors
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestConcurrentLookupsAndRegistrations exercises every mutable path alongside lookups,
+// run it with -race to enforce the concurrency contract documented in the package docs.
+func TestConcurrentLookupsAndRegistrations(t *testing.T) {
+	const workers = 8
+	const iterations = 200
+
+	registry := NewRegistry(WithTestChains(false))
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(3)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < iterations; i++ {
+				RegisterCustomChain(uint64(987000000000+w*iterations+i), "stress-devnet-"+strconv.Itoa(i))
+			}
+		}(w)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < iterations/10; i++ {
+				chainID := strconv.Itoa(986000000000 + w*iterations + i)
+				yml := "selectors:\n  " + chainID + ":\n    selector: " + chainID + "\n    name: stress-loaded-" + chainID + "\n"
+				assert.NoError(t, registry.LoadYAML([]byte(yml)))
+			}
+		}(w)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < iterations; i++ {
+				ch := ALL[i%len(ALL)]
+
+				_, err := GetChainDetailsBySelector(ch.Selector)
+				assert.NoError(t, err)
+				_, exists := ChainByName(ch.Name)
+				assert.True(t, exists)
+				_, _ = registry.ChainBySelector(ch.Selector)
+				_, _ = registry.ChainByEvmChainID(uint64(986000000000 + i))
+				_ = IsTestChain(ch.Selector)
+				if i%50 == 0 {
+					_ = Stats()
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.GreaterOrEqual(t, customChains.count(), workers*iterations)
+	assert.Len(t, registry.loadState().evmByChainID, workers*iterations/10)
+}
@@ -0,0 +1,161 @@
+// Package consensus lets services compare the chain mappings they run with, so a service
+// vendoring a stale dataset is detected before it routes messages differently than its peers.
+// Every service serves the fingerprint of its registry with Handler and checks its peers with
+// CompareFingerprints at startup.
+package consensus
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	chainselectors "github.com/fravlaca/chain-selectors"
+)
+
+// Path is where Handler is expected to be mounted on every peer.
+const Path = "/chainselectors/fingerprint"
+
+type fingerprintResponse struct {
+	Fingerprint string `json:"fingerprint"`
+}
+
+// Handler serves the fingerprint of the registry as {"fingerprint": "..."}, see
+// Registry.Fingerprint. The fingerprint is computed when the handler is created and again
+// whenever chains are loaded into the registry.
+func Handler(registry *chainselectors.Registry) http.Handler {
+	var body atomic.Pointer[[]byte]
+	update := func() {
+		b, err := json.Marshal(fingerprintResponse{Fingerprint: registry.Fingerprint()})
+		if err != nil {
+			panic(err)
+		}
+		body.Store(&b)
+	}
+	update()
+	registry.OnChange(func(chainselectors.ChangeSet) { update() })
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.Header().Set("Allow", "GET, HEAD")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(*body.Load())
+	})
+}
+
+// Comparison is the outcome of CompareFingerprints.
+type Comparison struct {
+	// Peers lists the peers answering with each fingerprint, sorted.
+	Peers map[string][]string
+	// Unreachable holds why peers could not be asked for their fingerprint.
+	Unreachable map[string]error
+}
+
+// Diverged reports whether the peers answered with different fingerprints.
+func (c *Comparison) Diverged() bool {
+	return len(c.Peers) > 1
+}
+
+// Err returns an error describing the divergence and the unreachable peers, nil if every peer
+// answered with the same fingerprint.
+func (c *Comparison) Err() error {
+	var problems []string
+	if c.Diverged() {
+		fingerprints := make([]string, 0, len(c.Peers))
+		for fingerprint := range c.Peers {
+			fingerprints = append(fingerprints, fingerprint)
+		}
+		sort.Strings(fingerprints)
+		for _, fingerprint := range fingerprints {
+			problems = append(problems, fmt.Sprintf("%s run %s", strings.Join(c.Peers[fingerprint], ", "), fingerprint))
+		}
+	}
+	peers := make([]string, 0, len(c.Unreachable))
+	for peer := range c.Unreachable {
+		peers = append(peers, peer)
+	}
+	sort.Strings(peers)
+	for _, peer := range peers {
+		problems = append(problems, fmt.Sprintf("%s unreachable: %v", peer, c.Unreachable[peer]))
+	}
+	if len(problems) == 0 {
+		return nil
+	}
+	return fmt.Errorf("chain selectors fingerprints differ: %s", strings.Join(problems, "; "))
+}
+
+// CompareFingerprints asks the peers, given as base URLs, for their fingerprint and groups them
+// by the fingerprint they answered with. Use CompareWith to include the local registry.
+func CompareFingerprints(ctx context.Context, peers []string) *Comparison {
+	return CompareFingerprintsWithClient(ctx, http.DefaultClient, peers)
+}
+
+// CompareFingerprintsWithClient is CompareFingerprints using the given HTTP client.
+func CompareFingerprintsWithClient(ctx context.Context, client *http.Client, peers []string) *Comparison {
+	comparison := &Comparison{Peers: make(map[string][]string), Unreachable: make(map[string]error)}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for _, peer := range peers {
+		wg.Add(1)
+		go func(peer string) {
+			defer wg.Done()
+			fingerprint, err := fetchFingerprint(ctx, client, peer)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				comparison.Unreachable[peer] = err
+				return
+			}
+			comparison.Peers[fingerprint] = append(comparison.Peers[fingerprint], peer)
+		}(peer)
+	}
+	wg.Wait()
+
+	for _, group := range comparison.Peers {
+		sort.Strings(group)
+	}
+	return comparison
+}
+
+// CompareWith compares the fingerprint of the local registry with the ones of the peers, the
+// local registry being reported as the peer "local".
+func CompareWith(ctx context.Context, registry *chainselectors.Registry, peers []string) *Comparison {
+	comparison := CompareFingerprints(ctx, peers)
+	local := registry.Fingerprint()
+	comparison.Peers[local] = append([]string{"local"}, comparison.Peers[local]...)
+	return comparison
+}
+
+func fetchFingerprint(ctx context.Context, client *http.Client, peer string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(peer, "/")+Path, nil)
+	if err != nil {
+		return "", fmt.Errorf("invalid peer url %q: %w", peer, err)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("fingerprint endpoint returned status %s", resp.Status)
+	}
+	var result fingerprintResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return "", fmt.Errorf("failed to decode fingerprint: %w", err)
+	}
+	if result.Fingerprint == "" {
+		return "", errors.New("fingerprint endpoint returned no fingerprint")
+	}
+	return result.Fingerprint, nil
+}
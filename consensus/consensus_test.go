@@ -0,0 +1,67 @@
+package consensus
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	chainselectors "github.com/fravlaca/chain-selectors"
+)
+
+func newPeer(t *testing.T, registry *chainselectors.Registry) string {
+	mux := http.NewServeMux()
+	mux.Handle(Path, Handler(registry))
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server.URL
+}
+
+func TestCompareFingerprints(t *testing.T) {
+	a := newPeer(t, chainselectors.NewRegistry())
+	b := newPeer(t, chainselectors.NewRegistry())
+
+	comparison := CompareFingerprints(context.Background(), []string{a, b + "/"})
+	assert.False(t, comparison.Diverged())
+	assert.NoError(t, comparison.Err())
+	assert.NoError(t, CompareWith(context.Background(), chainselectors.NewRegistry(), []string{a, b}).Err())
+
+	stale := newPeer(t, chainselectors.NewRegistry(chainselectors.WithTestChains(false)))
+	comparison = CompareFingerprints(context.Background(), []string{a, b, stale})
+	assert.True(t, comparison.Diverged())
+	require.Error(t, comparison.Err())
+	assert.Contains(t, comparison.Err().Error(), stale)
+}
+
+func TestCompareFingerprintsUnreachable(t *testing.T) {
+	a := newPeer(t, chainselectors.NewRegistry())
+	broken := httptest.NewServer(http.NotFoundHandler())
+	t.Cleanup(broken.Close)
+
+	comparison := CompareFingerprints(context.Background(), []string{a, broken.URL, "://invalid"})
+	assert.False(t, comparison.Diverged())
+	assert.Len(t, comparison.Unreachable, 2)
+	require.Error(t, comparison.Err())
+}
+
+func TestHandlerMethods(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	Handler(chainselectors.NewRegistry()).ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, Path, nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+}
+
+func TestHandlerFollowsLoads(t *testing.T) {
+	registry := chainselectors.NewRegistry()
+	peer := newPeer(t, registry)
+
+	yml := []byte("selectors:\n  77001:\n    selector: 7700100000000000001\n    name: consensus-devnet\n")
+	require.NoError(t, registry.LoadYAML(yml))
+	loaded := chainselectors.NewRegistry()
+	require.NoError(t, loaded.LoadYAML(yml))
+
+	assert.NoError(t, CompareWith(context.Background(), loaded, []string{peer}).Err())
+	assert.Error(t, CompareWith(context.Background(), chainselectors.NewRegistry(), []string{peer}).Err())
+}
@@ -0,0 +1,171 @@
+package chain_selectors
+
+import (
+	"context"
+	"crypto/sha256"
+	_ "embed"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+)
+
+//go:embed contracts.yml
+var contractsYml []byte
+
+// WellKnownContract is the name of a contract deployed at a widely known address.
+type WellKnownContract string
+
+const (
+	ContractWrappedNative   WellKnownContract = "wrapped-native"
+	ContractMulticall3      WellKnownContract = "multicall3"
+	ContractCreate2Deployer WellKnownContract = "create2-deployer"
+)
+
+var knownContracts = map[WellKnownContract]struct{}{
+	ContractWrappedNative:   {},
+	ContractMulticall3:      {},
+	ContractCreate2Deployer: {},
+}
+
+var evmAddressFormat = regexp.MustCompile(`^0x[0-9a-fA-F]{40}$`)
+
+var sha256Format = regexp.MustCompile(`^[0-9a-f]{64}$`)
+
+// contractsIndexes holds both indexes parsed from contracts.yml.
+type contractsIndexes struct {
+	bySelector map[uint64]map[WellKnownContract]string
+	codeHashes map[WellKnownContract]string
+}
+
+var contractsDataset = onceValue(func() contractsIndexes {
+	bySelector, codeHashes := parseContractsYml(contractsYml)
+	return contractsIndexes{bySelector: bySelector, codeHashes: codeHashes}
+})
+
+func contractsBySelector() map[uint64]map[WellKnownContract]string {
+	return contractsDataset().bySelector
+}
+
+func codeHashesByContract() map[WellKnownContract]string {
+	return contractsDataset().codeHashes
+}
+
+func parseContractsYml(ymlFile []byte) (map[uint64]map[WellKnownContract]string, map[WellKnownContract]string) {
+	type ymlData struct {
+		Contracts  map[uint64]map[WellKnownContract]string `yaml:"contracts"`
+		CodeHashes map[WellKnownContract]string            `yaml:"code_hashes"`
+	}
+
+	var data ymlData
+	err := yaml.Unmarshal(ymlFile, &data)
+	if err != nil {
+		panic(err)
+	}
+
+	validateContracts(data.Contracts)
+	validateCodeHashes(data.CodeHashes)
+	return data.Contracts, data.CodeHashes
+}
+
+func validateCodeHashes(data map[WellKnownContract]string) {
+	for name, hash := range data {
+		if _, known := knownContracts[name]; !known {
+			panic(fmt.Errorf("code hash of unknown contract %q", name))
+		}
+		if !sha256Format.MatchString(hash) {
+			panic(fmt.Errorf("invalid %s code hash %q", name, hash))
+		}
+	}
+}
+
+func validateContracts(data map[uint64]map[WellKnownContract]string) {
+	for selector, contracts := range data {
+		for name, address := range contracts {
+			if _, known := knownContracts[name]; !known {
+				panic(fmt.Errorf("unknown contract %q for selector %d", name, selector))
+			}
+			if !evmAddressFormat.MatchString(address) {
+				panic(fmt.Errorf("invalid %s address %q for selector %d", name, address, selector))
+			}
+		}
+	}
+}
+
+// GetWellKnownContract returns the address of the named contract on the chain identified by the selector.
+func GetWellKnownContract(selector uint64, name WellKnownContract) (string, error) {
+	if _, known := knownContracts[name]; !known {
+		return "", fmt.Errorf("unknown contract %s", name)
+	}
+
+	address, exist := contractsBySelector()[selector][name]
+	if !exist {
+		return "", fmt.Errorf("contract %s not found for selector %d", name, selector)
+	}
+	return address, nil
+}
+
+var (
+	// ErrContractNotDeployed is returned, wrapped, by VerifyWellKnownContracts for contracts
+	// without code at their well-known address.
+	ErrContractNotDeployed = errors.New("contract not deployed")
+	// ErrContractCodeMismatch is returned, wrapped, by VerifyWellKnownContracts for contracts
+	// whose code differs from the one expected at their well-known address.
+	ErrContractCodeMismatch = errors.New("contract code mismatch")
+)
+
+// CodeReader reads the runtime code deployed at an address of a chain, e.g. with eth_getCode.
+// It returns empty code for addresses without a contract.
+type CodeReader interface {
+	CodeAt(ctx context.Context, address string) ([]byte, error)
+}
+
+// CodeReaderFunc adapts a function to the CodeReader interface.
+type CodeReaderFunc func(ctx context.Context, address string) ([]byte, error)
+
+func (f CodeReaderFunc) CodeAt(ctx context.Context, address string) ([]byte, error) {
+	return f(ctx, address)
+}
+
+// VerifyWellKnownContracts checks, through rpc, that the well-known contracts of the chain
+// identified by the selector are deployed, so tooling relying on them fails before sending
+// transactions to empty addresses. Contracts deployed with the same code on every chain, see
+// code_hashes in contracts.yml, must also match the SHA-256 of their expected runtime code.
+// Failures are returned as a *MultiError with one error per contract, in contract name order,
+// wrapping ErrContractNotDeployed, ErrContractCodeMismatch or the error of rpc.
+func VerifyWellKnownContracts(ctx context.Context, rpc CodeReader, selector uint64) error {
+	if _, err := getChainInfo(selector); err != nil {
+		return err
+	}
+
+	contracts := contractsBySelector()[selector]
+	names := make([]WellKnownContract, 0, len(contracts))
+	for name := range contracts {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool { return names[i] < names[j] })
+
+	errs := &MultiError{}
+	for i, name := range names {
+		address := contracts[name]
+		item := fmt.Sprintf("%s at %s", name, address)
+
+		code, err := rpc.CodeAt(ctx, address)
+		if err != nil {
+			errs.add(i, item, err)
+			continue
+		}
+		if len(code) == 0 {
+			errs.add(i, item, ErrContractNotDeployed)
+			continue
+		}
+		expected, pinned := codeHashesByContract()[name]
+		if sum := sha256.Sum256(code); pinned && hex.EncodeToString(sum[:]) != expected {
+			errs.add(i, item, fmt.Errorf("%w: code sha256 %x, expected %s", ErrContractCodeMismatch, sum, expected))
+		}
+	}
+	return errs.errOrNil()
+}
@@ -0,0 +1,109 @@
+package chain_selectors
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContractSelectorsAreKnownChains(t *testing.T) {
+	for selector := range contractsBySelector() {
+		_, err := getChainInfo(selector)
+		assert.NoError(t, err, "contracts.yml references unknown selector %d", selector)
+	}
+}
+
+func Test_GetWellKnownContract(t *testing.T) {
+	tests := []struct {
+		name      string
+		selector  uint64
+		contract  WellKnownContract
+		address   string
+		expectErr bool
+	}{
+		{
+			name:     "weth on ethereum",
+			selector: ETHEREUM_MAINNET.Selector,
+			contract: ContractWrappedNative,
+			address:  "0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2",
+		},
+		{
+			name:     "multicall3 on base",
+			selector: ETHEREUM_MAINNET_BASE_1.Selector,
+			contract: ContractMulticall3,
+			address:  "0xcA11bde05977b3631167028862bE2a173976CA11",
+		},
+		{
+			name:      "chain without contracts",
+			selector:  ZORA_TESTNET.Selector,
+			contract:  ContractMulticall3,
+			expectErr: true,
+		},
+		{
+			name:      "unknown contract",
+			selector:  ETHEREUM_MAINNET.Selector,
+			contract:  WellKnownContract("router"),
+			expectErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			address, err := GetWellKnownContract(test.selector, test.contract)
+			if test.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.address, address)
+		})
+	}
+}
+
+func Test_ParseContractsYmlRejectsInvalidEntries(t *testing.T) {
+	require.Panics(t, func() {
+		parseContractsYml([]byte("contracts:\n  1:\n    router: \"0xcA11bde05977b3631167028862bE2a173976CA11\"\n"))
+	})
+	require.Panics(t, func() {
+		parseContractsYml([]byte("contracts:\n  1:\n    multicall3: \"0x1234\"\n"))
+	})
+}
+
+func TestVerifyWellKnownContracts(t *testing.T) {
+	create2Deployer, err := hex.DecodeString("7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe03601600081602082378035828234f58015156039578182fd5b8082525050506014600cf3")
+	require.NoError(t, err)
+	deployed := map[string][]byte{
+		"0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2": {0x60, 0x80},
+		"0xcA11bde05977b3631167028862bE2a173976CA11": {0x60, 0x80},
+		"0x4e59b44847b379578588920CA78FbF26c0b4956C": create2Deployer,
+	}
+	rpc := CodeReaderFunc(func(_ context.Context, address string) ([]byte, error) {
+		return deployed[address], nil
+	})
+
+	require.NoError(t, VerifyWellKnownContracts(context.Background(), rpc, ETHEREUM_MAINNET.Selector))
+	require.NoError(t, VerifyWellKnownContracts(context.Background(), rpc, ZORA_TESTNET.Selector), "chains without well-known contracts")
+
+	delete(deployed, "0xcA11bde05977b3631167028862bE2a173976CA11")
+	deployed["0x4e59b44847b379578588920CA78FbF26c0b4956C"] = []byte{0x60, 0x80}
+	err = VerifyWellKnownContracts(context.Background(), rpc, ETHEREUM_MAINNET.Selector)
+	var multi *MultiError
+	require.ErrorAs(t, err, &multi)
+	require.Len(t, multi.Errors, 2)
+	assert.ErrorIs(t, multi.Errors[0], ErrContractCodeMismatch)
+	assert.Contains(t, multi.Errors[0].Item, string(ContractCreate2Deployer))
+	assert.ErrorIs(t, multi.Errors[1], ErrContractNotDeployed)
+	assert.Contains(t, multi.Errors[1].Item, string(ContractMulticall3))
+
+	unreachable := errors.New("connection refused")
+	err = VerifyWellKnownContracts(context.Background(), CodeReaderFunc(func(context.Context, string) ([]byte, error) {
+		return nil, unreachable
+	}), ETHEREUM_MAINNET.Selector)
+	require.ErrorIs(t, err, unreachable)
+
+	require.ErrorIs(t, VerifyWellKnownContracts(context.Background(), rpc, 1), ErrChainNotFound)
+}
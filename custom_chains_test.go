@@ -2,9 +2,23 @@ package chain_selectors
 
 import (
 	"strconv"
+	"strings"
 	"testing"
 )
 
+// restoreCustomChains restores the registered custom chains once the test and its subtests
+// completed, so tests registering chains can run again.
+func restoreCustomChains(t *testing.T) {
+	t.Helper()
+	names, metadata := customChains.names.Load(), customChains.metadata.Load()
+	t.Cleanup(func() {
+		customChains.mu.Lock()
+		defer customChains.mu.Unlock()
+		customChains.names.Store(names)
+		customChains.metadata.Store(metadata)
+	})
+}
+
 func TestCustomChainSelectorGeneration(t *testing.T) {
 	// Test your custom chain IDs
 	testChains := []uint64{9388201, 9250445, 1234567, 7777777}
@@ -78,7 +92,7 @@ func TestSelectorFromChainIdCustom(t *testing.T) {
 
 	for _, chainID := range testChains {
 		t.Run("Selector_"+strconv.FormatUint(chainID, 10), func(t *testing.T) {
-			selector, err := SelectorFromChainId(chainID)
+			selector, err := selectorFromChainId(chainID)
 
 			if err != nil {
 				t.Errorf("Failed to get selector for custom chain %d: %v", chainID, err)
@@ -122,7 +136,7 @@ func TestGetCustomChainSelector(t *testing.T) {
 
 func TestOfficialChainsStillWork(t *testing.T) {
 	// Test that official chains still work (e.g., Ethereum mainnet)
-	selector, err := SelectorFromChainId(1) // Ethereum mainnet
+	selector, err := selectorFromChainId(1) // Ethereum mainnet
 	if err != nil {
 		t.Errorf("Official Ethereum mainnet chain should still work: %v", err)
 	}
@@ -140,3 +154,152 @@ func BenchmarkCustomSelectorGeneration(b *testing.B) {
 		generateCustomChainSelector(chainID)
 	}
 }
+
+func TestAppendCustomChainName(t *testing.T) {
+	buf := []byte("chains: ")
+	buf = AppendCustomChainName(buf, 9388201)
+	if string(buf) != "chains: custom-testnet-9388201" {
+		t.Errorf("Unexpected appended name: %q", buf)
+	}
+
+	for _, chainID := range []uint64{0, 9388201, 0xFFFFFFFFFFFFFFFF} {
+		if got := string(AppendCustomChainName(nil, chainID)); got != generateCustomChainName(chainID) {
+			t.Errorf("AppendCustomChainName(%d) = %q, generateCustomChainName = %q", chainID, got, generateCustomChainName(chainID))
+		}
+	}
+
+	reused := make([]byte, 0, 64)
+	allocs := testing.AllocsPerRun(100, func() {
+		reused = AppendCustomChainName(reused[:0], 9388201)
+	})
+	if allocs != 0 {
+		t.Errorf("AppendCustomChainName into a large enough buffer allocated %v times", allocs)
+	}
+}
+
+func TestGenerateCustomChainNameAllocations(t *testing.T) {
+	allocs := testing.AllocsPerRun(100, func() {
+		generateCustomChainName(9388201)
+	})
+	if allocs > 1 {
+		t.Errorf("generateCustomChainName allocated %v times, expected only the returned string", allocs)
+	}
+}
+
+func BenchmarkGenerateCustomChainName(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		generateCustomChainName(uint64(i))
+	}
+}
+
+func BenchmarkAppendCustomChainName(b *testing.B) {
+	buf := make([]byte, 0, 64)
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buf = AppendCustomChainName(buf[:0], uint64(i))
+	}
+}
+
+func TestRegisterCustomChains(t *testing.T) {
+	specs := []CustomChainSpec{
+		{ChainID: 5500000001, Name: "bulk-devnet-1"},
+		{ChainID: 5500000002, Name: "bulk-devnet-2"},
+	}
+
+	selectors, err := RegisterCustomChains(specs)
+	if err != nil {
+		t.Fatalf("Unexpected error registering custom chains: %v", err)
+	}
+	for i, spec := range specs {
+		if selectors[i] != generateCustomChainSelector(spec.ChainID) {
+			t.Errorf("Unexpected selector %d for chain %d", selectors[i], spec.ChainID)
+		}
+		if name, _ := customChains.name(spec.ChainID); name != spec.Name {
+			t.Errorf("Chain %d registered as %q, expected %q", spec.ChainID, name, spec.Name)
+		}
+	}
+
+	// Registering the same chains again is allowed.
+	if _, err := RegisterCustomChains(specs); err != nil {
+		t.Errorf("Re-registering identical custom chains failed: %v", err)
+	}
+}
+
+func TestRegisterCustomChainsIsAllOrNothing(t *testing.T) {
+	RegisterCustomChain(5500000101, "bulk-existing")
+
+	specs := []CustomChainSpec{
+		{ChainID: 5500000102, Name: "bulk-valid"},
+		{ChainID: 0, Name: "bulk-zero"},
+		{ChainID: 1, Name: "bulk-official"},
+		{ChainID: 5500000103, Name: ""},
+		{ChainID: 5500000104, Name: "ethereum-mainnet"},
+		{ChainID: 5500000101, Name: "bulk-renamed"},
+		{ChainID: 5500000102, Name: "bulk-duplicate-id"},
+		{ChainID: 5500000105, Name: "bulk-valid"},
+		{ChainID: 5500000106, Name: "bulk-existing"},
+	}
+
+	selectors, err := RegisterCustomChains(specs)
+	if err == nil {
+		t.Fatalf("Expected an error, got selectors %v", selectors)
+	}
+
+	for _, expected := range []string{
+		"(index 1): chain id must not be zero",
+		"(index 2): chain id belongs to an official chain",
+		"(index 3): name must not be empty",
+		"(index 4): name \"ethereum-mainnet\" belongs to an official chain",
+		"(index 5): already registered as \"bulk-existing\"",
+		"(index 6): chain id duplicates index 0",
+		"(index 7): name \"bulk-valid\" duplicates index 0",
+		"(index 8): name \"bulk-existing\" is already registered for chain 5500000101",
+	} {
+		if !strings.Contains(err.Error(), expected) {
+			t.Errorf("Error %q does not mention %q", err, expected)
+		}
+	}
+	if joined, ok := err.(interface{ Unwrap() []error }); !ok || len(joined.Unwrap()) != 8 {
+		t.Errorf("Expected 8 joined errors, got %v", err)
+	}
+
+	if _, exists := customChains.name(5500000102); exists {
+		t.Errorf("Valid chain of a failed batch must not be registered")
+	}
+	if name, _ := customChains.name(5500000101); name != "bulk-existing" {
+		t.Errorf("Existing custom chain was renamed to %q", name)
+	}
+}
+
+func TestRegisteredCustomChainNames(t *testing.T) {
+	selectors, err := RegisterCustomChains([]CustomChainSpec{{ChainID: 5500000901, Name: "acme-devnet-1"}})
+	if err != nil {
+		t.Fatalf("Unexpected error registering custom chain: %v", err)
+	}
+	selector := selectors[0]
+
+	ch, exists := ChainByName("acme-devnet-1")
+	if !exists {
+		t.Fatalf("Registered custom chain not found by name")
+	}
+	if ch.EvmChainID != 5500000901 || ch.Selector != selector || ch.VarName != "ACME_DEVNET_1" {
+		t.Errorf("Unexpected chain %+v", ch)
+	}
+	if ch, _ := ChainBySelector(selector); ch.Name != "acme-devnet-1" {
+		t.Errorf("ChainBySelector returned name %q", ch.Name)
+	}
+	if ch, _ := ChainByEvmChainID(5500000901); ch.Name != "acme-devnet-1" {
+		t.Errorf("ChainByEvmChainID returned name %q", ch.Name)
+	}
+	if details, _ := GetChainDetailsBySelector(selector); details.ChainName != "acme-devnet-1" {
+		t.Errorf("GetChainDetailsBySelector returned name %q", details.ChainName)
+	}
+	if details, _ := GetChainDetailsByChainIDAndFamily("5500000901", FamilyEVM); details.ChainName != "acme-devnet-1" {
+		t.Errorf("GetChainDetailsByChainIDAndFamily returned name %q", details.ChainName)
+	}
+	if ch, _ := NewRegistry().ChainByName("acme-devnet-1"); ch.Selector != selector {
+		t.Errorf("Registry did not resolve the registered name, got %+v", ch)
+	}
+}
@@ -0,0 +1,146 @@
+package chain_selectors
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// ErrCustomSelectorLimit is matched, with errors.Is, by the *CustomSelectorLimitError of
+// lookups refusing to generate a custom selector, see SetCustomSelectorLimits.
+var ErrCustomSelectorLimit = errors.New("custom selector generation limit exceeded")
+
+// CustomSelectorLimits restricts the chain ids custom selectors are generated for automatically,
+// e.g. by GetCustomChainSelector, so a bug feeding garbage chain ids fails fast instead of
+// synthesizing selectors for all of them. Only new chain ids count against the limits, chains
+// generated before and chains registered with RegisterCustomChain or RegisterCustomChains are
+// never refused. The zero value sets no limit.
+type CustomSelectorLimits struct {
+	// MaxChains caps the number of distinct chain ids selectors are generated for, zero means
+	// no cap.
+	MaxChains int
+	// PerSecond is the sustained rate of new chain ids, allowing bursts of Burst chain ids, at
+	// least one, zero means no rate limit.
+	PerSecond float64
+	Burst     int
+}
+
+// CustomSelectorLimitError is returned by lookups refusing to generate a custom selector.
+type CustomSelectorLimitError struct {
+	ChainID uint64
+	// RateLimited tells the rate limit rather than the cap was exceeded.
+	RateLimited bool
+	Limits      CustomSelectorLimits
+}
+
+func (e *CustomSelectorLimitError) Error() string {
+	if e.RateLimited {
+		return fmt.Sprintf("custom selector for chain id %d refused: more than %g new chain ids per second", e.ChainID, e.Limits.PerSecond)
+	}
+	return fmt.Sprintf("custom selector for chain id %d refused: selectors were generated for %d chain ids already", e.ChainID, e.Limits.MaxChains)
+}
+
+func (e *CustomSelectorLimitError) Is(target error) bool {
+	return target == ErrCustomSelectorLimit
+}
+
+// customSelectorLimiter enforces CustomSelectorLimits. It only tracks chain ids while a limit
+// is set. It is safe for concurrent use.
+type customSelectorLimiter struct {
+	mu        sync.Mutex
+	limits    CustomSelectorLimits
+	generated map[uint64]struct{}
+	tokens    float64
+	refilled  time.Time
+	now       func() time.Time
+}
+
+var customSelectorLimits = &customSelectorLimiter{now: time.Now}
+
+// SetCustomSelectorLimits sets the limits of automatic custom selector generation for the
+// process. Chain ids generated before are forgotten, they count against the new limits again.
+func SetCustomSelectorLimits(limits CustomSelectorLimits) {
+	customSelectorLimits.set(limits)
+}
+
+func (l *customSelectorLimiter) set(limits CustomSelectorLimits) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if limits.PerSecond > 0 && limits.Burst < 1 {
+		limits.Burst = 1
+	}
+	l.limits = limits
+	l.generated = nil
+	l.tokens = float64(limits.Burst)
+	l.refilled = l.now()
+}
+
+// allow records that a selector is generated for the chain id, failing if it exceeds the limits.
+func (l *customSelectorLimiter) allow(chainID uint64) error {
+	return l.check(chainID, true)
+}
+
+// peek reports whether a selector could be generated for the chain id without recording it.
+func (l *customSelectorLimiter) peek(chainID uint64) error {
+	return l.check(chainID, false)
+}
+
+func (l *customSelectorLimiter) check(chainID uint64, record bool) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.limits == (CustomSelectorLimits{}) {
+		return nil
+	}
+	if _, generated := l.generated[chainID]; generated {
+		return nil
+	}
+	if _, registered := customChains.name(chainID); registered {
+		return nil
+	}
+
+	if l.limits.MaxChains > 0 && len(l.generated) >= l.limits.MaxChains {
+		return &CustomSelectorLimitError{ChainID: chainID, Limits: l.limits}
+	}
+	if l.limits.PerSecond > 0 {
+		now := l.now()
+		tokens := l.tokens + now.Sub(l.refilled).Seconds()*l.limits.PerSecond
+		if burst := float64(l.limits.Burst); tokens > burst {
+			tokens = burst
+		}
+		if tokens < 1 {
+			return &CustomSelectorLimitError{ChainID: chainID, RateLimited: true, Limits: l.limits}
+		}
+		if record {
+			l.tokens, l.refilled = tokens-1, now
+		}
+	}
+
+	if !record {
+		return nil
+	}
+	if l.generated == nil {
+		l.generated = make(map[uint64]struct{})
+	}
+	l.generated[chainID] = struct{}{}
+	return nil
+}
+
+// checkCustomGeneration returns why no selector may be generated automatically for the chain
+// id, a quarantine or the limits, recording the generation otherwise.
+func checkCustomGeneration(chainID uint64) error {
+	if quarantineBlocks(chainID) {
+		return lookupError(InputChainID, FamilyEVM, strconv.FormatUint(chainID, 10), reasonQuarantined)
+	}
+	return customSelectorLimits.allow(chainID)
+}
+
+// peekCustomGeneration is checkCustomGeneration without recording the generation, for the
+// listings that do not hand out the selectors.
+func peekCustomGeneration(chainID uint64) error {
+	if quarantineBlocks(chainID) {
+		return lookupError(InputChainID, FamilyEVM, strconv.FormatUint(chainID, 10), reasonQuarantined)
+	}
+	return customSelectorLimits.peek(chainID)
+}
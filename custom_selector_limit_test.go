@@ -0,0 +1,80 @@
+package chain_selectors
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCustomSelectorLimitsCap(t *testing.T) {
+	SetCustomSelectorLimits(CustomSelectorLimits{MaxChains: 2})
+	t.Cleanup(func() { SetCustomSelectorLimits(CustomSelectorLimits{}) })
+
+	for _, chainID := range []uint64{9388301, 9388302, 9388301} {
+		_, err := GetCustomChainSelector(chainID)
+		require.NoError(t, err)
+	}
+
+	_, err := GetCustomChainSelector(9388303)
+	var limitErr *CustomSelectorLimitError
+	require.ErrorAs(t, err, &limitErr)
+	assert.ErrorIs(t, err, ErrCustomSelectorLimit)
+	assert.False(t, limitErr.RateLimited)
+	_, exists := ChainByEvmChainID(9388303)
+	assert.False(t, exists)
+	_, err = GetChainDetailsByChainIDAndFamily("9388303", FamilyEVM)
+	require.ErrorIs(t, err, ErrCustomSelectorLimit)
+	for _, details := range ListAllChains(9388301, 9388303) {
+		assert.NotEqual(t, generateCustomChainSelector(9388303), details.ChainSelector, "ListAllChains generated a refused chain")
+	}
+	assert.Len(t, ListAllChains(9388301, 9388303), 2)
+
+	RegisterCustomChain(9388304, "registered-beyond-cap")
+	_, err = GetCustomChainSelector(9388304)
+	require.NoError(t, err, "registered chains are not limited")
+}
+
+func TestCustomSelectorLimitsRate(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	customSelectorLimits.now = clock.Now
+	SetCustomSelectorLimits(CustomSelectorLimits{PerSecond: 1, Burst: 2})
+	t.Cleanup(func() {
+		customSelectorLimits.now = time.Now
+		SetCustomSelectorLimits(CustomSelectorLimits{})
+	})
+
+	for _, chainID := range []uint64{9388311, 9388312} {
+		_, err := GetCustomChainSelector(chainID)
+		require.NoError(t, err)
+	}
+	_, err := GetCustomChainSelector(9388313)
+	var limitErr *CustomSelectorLimitError
+	require.ErrorAs(t, err, &limitErr)
+	assert.True(t, limitErr.RateLimited)
+
+	_, err = GetCustomChainSelector(9388311)
+	require.NoError(t, err, "chain ids generated before are not limited")
+
+	clock.Advance(time.Second)
+	_, err = GetCustomChainSelector(9388313)
+	require.NoError(t, err)
+}
+
+func TestListingDoesNotCountAgainstCustomSelectorLimits(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	customSelectorLimits.now = clock.Now
+	SetCustomSelectorLimits(CustomSelectorLimits{MaxChains: 1, PerSecond: 1, Burst: 1})
+	t.Cleanup(func() {
+		customSelectorLimits.now = time.Now
+		SetCustomSelectorLimits(CustomSelectorLimits{})
+	})
+
+	assert.Len(t, ListAllChains(9388321, 9388330), 10)
+	assert.Len(t, ListAllChains(9388321, 9388330), 10)
+
+	_, err := GetCustomChainSelector(9388331)
+	require.NoError(t, err, "listing used up the quota")
+	assert.Empty(t, ListAllChains(9388321, 9388330))
+}
@@ -0,0 +1,95 @@
+package chain_selectors
+
+import (
+	"container/list"
+	"sync"
+)
+
+// DefaultCustomSelectorMemoSize is the number of hashed custom selectors remembered by default.
+const DefaultCustomSelectorMemoSize = 1024
+
+// customSelectorMemo remembers the selectors of custom chains whose chain id is too large for
+// the direct encoding and has to be hashed. It evicts the least recently used entry once it
+// holds more than size entries, a negative size never evicts and a zero size disables it.
+// It is safe for concurrent use.
+type customSelectorMemo struct {
+	mu      sync.Mutex
+	size    int
+	order   *list.List
+	entries map[uint64]*list.Element
+}
+
+type customSelectorMemoEntry struct {
+	chainID  uint64
+	selector uint64
+}
+
+var hashedSelectors = newCustomSelectorMemo(DefaultCustomSelectorMemoSize)
+
+func newCustomSelectorMemo(size int) *customSelectorMemo {
+	return &customSelectorMemo{
+		size:    size,
+		order:   list.New(),
+		entries: make(map[uint64]*list.Element),
+	}
+}
+
+// selector returns the memoized selector of the chain, computing and storing it on a miss.
+func (m *customSelectorMemo) selector(chainID uint64, compute func(uint64) uint64) uint64 {
+	m.mu.Lock()
+	if elem, exists := m.entries[chainID]; exists {
+		m.order.MoveToFront(elem)
+		selector := elem.Value.(customSelectorMemoEntry).selector
+		m.mu.Unlock()
+		return selector
+	}
+	size := m.size
+	m.mu.Unlock()
+
+	selector := compute(chainID)
+	if size == 0 {
+		return selector
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, exists := m.entries[chainID]; !exists {
+		m.entries[chainID] = m.order.PushFront(customSelectorMemoEntry{chainID: chainID, selector: selector})
+		m.evict()
+	}
+	return selector
+}
+
+// resize changes the capacity of the memo, evicting entries if it shrinks.
+func (m *customSelectorMemo) resize(size int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.size = size
+	m.evict()
+}
+
+func (m *customSelectorMemo) len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.order.Len()
+}
+
+// evict drops the least recently used entries above the capacity, m.mu must be held.
+func (m *customSelectorMemo) evict() {
+	if m.size < 0 {
+		return
+	}
+	for m.order.Len() > m.size {
+		oldest := m.order.Back()
+		m.order.Remove(oldest)
+		delete(m.entries, oldest.Value.(customSelectorMemoEntry).chainID)
+	}
+}
+
+// SetCustomSelectorMemoSize sets how many hashed custom chain selectors are remembered, see
+// GetCustomChainSelector. Chain ids that fit the direct 0xE encoding are never hashed and are
+// not affected. A negative size remembers every selector, zero disables memoization. The
+// default is DefaultCustomSelectorMemoSize.
+func SetCustomSelectorMemoSize(size int) {
+	hashedSelectors.resize(size)
+}
@@ -0,0 +1,80 @@
+package chain_selectors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCustomSelectorMemo(t *testing.T) {
+	calls := 0
+	compute := func(chainID uint64) uint64 {
+		calls++
+		return hashCustomChainSelector(chainID)
+	}
+
+	memo := newCustomSelectorMemo(2)
+	first := memo.selector(0xF000000000000001, compute)
+	assert.Equal(t, hashCustomChainSelector(0xF000000000000001), first)
+	assert.Equal(t, first, memo.selector(0xF000000000000001, compute))
+	assert.Equal(t, 1, calls)
+
+	memo.selector(0xF000000000000002, compute)
+	memo.selector(0xF000000000000001, compute)
+	memo.selector(0xF000000000000003, compute)
+	assert.Equal(t, 3, calls)
+	assert.Equal(t, 2, memo.len())
+
+	// 0xF000000000000002 was the least recently used entry and got evicted.
+	memo.selector(0xF000000000000001, compute)
+	assert.Equal(t, 3, calls)
+	memo.selector(0xF000000000000002, compute)
+	assert.Equal(t, 4, calls)
+
+	memo.resize(1)
+	assert.Equal(t, 1, memo.len())
+}
+
+func TestCustomSelectorMemoSizes(t *testing.T) {
+	tests := []struct {
+		name     string
+		size     int
+		expected int
+	}{
+		{name: "disabled", size: 0, expected: 0},
+		{name: "bounded", size: 3, expected: 3},
+		{name: "unbounded", size: -1, expected: 10},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			memo := newCustomSelectorMemo(test.size)
+			for i := uint64(0); i < 10; i++ {
+				memo.selector(0xF000000000000000+i, hashCustomChainSelector)
+			}
+			assert.Equal(t, test.expected, memo.len())
+		})
+	}
+}
+
+func TestHashedCustomSelectorIsMemoized(t *testing.T) {
+	chainID := uint64(0xFFFFFFFFFFFFFF00)
+	selector := generateCustomChainSelector(chainID)
+	require.True(t, isCustomSelector(selector))
+	assert.Equal(t, hashCustomChainSelector(chainID), selector)
+
+	hashedSelectors.mu.Lock()
+	_, exists := hashedSelectors.entries[chainID]
+	hashedSelectors.mu.Unlock()
+	assert.True(t, exists)
+}
+
+func BenchmarkHashedCustomSelectorGeneration(b *testing.B) {
+	chainID := uint64(0xFFFFFFFFFFFFFF00)
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		generateCustomChainSelector(chainID)
+	}
+}
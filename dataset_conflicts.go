@@ -0,0 +1,193 @@
+package chain_selectors
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// DatasetFile is a dataset of EVM chains in the selectors.yml format maintained by a fork or a
+// team, Name identifies it in a ConflictReport.
+type DatasetFile struct {
+	Name   string
+	Chains map[uint64]ChainDetails
+}
+
+// ParseDatasetFile decodes a dataset in the selectors.yml format.
+func ParseDatasetFile(name string, ymlFile []byte) (DatasetFile, error) {
+	chains, err := decodeSelectorsYml(ymlFile)
+	if err != nil {
+		return DatasetFile{}, fmt.Errorf("failed to decode %s: %w", name, err)
+	}
+	return DatasetFile{Name: name, Chains: chains}, nil
+}
+
+// ConflictKind is what datasets disagree on.
+type ConflictKind string
+
+const (
+	// ConflictSelector is a chain id given different selectors.
+	ConflictSelector ConflictKind = "selector"
+	// ConflictChainID is a selector given to different chain ids.
+	ConflictChainID ConflictKind = "chain_id"
+	// ConflictName is a name given to different chain ids.
+	ConflictName ConflictKind = "name"
+)
+
+// DatasetClaim is what a dataset says about the chain of a conflict.
+type DatasetClaim struct {
+	Dataset  string `json:"dataset"`
+	ChainID  uint64 `json:"chain_id"`
+	Selector uint64 `json:"selector"`
+	Name     string `json:"name"`
+}
+
+// DatasetConflict is a chain id, selector or name the datasets disagree on. Key is the chain id,
+// selector or name, depending on the kind.
+type DatasetConflict struct {
+	Kind   ConflictKind   `json:"kind"`
+	Key    string         `json:"key"`
+	Claims []DatasetClaim `json:"claims"`
+	// Resolution suggests how to reconcile the datasets.
+	Resolution string `json:"resolution"`
+}
+
+// ConflictReport is the reconciliation report of datasets.
+type ConflictReport struct {
+	Datasets []string `json:"datasets"`
+	// Chains is the number of distinct chain ids of the datasets.
+	Chains    int               `json:"chains"`
+	Conflicts []DatasetConflict `json:"conflicts"`
+}
+
+// String formats the report for humans, one conflict per paragraph.
+func (r ConflictReport) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d datasets (%s), %d chains, %d conflicts\n", len(r.Datasets), strings.Join(r.Datasets, ", "), r.Chains, len(r.Conflicts))
+	for _, c := range r.Conflicts {
+		fmt.Fprintf(&b, "\n%s %s:\n", c.Kind, c.Key)
+		for _, claim := range c.Claims {
+			fmt.Fprintf(&b, "  %s: chain %d, selector %d, name %q\n", claim.Dataset, claim.ChainID, claim.Selector, claim.Name)
+		}
+		fmt.Fprintf(&b, "  resolution: %s\n", c.Resolution)
+	}
+	return b.String()
+}
+
+// DetectDatasetConflicts compares the datasets of forks or teams and reports every chain id
+// given different selectors, selector given to different chain ids and name given to different
+// chain ids, sorted by kind and key. The resolutions favor the chains embedded in the package,
+// then the claim of most datasets, then the one of the dataset listed first.
+func DetectDatasetConflicts(datasets ...DatasetFile) ConflictReport {
+	report := ConflictReport{Datasets: make([]string, len(datasets)), Conflicts: []DatasetConflict{}}
+	byChainID := make(map[uint64][]DatasetClaim)
+	bySelector := make(map[uint64][]DatasetClaim)
+	byName := make(map[string][]DatasetClaim)
+	for i, dataset := range datasets {
+		report.Datasets[i] = dataset.Name
+		chainIDs := make([]uint64, 0, len(dataset.Chains))
+		for chainID := range dataset.Chains {
+			chainIDs = append(chainIDs, chainID)
+		}
+		sort.Slice(chainIDs, func(i, j int) bool { return chainIDs[i] < chainIDs[j] })
+		for _, chainID := range chainIDs {
+			details := dataset.Chains[chainID]
+			claim := DatasetClaim{Dataset: dataset.Name, ChainID: chainID, Selector: details.ChainSelector, Name: details.ChainName}
+			byChainID[chainID] = append(byChainID[chainID], claim)
+			bySelector[claim.Selector] = append(bySelector[claim.Selector], claim)
+			if claim.Name != "" {
+				byName[claim.Name] = append(byName[claim.Name], claim)
+			}
+		}
+	}
+	report.Chains = len(byChainID)
+
+	for chainID, claims := range byChainID {
+		if distinct(claims, func(c DatasetClaim) string { return fmt.Sprint(c.Selector) }) > 1 {
+			official, known := evmChainIdToChainSelector()[chainID]
+			report.Conflicts = append(report.Conflicts, DatasetConflict{
+				Kind:       ConflictSelector,
+				Key:        fmt.Sprint(chainID),
+				Claims:     claims,
+				Resolution: resolve(claims, "selector", func(c DatasetClaim) string { return fmt.Sprint(c.Selector) }, fmt.Sprint(official.ChainSelector), known),
+			})
+		}
+	}
+	for selector, claims := range bySelector {
+		if distinct(claims, func(c DatasetClaim) string { return fmt.Sprint(c.ChainID) }) > 1 {
+			official, known := evmChainsBySelector()[selector]
+			report.Conflicts = append(report.Conflicts, DatasetConflict{
+				Kind:       ConflictChainID,
+				Key:        fmt.Sprint(selector),
+				Claims:     claims,
+				Resolution: resolve(claims, "chain id", func(c DatasetClaim) string { return fmt.Sprint(c.ChainID) }, fmt.Sprint(official.EvmChainID), known) + ", the other chains need new selectors",
+			})
+		}
+	}
+	for name, claims := range byName {
+		if distinct(claims, func(c DatasetClaim) string { return fmt.Sprint(c.ChainID) }) > 1 {
+			official, known := evmChainsByName()[name]
+			report.Conflicts = append(report.Conflicts, DatasetConflict{
+				Kind:       ConflictName,
+				Key:        name,
+				Claims:     claims,
+				Resolution: resolve(claims, "chain id", func(c DatasetClaim) string { return fmt.Sprint(c.ChainID) }, fmt.Sprint(official.EvmChainID), known) + ", the other chains need new names",
+			})
+		}
+	}
+
+	kindOrder := map[ConflictKind]int{ConflictSelector: 0, ConflictChainID: 1, ConflictName: 2}
+	sort.Slice(report.Conflicts, func(i, j int) bool {
+		a, b := report.Conflicts[i], report.Conflicts[j]
+		if a.Kind != b.Kind {
+			return kindOrder[a.Kind] < kindOrder[b.Kind]
+		}
+		if a.Kind == ConflictName {
+			return a.Key < b.Key
+		}
+		x, _ := strconv.ParseUint(a.Key, 10, 64)
+		y, _ := strconv.ParseUint(b.Key, 10, 64)
+		return x < y
+	})
+	return report
+}
+
+// distinct returns the number of distinct values of the claims.
+func distinct(claims []DatasetClaim, value func(DatasetClaim) string) int {
+	values := make(map[string]bool, len(claims))
+	for _, c := range claims {
+		values[value(c)] = true
+	}
+	return len(values)
+}
+
+// resolve suggests the value of the claims to keep: the official one if known, otherwise the
+// one of most datasets, ties going to the dataset listed first.
+func resolve(claims []DatasetClaim, field string, value func(DatasetClaim) string, official string, known bool) string {
+	if known {
+		return fmt.Sprintf("keep the official %s %s", field, official)
+	}
+	counts := make(map[string]int)
+	var order []string
+	for _, c := range claims {
+		v := value(c)
+		if counts[v] == 0 {
+			order = append(order, v)
+		}
+		counts[v]++
+	}
+	best := order[0]
+	for _, v := range order[1:] {
+		if counts[v] > counts[best] {
+			best = v
+		}
+	}
+	var keeping []string
+	for _, c := range claims {
+		if value(c) == best {
+			keeping = append(keeping, c.Dataset)
+		}
+	}
+	return fmt.Sprintf("keep the %s %s of %s", field, best, strings.Join(keeping, ", "))
+}
@@ -0,0 +1,55 @@
+package chain_selectors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDetectDatasetConflicts(t *testing.T) {
+	parse := func(name, yml string) DatasetFile {
+		dataset, err := ParseDatasetFile(name, []byte(yml))
+		require.NoError(t, err)
+		return dataset
+	}
+	a := parse("team-a", "selectors:\n  1:\n    selector: 5009297550715157269\n    name: ethereum-mainnet\n  77001:\n    selector: 11\n    name: devnet-a\n  77002:\n    selector: 21\n    name: devnet-b\n")
+	b := parse("team-b", "selectors:\n  1:\n    selector: 42\n    name: ethereum-mainnet\n  77001:\n    selector: 12\n    name: devnet-a\n  77003:\n    selector: 21\n    name: devnet-c\n")
+	c := parse("team-c", "selectors:\n  77001:\n    selector: 12\n    name: devnet-a\n  77004:\n    selector: 31\n    name: devnet-b\n")
+
+	report := DetectDatasetConflicts(a, b, c)
+	assert.Equal(t, []string{"team-a", "team-b", "team-c"}, report.Datasets)
+	assert.Equal(t, 5, report.Chains)
+	require.Len(t, report.Conflicts, 4)
+
+	assert.Equal(t, ConflictSelector, report.Conflicts[0].Kind)
+	assert.Equal(t, "1", report.Conflicts[0].Key)
+	assert.Equal(t, "keep the official selector 5009297550715157269", report.Conflicts[0].Resolution)
+
+	assert.Equal(t, DatasetConflict{
+		Kind: ConflictSelector,
+		Key:  "77001",
+		Claims: []DatasetClaim{
+			{Dataset: "team-a", ChainID: 77001, Selector: 11, Name: "devnet-a"},
+			{Dataset: "team-b", ChainID: 77001, Selector: 12, Name: "devnet-a"},
+			{Dataset: "team-c", ChainID: 77001, Selector: 12, Name: "devnet-a"},
+		},
+		Resolution: "keep the selector 12 of team-b, team-c",
+	}, report.Conflicts[1])
+
+	assert.Equal(t, ConflictChainID, report.Conflicts[2].Kind)
+	assert.Equal(t, "21", report.Conflicts[2].Key)
+	assert.Equal(t, "keep the chain id 77002 of team-a, the other chains need new selectors", report.Conflicts[2].Resolution)
+	assert.Equal(t, ConflictName, report.Conflicts[3].Kind)
+	assert.Equal(t, "devnet-b", report.Conflicts[3].Key)
+	assert.Equal(t, "keep the chain id 77002 of team-a, the other chains need new names", report.Conflicts[3].Resolution)
+	assert.Contains(t, report.String(), "3 datasets (team-a, team-b, team-c), 5 chains, 4 conflicts")
+}
+
+func TestDetectDatasetConflictsAgree(t *testing.T) {
+	official, err := ParseDatasetFile("official", selectorsYml)
+	require.NoError(t, err)
+	report := DetectDatasetConflicts(official, official)
+	assert.Empty(t, report.Conflicts)
+	assert.Equal(t, len(official.Chains), report.Chains)
+}
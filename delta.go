@@ -0,0 +1,171 @@
+package chain_selectors
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// deltaHistorySize is the number of states a Registry remembers to compute deltas from.
+const deltaHistorySize = 32
+
+// stateVersion is a state a Registry published, fingerprint is computed on demand under the
+// lock of the registry.
+type stateVersion struct {
+	state       *registryState
+	fingerprint string
+}
+
+var (
+	// ErrUnknownFingerprint is returned by ComputeDelta for fingerprints the registry does not
+	// remember, the full dataset must be transmitted instead.
+	ErrUnknownFingerprint = errors.New("unknown registry fingerprint")
+	// ErrDeltaMismatch is returned by ApplyDelta for deltas not computed from, or not leading
+	// to, the chains of the registry.
+	ErrDeltaMismatch = errors.New("delta does not apply to the registry")
+)
+
+// DeltaChain is a chain added or changed by a Delta.
+type DeltaChain struct {
+	ChainID     uint64 `json:"chain_id"`
+	Selector    uint64 `json:"selector"`
+	Name        string `json:"name"`
+	NetworkID   uint64 `json:"network_id,omitempty"`
+	GenesisHash string `json:"genesis_hash,omitempty"`
+	Owner       string `json:"owner,omitempty"`
+	ApprovedBy  string `json:"approved_by,omitempty"`
+}
+
+func deltaChain(chainID uint64, details ChainDetails) DeltaChain {
+	return DeltaChain{
+		ChainID:     chainID,
+		Selector:    details.ChainSelector,
+		Name:        details.ChainName,
+		NetworkID:   details.NetworkID,
+		GenesisHash: details.GenesisHash,
+		Owner:       details.Owner,
+		ApprovedBy:  details.ApprovedBy,
+	}
+}
+
+func (c DeltaChain) details() ChainDetails {
+	return ChainDetails{
+		ChainSelector: c.Selector,
+		ChainName:     c.Name,
+		NetworkID:     c.NetworkID,
+		GenesisHash:   c.GenesisHash,
+		Owner:         c.Owner,
+		ApprovedBy:    c.ApprovedBy,
+	}
+}
+
+// Delta is the difference between the loaded EVM chains of two versions of a registry, so
+// they can be synced without transmitting the whole dataset. Chains are in chain id order.
+type Delta struct {
+	// From and To are the fingerprints of the registry before and after the delta.
+	From    string       `json:"from"`
+	To      string       `json:"to"`
+	Added   []DeltaChain `json:"added,omitempty"`
+	Changed []DeltaChain `json:"changed,omitempty"`
+	// Removed holds the chain ids of the chains no longer loaded, and RemovedSelectors their
+	// selectors, in the same order.
+	Removed          []uint64 `json:"removed,omitempty"`
+	RemovedSelectors []uint64 `json:"removed_selectors,omitempty"`
+}
+
+// Empty reports whether the delta changes nothing.
+func (d Delta) Empty() bool {
+	return len(d.Added) == 0 && len(d.Changed) == 0 && len(d.Removed) == 0
+}
+
+// ComputeDelta returns the delta from the version of the registry with the fingerprint to its
+// current chains. The registry remembers its last versions only, for older or unknown
+// fingerprints it returns ErrUnknownFingerprint.
+func (r *Registry) ComputeDelta(oldFingerprint string) (Delta, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	current := r.history[len(r.history)-1]
+	for i := len(r.history) - 1; i >= 0; i-- {
+		if r.versionFingerprint(r.history[i]) == oldFingerprint {
+			return diffDelta(r.history[i].state, current.state, oldFingerprint, r.versionFingerprint(current)), nil
+		}
+	}
+	return Delta{}, fmt.Errorf("%w %s", ErrUnknownFingerprint, oldFingerprint)
+}
+
+// versionFingerprint returns the fingerprint of the version, r.mu must be held.
+func (r *Registry) versionFingerprint(v *stateVersion) string {
+	if v.fingerprint == "" {
+		v.fingerprint = r.fingerprintOf(v.state)
+	}
+	return v.fingerprint
+}
+
+func diffDelta(previous, next *registryState, from, to string) Delta {
+	delta := Delta{From: from, To: to}
+	for chainID, details := range next.evmDetails {
+		old, existed := previous.evmDetails[chainID]
+		switch {
+		case !existed:
+			delta.Added = append(delta.Added, deltaChain(chainID, details))
+		case old != details:
+			delta.Changed = append(delta.Changed, deltaChain(chainID, details))
+		}
+	}
+	for chainID := range previous.evmDetails {
+		if _, exists := next.evmDetails[chainID]; !exists {
+			delta.Removed = append(delta.Removed, chainID)
+		}
+	}
+	sort.Slice(delta.Added, func(i, j int) bool { return delta.Added[i].ChainID < delta.Added[j].ChainID })
+	sort.Slice(delta.Changed, func(i, j int) bool { return delta.Changed[i].ChainID < delta.Changed[j].ChainID })
+	sort.Slice(delta.Removed, func(i, j int) bool { return delta.Removed[i] < delta.Removed[j] })
+	for _, chainID := range delta.Removed {
+		delta.RemovedSelectors = append(delta.RemovedSelectors, previous.evmDetails[chainID].ChainSelector)
+	}
+	return delta
+}
+
+// ApplyDelta applies a delta computed by ComputeDelta on another registry, attributing the
+// chains to ProvenanceRemote with the first 16 characters of the To fingerprint as dataset
+// version. It fails with ErrDeltaMismatch, leaving the registry unchanged, unless the registry
+// has the From fingerprint and would have the To fingerprint after it, e.g. when the registries
+// embed different datasets. Like LoadYAML, the delta applies atomically and listeners
+// registered with OnChange are notified.
+func (r *Registry) ApplyDelta(delta Delta) error {
+	chains := make(map[uint64]ChainDetails, len(delta.Added)+len(delta.Changed))
+	for _, ch := range append(append([]DeltaChain(nil), delta.Added...), delta.Changed...) {
+		chains[ch.ChainID] = ch.details()
+	}
+
+	r.mu.Lock()
+	previous := r.history[len(r.history)-1]
+	if fingerprint := r.versionFingerprint(previous); fingerprint != delta.From {
+		r.mu.Unlock()
+		return fmt.Errorf("%w: computed from %s, registry is at %s", ErrDeltaMismatch, delta.From, fingerprint)
+	}
+	version := delta.To
+	if len(version) > 16 {
+		version = version[:16]
+	}
+	provenance := Provenance{Source: ProvenanceRemote, DatasetVersion: version, LoadedAt: time.Now()}
+	next, err := previous.state.withoutEVMChains(delta.Removed).withEVMChains(chains, provenance)
+	if err != nil {
+		r.mu.Unlock()
+		return err
+	}
+	if fingerprint := r.fingerprintOf(next); fingerprint != delta.To {
+		r.mu.Unlock()
+		return fmt.Errorf("%w: leads to %s instead of %s", ErrDeltaMismatch, fingerprint, delta.To)
+	}
+	r.publish(next)
+	r.history[len(r.history)-1].fingerprint = delta.To
+	r.lastSync = provenance
+	listeners := r.listeners
+	auditErr := r.auditLoad(previous.state, next, ProvenanceRemote, provenance)
+	r.mu.Unlock()
+
+	r.notify(listeners, diffStates(previous.state, next, ProvenanceRemote))
+	return auditErr
+}
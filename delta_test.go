@@ -0,0 +1,83 @@
+package chain_selectors
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_DeltaSyncsRegistries(t *testing.T) {
+	primary := NewRegistry()
+	replica := NewRegistry()
+	base := primary.Fingerprint()
+	require.Equal(t, base, replica.Fingerprint())
+
+	require.NoError(t, primary.LoadYAML([]byte("selectors:\n  77001:\n    selector: 11\n    name: devnet-a\n  77002:\n    selector: 12\n    name: devnet-b\n")))
+	delta, err := primary.ComputeDelta(base)
+	require.NoError(t, err)
+	assert.Equal(t, base, delta.From)
+	assert.Equal(t, primary.Fingerprint(), delta.To)
+	assert.Equal(t, []DeltaChain{{ChainID: 77001, Selector: 11, Name: "devnet-a"}, {ChainID: 77002, Selector: 12, Name: "devnet-b"}}, delta.Added)
+
+	var changes []ChangeSet
+	replica.OnChange(func(c ChangeSet) { changes = append(changes, c) })
+	require.NoError(t, replica.ApplyDelta(delta))
+	assert.Equal(t, primary.Fingerprint(), replica.Fingerprint())
+	require.Len(t, changes, 1)
+	assert.Equal(t, ProvenanceRemote, changes[0].Source)
+	assert.Len(t, changes[0].Added, 2)
+
+	synced := primary.Fingerprint()
+	require.NoError(t, primary.LoadYAML([]byte("selectors:\n  77001:\n    selector: 11\n    name: devnet-a-renamed\n")))
+	delta, err = primary.ComputeDelta(synced)
+	require.NoError(t, err)
+	assert.Empty(t, delta.Added)
+	assert.Equal(t, []DeltaChain{{ChainID: 77001, Selector: 11, Name: "devnet-a-renamed"}}, delta.Changed)
+	require.NoError(t, replica.ApplyDelta(delta))
+	assert.Equal(t, primary.Fingerprint(), replica.Fingerprint())
+
+	current, err := primary.ComputeDelta(primary.Fingerprint())
+	require.NoError(t, err)
+	assert.True(t, current.Empty())
+
+	_, err = primary.ComputeDelta("unknown")
+	assert.True(t, errors.Is(err, ErrUnknownFingerprint))
+}
+
+func Test_ApplyDeltaRemovesChains(t *testing.T) {
+	registry := NewRegistry()
+	base := registry.Fingerprint()
+	require.NoError(t, registry.LoadYAML([]byte("selectors:\n  77001:\n    selector: 11\n    name: devnet-a\n")))
+
+	target := NewRegistry()
+	var audit bytes.Buffer
+	replica := NewRegistry(WithAuditSink(NewJSONLinesAuditSink(&audit)))
+	require.NoError(t, replica.LoadYAML([]byte("selectors:\n  77001:\n    selector: 11\n    name: devnet-a\n")))
+
+	var changes ChangeSet
+	replica.OnChange(func(c ChangeSet) { changes = c })
+	require.NoError(t, replica.ApplyDelta(Delta{From: registry.Fingerprint(), To: base, Removed: []uint64{77001}}))
+	assert.Equal(t, target.Fingerprint(), replica.Fingerprint())
+	_, exists := replica.ChainBySelector(11)
+	assert.False(t, exists)
+	require.Len(t, changes.Removed, 1)
+	assert.Equal(t, uint64(77001), changes.Removed[0].EvmChainID)
+	assert.Contains(t, audit.String(), `"operation":"remote_removal"`)
+}
+
+func Test_ApplyDeltaRejectsMismatches(t *testing.T) {
+	registry := NewRegistry()
+	fingerprint := registry.Fingerprint()
+
+	err := registry.ApplyDelta(Delta{From: "other", To: fingerprint})
+	assert.True(t, errors.Is(err, ErrDeltaMismatch))
+
+	err = registry.ApplyDelta(Delta{From: fingerprint, To: fingerprint, Added: []DeltaChain{{ChainID: 77001, Selector: 11, Name: "devnet-a"}}})
+	assert.True(t, errors.Is(err, ErrDeltaMismatch))
+	_, exists := registry.ChainBySelector(11)
+	assert.False(t, exists, "a delta not leading to its fingerprint is not applied")
+	assert.Equal(t, fingerprint, registry.Fingerprint())
+}
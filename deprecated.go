@@ -0,0 +1,104 @@
+//go:build !chainsel_strict_api
+
+package chain_selectors
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+)
+
+// The deprecated functions of the package live in this file, which is excluded from builds
+// with the chainsel_strict_api tag so code still calling them fails to compile:
+//
+//	go build -tags chainsel_strict_api ./...
+//
+// cmd/chainsel-migrate rewrites or reports their calls, see internal/migrate. Functions must
+// not be deprecated anywhere else, and the package must not call them.
+
+// ChainIdFromSelector returns the chain id of the EVM chain identified by the selector.
+//
+// Deprecated: this only supports EVM chains, use the chain agnostic GetChainIDFromSelector
+// instead.
+func ChainIdFromSelector(chainSelectorId uint64) (uint64, error) {
+	return chainIdFromSelector(chainSelectorId)
+}
+
+// SelectorFromChainId returns the selector of the EVM chain, generating one for custom chains.
+//
+// Deprecated: this only supports EVM chains, use the chain agnostic
+// GetChainDetailsByChainIDAndFamily instead.
+func SelectorFromChainId(chainId uint64) (uint64, error) {
+	return selectorFromChainId(chainId)
+}
+
+// Enhanced GetChainDetailsByChainIDAndFamily that supports custom chains
+//
+// Deprecated: GetChainDetailsByChainIDAndFamily supports custom chains too, cmd/chainsel-migrate
+// rewrites the calls.
+func GetChainDetailsByChainIDAndFamilyWithCustom(chainID string, family string) (ChainDetails, error) {
+	// First try the standard function
+	details, err := GetChainDetailsByChainIDAndFamily(chainID, family)
+	if err == nil {
+		return details, nil
+	}
+
+	// If not found, check if it's a custom chain
+	if family == FamilyEVM {
+		evmChainId, parseErr := strconv.ParseUint(chainID, 10, 64)
+		if parseErr != nil {
+			return ChainDetails{}, lookupError(InputChainID, family, chainID, reasonMalformed)
+		}
+
+		if isCustomChain(evmChainId) {
+			name := customChainName(evmChainId)
+
+			// Check if custom chain support is enabled
+			if os.Getenv("ENABLE_CUSTOM_CHAINS") != "false" {
+				// Generate deterministic selector for custom chain
+				selector, genErr := customChainSelector(evmChainId)
+				if genErr != nil {
+					return ChainDetails{}, genErr
+				}
+				if genErr = checkCustomGeneration(evmChainId); genErr != nil {
+					return ChainDetails{}, genErr
+				}
+				fmt.Printf("🔧 Generated custom chain selector: %s (ID: %d, Selector: %d)\n",
+					name, evmChainId, selector)
+
+				return ChainDetails{
+					ChainSelector: selector,
+					ChainName:     name,
+				}, nil
+			} else {
+				fmt.Printf("⚠️  Custom chain %d detected but ENABLE_CUSTOM_CHAINS is disabled\n", evmChainId)
+			}
+		}
+	}
+
+	// Return original error if not a custom chain or custom chains disabled
+	return ChainDetails{}, err
+}
+
+// Enhanced GetChainIDFromSelector that supports custom chains
+//
+// Deprecated: GetChainIDFromSelector supports custom chains too, cmd/chainsel-migrate rewrites
+// the calls.
+func GetChainIDFromSelectorWithCustom(selector uint64) (string, error) {
+	// First try the standard function
+	chainID, err := GetChainIDFromSelector(selector)
+	if err == nil {
+		return chainID, nil
+	}
+
+	// Check if it's a custom selector
+	if isCustomSelector(selector) {
+		evmChainId, extractErr := extractChainIdFromCustomSelector(selector)
+		if extractErr == nil {
+			return strconv.FormatUint(evmChainId, 10), nil
+		}
+	}
+
+	// Return original error if not custom
+	return "", err
+}
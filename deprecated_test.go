@@ -0,0 +1,38 @@
+//go:build !chainsel_strict_api
+
+package chain_selectors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeprecatedWrappers(t *testing.T) {
+	selector, err := SelectorFromChainId(ETHEREUM_MAINNET.EvmChainID)
+	require.NoError(t, err)
+	assert.Equal(t, ETHEREUM_MAINNET.Selector, selector)
+
+	chainID, err := ChainIdFromSelector(ETHEREUM_MAINNET.Selector)
+	require.NoError(t, err)
+	assert.Equal(t, ETHEREUM_MAINNET.EvmChainID, chainID)
+
+	details, err := GetChainDetailsByChainIDAndFamilyWithCustom("1", FamilyEVM)
+	require.NoError(t, err)
+	assert.Equal(t, ETHEREUM_MAINNET.Selector, details.ChainSelector)
+
+	id, err := GetChainIDFromSelectorWithCustom(ETHEREUM_MAINNET.Selector)
+	require.NoError(t, err)
+	assert.Equal(t, "1", id)
+}
+
+func TestDeprecatedCustomLookupsRespectLimits(t *testing.T) {
+	SetCustomSelectorLimits(CustomSelectorLimits{MaxChains: 1})
+	t.Cleanup(func() { SetCustomSelectorLimits(CustomSelectorLimits{}) })
+
+	_, err := GetChainDetailsByChainIDAndFamilyWithCustom("9388321", FamilyEVM)
+	require.NoError(t, err)
+	_, err = GetChainDetailsByChainIDAndFamilyWithCustom("9388322", FamilyEVM)
+	require.ErrorIs(t, err, ErrCustomSelectorLimit)
+}
@@ -0,0 +1,87 @@
+package chain_selectors
+
+import (
+	"fmt"
+	"io"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DevnetManifest describes the chains of a local multi-chain environment, for instance one
+// started with Kurtosis.
+//
+//	chains:
+//	  - chain_id: 3151908
+//	    name: devnet-l1
+//	    rpcs:
+//	      - http://127.0.0.1:8545
+//	    native_currency:
+//	      name: Ether
+//	      symbol: ETH
+//	      decimals: 18
+type DevnetManifest struct {
+	Chains []DevnetChain `yaml:"chains"`
+}
+
+// DevnetChain is a single chain of a DevnetManifest.
+type DevnetChain struct {
+	ChainID        uint64          `yaml:"chain_id"`
+	Name           string          `yaml:"name"`
+	DisplayName    string          `yaml:"display_name,omitempty"`
+	RPCs           []string        `yaml:"rpcs,omitempty"`
+	Explorers      []string        `yaml:"explorers,omitempty"`
+	NativeCurrency *NativeCurrency `yaml:"native_currency,omitempty"`
+}
+
+// defaultDevnetCurrency is assumed for devnet chains that do not declare their native currency.
+var defaultDevnetCurrency = NativeCurrency{Name: "Ether", Symbol: "ETH", Decimals: 18}
+
+// LoadDevnetManifest reads a DevnetManifest and registers every chain it lists as a custom
+// chain together with its metadata, which GetChainMetadata returns afterwards. The chains are
+// registered with RegisterCustomChains, so either all of them are registered or none is. The
+// registered chains are returned in manifest order.
+func LoadDevnetManifest(r io.Reader) ([]Chain, error) {
+	var manifest DevnetManifest
+	decoder := yaml.NewDecoder(r)
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&manifest); err != nil {
+		return nil, fmt.Errorf("failed to decode devnet manifest: %w", err)
+	}
+	if len(manifest.Chains) == 0 {
+		return nil, fmt.Errorf("devnet manifest lists no chains")
+	}
+
+	specs := make([]CustomChainSpec, len(manifest.Chains))
+	for i, ch := range manifest.Chains {
+		currency := defaultDevnetCurrency
+		if ch.NativeCurrency != nil {
+			currency = *ch.NativeCurrency
+		}
+		specs[i] = CustomChainSpec{
+			ChainID: ch.ChainID,
+			Name:    ch.Name,
+			Metadata: &ChainMetadata{
+				DisplayName:    ch.DisplayName,
+				NativeCurrency: currency,
+				Explorers:      ch.Explorers,
+				RPCs:           ch.RPCs,
+			},
+		}
+	}
+
+	selectors, err := RegisterCustomChains(specs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to register devnet chains: %w", err)
+	}
+
+	chains := make([]Chain, len(specs))
+	for i, spec := range specs {
+		chains[i] = Chain{
+			EvmChainID: spec.ChainID,
+			Selector:   selectors[i],
+			Name:       spec.Name,
+			VarName:    generateCustomChainVarName(spec.ChainID),
+		}
+	}
+	return chains, nil
+}
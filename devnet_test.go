@@ -0,0 +1,69 @@
+package chain_selectors
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadDevnetManifest(t *testing.T) {
+	manifest := `
+chains:
+  - chain_id: 6600000001
+    name: manifest-l1
+    display_name: Manifest L1
+    rpcs:
+      - http://127.0.0.1:8545
+  - chain_id: 6600000002
+    name: manifest-l2
+    rpcs:
+      - http://127.0.0.1:9545
+    native_currency:
+      name: Gas
+      symbol: GAS
+      decimals: 6
+`
+	chains, err := LoadDevnetManifest(strings.NewReader(manifest))
+	require.NoError(t, err)
+	require.Len(t, chains, 2)
+
+	assert.Equal(t, uint64(6600000001), chains[0].EvmChainID)
+	assert.Equal(t, "manifest-l1", chains[0].Name)
+	assert.Equal(t, generateCustomChainSelector(6600000001), chains[0].Selector)
+
+	metadata, err := GetChainMetadata(chains[0].Selector)
+	require.NoError(t, err)
+	assert.Equal(t, ChainMetadata{
+		DisplayName:    "Manifest L1",
+		NativeCurrency: defaultDevnetCurrency,
+		RPCs:           []string{"http://127.0.0.1:8545"},
+	}, metadata)
+
+	metadata, err = GetChainMetadata(chains[1].Selector)
+	require.NoError(t, err)
+	assert.Equal(t, NativeCurrency{Name: "Gas", Symbol: "GAS", Decimals: 6}, metadata.NativeCurrency)
+	assert.Equal(t, []string{"http://127.0.0.1:9545"}, metadata.RPCs)
+}
+
+func TestLoadDevnetManifestInvalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		manifest string
+	}{
+		{name: "empty", manifest: "chains: []"},
+		{name: "unknown field", manifest: "chains:\n  - chain_id: 6600000101\n    name: typo\n    rpc: http://127.0.0.1:8545\n"},
+		{name: "official chain", manifest: "chains:\n  - chain_id: 6600000102\n    name: ok\n  - chain_id: 1\n    name: mainnet\n"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := LoadDevnetManifest(strings.NewReader(test.manifest))
+			require.Error(t, err)
+		})
+	}
+
+	_, err := GetChainMetadata(generateCustomChainSelector(6600000102))
+	assert.Error(t, err, "chains of a rejected manifest must not be registered")
+}
@@ -0,0 +1,192 @@
+// Package devtools helps integration test harnesses use chains spawned locally, for instance
+// with Anvil, Hardhat or a geth dev node, with the chain selectors package.
+package devtools
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	chainselectors "github.com/fravlaca/chain-selectors"
+)
+
+// RegisterLocalChain queries the chain id of the EVM node listening at rpcURL and registers it
+// as a custom chain under name, returning the Chain tests should use. Nodes running a known
+// chain, for instance a mainnet fork keeping its chain id, resolve to the official chain and
+// are not registered. Chains RegisterCustomChains refuses, for instance because their selector
+// would shadow the one of an official chain, fail to register.
+func RegisterLocalChain(ctx context.Context, rpcURL, name string) (chainselectors.Chain, error) {
+	return RegisterLocalChainWithClient(ctx, http.DefaultClient, rpcURL, name)
+}
+
+// RegisterLocalChainWithClient is RegisterLocalChain using the given HTTP client.
+func RegisterLocalChainWithClient(ctx context.Context, client *http.Client, rpcURL, name string) (chainselectors.Chain, error) {
+	chainID, err := EthChainID(ctx, client, rpcURL)
+	if err != nil {
+		return chainselectors.Chain{}, err
+	}
+
+	if _, official := chainselectors.EvmChainIdToChainSelector()[chainID]; official {
+		ch, _ := chainselectors.ChainByEvmChainID(chainID)
+		return ch, nil
+	}
+
+	selectors, err := chainselectors.RegisterCustomChains([]chainselectors.CustomChainSpec{{ChainID: chainID, Name: name}})
+	if err != nil {
+		return chainselectors.Chain{}, fmt.Errorf("failed to register local chain %d as %s: %w", chainID, name, err)
+	}
+	ch, exists := chainselectors.ChainBySelector(selectors[0])
+	if !exists {
+		return chainselectors.Chain{}, fmt.Errorf("registered chain %d cannot be resolved by selector %d", chainID, selectors[0])
+	}
+	return ch, nil
+}
+
+type rpcRequest struct {
+	JSONRPC string        `json:"jsonrpc"`
+	ID      int           `json:"id"`
+	Method  string        `json:"method"`
+	Params  []interface{} `json:"params"`
+}
+
+type rpcResponse struct {
+	Result json.RawMessage `json:"result"`
+	Error  *rpcError       `json:"error"`
+}
+
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// EthChainID returns the chain id reported by the eth_chainId method of the node at rpcURL.
+func EthChainID(ctx context.Context, client *http.Client, rpcURL string) (uint64, error) {
+	result, err := call(ctx, client, rpcURL, "eth_chainId")
+	if err != nil {
+		return 0, err
+	}
+
+	hexID, found := strings.CutPrefix(result, "0x")
+	if !found {
+		return 0, fmt.Errorf("eth_chainId at %s returned %q, expected a 0x prefixed quantity", rpcURL, result)
+	}
+	chainID, err := strconv.ParseUint(hexID, 16, 64)
+	if err != nil {
+		return 0, fmt.Errorf("eth_chainId at %s returned %q: %w", rpcURL, result, err)
+	}
+	return chainID, nil
+}
+
+// NodeInfo is what an EVM node reports about the chain it runs.
+type NodeInfo struct {
+	ChainID uint64
+	// ClientVersion is empty if the node does not serve web3_clientVersion.
+	ClientVersion string
+	// GenesisHash is the 0x prefixed hash of block 0.
+	GenesisHash string
+}
+
+// ProbeNode queries the chain id, client version and genesis hash of the EVM node at rpcURL,
+// for instance to check whether the chain it runs is known before onboarding it.
+func ProbeNode(ctx context.Context, client *http.Client, rpcURL string) (NodeInfo, error) {
+	chainID, err := EthChainID(ctx, client, rpcURL)
+	if err != nil {
+		return NodeInfo{}, err
+	}
+	info := NodeInfo{ChainID: chainID}
+	// many public endpoints disable the web3 namespace, the version is informational only
+	if version, err := call(ctx, client, rpcURL, "web3_clientVersion"); err == nil {
+		info.ClientVersion = version
+	}
+
+	raw, err := callRaw(ctx, client, rpcURL, "eth_getBlockByNumber", "0x0", false)
+	if err != nil {
+		return NodeInfo{}, err
+	}
+	var genesis *struct {
+		Hash string `json:"hash"`
+	}
+	if err := json.Unmarshal(raw, &genesis); err != nil {
+		return NodeInfo{}, fmt.Errorf("eth_getBlockByNumber at %s returned an invalid block: %w", rpcURL, err)
+	}
+	if genesis == nil || len(genesis.Hash) != 66 || !strings.HasPrefix(genesis.Hash, "0x") {
+		return NodeInfo{}, fmt.Errorf("eth_getBlockByNumber at %s returned no genesis block hash", rpcURL)
+	}
+	info.GenesisHash = strings.ToLower(genesis.Hash)
+	return info, nil
+}
+
+// CodeReader reads contract code with the eth_getCode method of the node at rpcURL, at the
+// latest block, for instance to run chainselectors.VerifyWellKnownContracts against it.
+func CodeReader(client *http.Client, rpcURL string) chainselectors.CodeReader {
+	return chainselectors.CodeReaderFunc(func(ctx context.Context, address string) ([]byte, error) {
+		result, err := call(ctx, client, rpcURL, "eth_getCode", address, "latest")
+		if err != nil {
+			return nil, err
+		}
+
+		hexCode, found := strings.CutPrefix(result, "0x")
+		if !found {
+			return nil, fmt.Errorf("eth_getCode at %s returned %q, expected 0x prefixed data", rpcURL, result)
+		}
+		code, err := hex.DecodeString(hexCode)
+		if err != nil {
+			return nil, fmt.Errorf("eth_getCode at %s returned invalid data: %w", rpcURL, err)
+		}
+		return code, nil
+	})
+}
+
+// call invokes the JSON-RPC method of the node at rpcURL and returns its string result.
+func call(ctx context.Context, client *http.Client, rpcURL, method string, params ...interface{}) (string, error) {
+	raw, err := callRaw(ctx, client, rpcURL, method, params...)
+	if err != nil {
+		return "", err
+	}
+	var result string
+	if err := json.Unmarshal(raw, &result); err != nil {
+		return "", fmt.Errorf("%s at %s returned %s, expected a string", method, rpcURL, raw)
+	}
+	return result, nil
+}
+
+// callRaw invokes the JSON-RPC method of the node at rpcURL and returns its undecoded result.
+func callRaw(ctx context.Context, client *http.Client, rpcURL, method string, params ...interface{}) (json.RawMessage, error) {
+	if params == nil {
+		params = []interface{}{}
+	}
+	body, err := json.Marshal(rpcRequest{JSONRPC: "2.0", ID: 1, Method: method, Params: params})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, rpcURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("invalid rpc url %q: %w", rpcURL, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query %s at %s: %w", method, rpcURL, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s at %s returned status %s", method, rpcURL, resp.Status)
+	}
+
+	var result rpcResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode %s response from %s: %w", method, rpcURL, err)
+	}
+	if result.Error != nil {
+		return nil, fmt.Errorf("%s at %s failed: %s (code %d)", method, rpcURL, result.Error.Message, result.Error.Code)
+	}
+	return result.Result, nil
+}
@@ -0,0 +1,119 @@
+package devtools
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	chainselectors "github.com/fravlaca/chain-selectors"
+)
+
+func newNode(t *testing.T, response string) *httptest.Server {
+	return newNodeFor(t, "eth_chainId", response)
+}
+
+func newNodeFor(t *testing.T, method, response string) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req rpcRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, method, req.Method)
+		_, _ = w.Write([]byte(response))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestRegisterLocalChain(t *testing.T) {
+	node := newNode(t, `{"jsonrpc":"2.0","id":1,"result":"0x1a4b5c7"}`)
+
+	ch, err := RegisterLocalChain(context.Background(), node.URL, "anvil-local")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0x1a4b5c7), ch.EvmChainID)
+	assert.Equal(t, "anvil-local", ch.Name)
+
+	// the returned chain is the one lookups resolve
+	byName, exists := chainselectors.ChainByName("anvil-local")
+	require.True(t, exists)
+	assert.Equal(t, ch, byName)
+	bySelector, exists := chainselectors.ChainBySelector(ch.Selector)
+	require.True(t, exists)
+	assert.Equal(t, ch, bySelector)
+}
+
+func TestRegisterLocalChainOfficialFork(t *testing.T) {
+	node := newNode(t, `{"jsonrpc":"2.0","id":1,"result":"0x1"}`)
+
+	ch, err := RegisterLocalChain(context.Background(), node.URL, "mainnet-fork")
+	require.NoError(t, err)
+	assert.Equal(t, chainselectors.ETHEREUM_MAINNET, ch)
+}
+
+func TestRegisterLocalChainShadowingOfficialSelector(t *testing.T) {
+	// the direct encoding of this chain id is the selector of zora-testnet
+	node := newNode(t, `{"jsonrpc":"2.0","id":1,"result":"0x16e5a7fd84a5e5f"}`)
+
+	_, err := RegisterLocalChain(context.Background(), node.URL, "shadowing-local")
+	require.Error(t, err)
+	_, exists := chainselectors.ChainByName("shadowing-local")
+	assert.False(t, exists)
+}
+
+func TestEthChainIDErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		response string
+	}{
+		{name: "rpc error", response: `{"jsonrpc":"2.0","id":1,"error":{"code":-32601,"message":"method not found"}}`},
+		{name: "not hex", response: `{"jsonrpc":"2.0","id":1,"result":"1337"}`},
+		{name: "invalid quantity", response: `{"jsonrpc":"2.0","id":1,"result":"0xzz"}`},
+		{name: "invalid json", response: `{`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			node := newNode(t, test.response)
+			_, err := EthChainID(context.Background(), http.DefaultClient, node.URL)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestCodeReader(t *testing.T) {
+	node := newNodeFor(t, "eth_getCode", `{"jsonrpc":"2.0","id":1,"result":"0x6080"}`)
+	code, err := CodeReader(http.DefaultClient, node.URL).CodeAt(context.Background(), "0xcA11bde05977b3631167028862bE2a173976CA11")
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0x60, 0x80}, code)
+
+	node = newNodeFor(t, "eth_getCode", `{"jsonrpc":"2.0","id":1,"result":"0x6"}`)
+	_, err = CodeReader(http.DefaultClient, node.URL).CodeAt(context.Background(), "0xcA11bde05977b3631167028862bE2a173976CA11")
+	require.Error(t, err)
+}
+
+func TestProbeNode(t *testing.T) {
+	genesis := "0xD4E56740F876AEF8C010B86A40D5F56745A118D0906A34E69AEC8C0DB1CB8FA3"
+	responses := map[string]string{
+		"eth_chainId":          `{"jsonrpc":"2.0","id":1,"result":"0x1a4b5c7"}`,
+		"web3_clientVersion":   `{"jsonrpc":"2.0","id":1,"error":{"code":-32601,"message":"method not found"}}`,
+		"eth_getBlockByNumber": `{"jsonrpc":"2.0","id":1,"result":{"number":"0x0","hash":"` + genesis + `"}}`,
+	}
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req rpcRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		_, _ = w.Write([]byte(responses[req.Method]))
+	}))
+	t.Cleanup(node.Close)
+
+	info, err := ProbeNode(context.Background(), http.DefaultClient, node.URL)
+	require.NoError(t, err)
+	assert.Equal(t, NodeInfo{ChainID: 0x1a4b5c7, GenesisHash: "0xd4e56740f876aef8c010b86a40d5f56745a118d0906a34e69aec8c0db1cb8fa3"}, info)
+
+	responses["web3_clientVersion"] = `{"jsonrpc":"2.0","id":1,"result":"anvil/v0.2.0"}`
+	responses["eth_getBlockByNumber"] = `{"jsonrpc":"2.0","id":1,"result":null}`
+	_, err = ProbeNode(context.Background(), http.DefaultClient, node.URL)
+	assert.ErrorContains(t, err, "no genesis block hash")
+}
@@ -0,0 +1,26 @@
+// Package chain_selectors maps the chain selectors used by CCIP to the chain ids and names
+// used by the chains themselves, for every supported chain family.
+//
+// # Concurrency
+//
+// Every exported function and every Registry method is safe for concurrent use. The embedded
+// datasets are parsed at most once, each family and file on its first use, and never modified
+// afterwards. They are all parsed during package initialisation unless the package is built
+// with the chainsel_lazy tag, in which case the first lookup needing a dataset parses it while
+// concurrent lookups needing the same dataset wait for it, see WarmUp. The state mutated at
+// runtime, such as the custom chains registered with RegisterCustomChain and the chains loaded
+// into a Registry with LoadYAML, is updated copy-on-write: writers are serialized, build a
+// modified copy and publish it with an atomic pointer swap, so lookups never observe a partial
+// update. Lookups of loaded and embedded chains read that state without locking. Lookups
+// generating a custom selector take a short lock while limits set with
+// SetCustomSelectorLimits are enforced. Options of a Registry are fixed when it is created.
+//
+// Exported collections such as ALL or ChainsByVarName are shared with the package and must be
+// treated as read-only by callers; functions returning maps or slices return copies instead.
+//
+// # Family agnostic API
+//
+// The registryapi subpackage exposes the same chains through a single family agnostic Registry
+// and is recommended for new code. It is implemented on top of this package, which remains
+// supported, so both resolve chains from the same datasets and custom chains.
+package chain_selectors
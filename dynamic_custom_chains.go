@@ -3,15 +3,155 @@ package chain_selectors
 import (
 	"crypto/sha256"
 	"encoding/binary"
+	"errors"
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
 )
 
 // CUSTOM_CHAIN_RANGE defines the range for custom/testnet chains
 // Any chain ID above this value will be treated as a custom chain
 const CUSTOM_CHAIN_RANGE = uint64(1000000)
 
+// customChainRegistry holds the names of explicitly registered custom chains keyed by chain ID.
+// It is safe for concurrent use: readers load the current map without locking, writers are
+// serialized and publish a modified copy, so a published map is never mutated.
+type customChainRegistry struct {
+	mu       sync.Mutex
+	names    atomic.Pointer[map[uint64]string]
+	metadata atomic.Pointer[map[uint64]ChainMetadata]
+}
+
+var customChains = newCustomChainRegistry()
+
+func newCustomChainRegistry() *customChainRegistry {
+	c := &customChainRegistry{}
+	c.names.Store(&map[uint64]string{})
+	c.metadata.Store(&map[uint64]ChainMetadata{})
+	return c
+}
+
+// register registers the chain under the name and returns the name it was registered under
+// before, if any.
+func (c *customChainRegistry) register(chainID uint64, name string) (previous string, existed bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	current := *c.names.Load()
+	next := make(map[uint64]string, len(current)+1)
+	for id, n := range current {
+		next[id] = n
+	}
+	next[chainID] = name
+	c.names.Store(&next)
+	previous, existed = current[chainID]
+	return previous, existed
+}
+
+// registerAll validates every spec against the embedded chains, the registered custom chains
+// and the other specs, and registers all of them or none. It returns the chain ids of the specs
+// that were not registered before.
+func (c *customChainRegistry) registerAll(specs []CustomChainSpec) (added map[uint64]bool, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	current := *c.names.Load()
+	if err := validateCustomChainSpecs(current, specs); err != nil {
+		return nil, err
+	}
+
+	added = make(map[uint64]bool, len(specs))
+	next := make(map[uint64]string, len(current)+len(specs))
+	for id, n := range current {
+		next[id] = n
+	}
+	currentMetadata := *c.metadata.Load()
+	nextMetadata := make(map[uint64]ChainMetadata, len(currentMetadata))
+	for id, m := range currentMetadata {
+		nextMetadata[id] = m
+	}
+	for _, spec := range specs {
+		if _, exists := current[spec.ChainID]; !exists {
+			added[spec.ChainID] = true
+		}
+		next[spec.ChainID] = spec.Name
+		if spec.Metadata != nil {
+			nextMetadata[spec.ChainID] = spec.Metadata.clone()
+		}
+	}
+	c.metadata.Store(&nextMetadata)
+	c.names.Store(&next)
+	return added, nil
+}
+
+// validateCustomChainSpecs validates the specs against the embedded chains, the custom chains
+// registered in current and the other specs, see RegisterCustomChains.
+func validateCustomChainSpecs(current map[uint64]string, specs []CustomChainSpec) error {
+	var errs MultiError
+	batchIDs := make(map[uint64]int, len(specs))
+	batchNames := make(map[string]int, len(specs))
+	registeredIDs := make(map[string]uint64, len(current))
+	for chainID, name := range current {
+		registeredIDs[name] = chainID
+	}
+	for i, spec := range specs {
+		item := fmt.Sprintf("custom chain %d", spec.ChainID)
+		if err := spec.validate(); err != nil {
+			errs.add(i, item, err)
+			continue
+		}
+		if registered, exists := current[spec.ChainID]; exists && registered != spec.Name {
+			errs.add(i, item, fmt.Errorf("already registered as %q", registered))
+		}
+		if owner, exists := registeredIDs[spec.Name]; exists && owner != spec.ChainID {
+			errs.add(i, item, fmt.Errorf("name %q is already registered for chain %d", spec.Name, owner))
+		}
+		if first, exists := batchIDs[spec.ChainID]; exists {
+			errs.add(i, item, fmt.Errorf("chain id duplicates index %d", first))
+		} else {
+			batchIDs[spec.ChainID] = i
+		}
+		if first, exists := batchNames[spec.Name]; exists {
+			errs.add(i, item, fmt.Errorf("name %q duplicates index %d", spec.Name, first))
+		} else {
+			batchNames[spec.Name] = i
+		}
+	}
+	return errs.errOrNil()
+}
+
+func (c *customChainRegistry) chainMetadata(chainID uint64) (ChainMetadata, bool) {
+	metadata, exists := (*c.metadata.Load())[chainID]
+	return metadata, exists
+}
+
+func (c *customChainRegistry) name(chainID uint64) (string, bool) {
+	name, exists := (*c.names.Load())[chainID]
+	return name, exists
+}
+
+// chainID returns the chain id registered under the name, the lowest one if several are.
+func (c *customChainRegistry) chainID(name string) (uint64, bool) {
+	var (
+		chainID uint64
+		found   bool
+	)
+	for id, n := range *c.names.Load() {
+		if n == name && (!found || id < chainID) {
+			chainID, found = id, true
+		}
+	}
+	return chainID, found
+}
+
+func (c *customChainRegistry) count() int {
+	return len(*c.names.Load())
+}
+
+// customChainNamePrefix prefixes the names generated for custom chains
+const customChainNamePrefix = "custom-testnet-"
+
 // No longer needed - using direct O(1) encoding/decoding
 // Keeping imports for backward compatibility if needed
 
@@ -23,18 +163,86 @@ func generateCustomChainSelector(chainID uint64) uint64 {
 	// Ensure chain ID fits in 60 bits (leaving 4 for 0xE marker)
 	if chainID > 0x0FFFFFFFFFFFFFFF {
 		// For very large chain IDs, fall back to hash-based approach
-		hash := sha256.Sum256([]byte(fmt.Sprintf("custom-testnet-chain-%d", chainID)))
-		selector := binary.BigEndian.Uint64(hash[:8])
-		return 0xE000000000000000 | (selector & 0x0FFFFFFFFFFFFFFF)
+		return hashedSelectors.selector(chainID, hashCustomChainSelector)
 	}
 
 	// Direct encoding: 0xE prefix + chain ID (O(1) reversible)
 	return 0xE000000000000000 | chainID
 }
 
+// customChainSelector returns the selector generated for the custom chain, failing if it is
+// assigned to an official chain. Every selector handed out for a custom chain goes through it.
+func customChainSelector(chainID uint64) (uint64, error) {
+	selector := generateCustomChainSelector(chainID)
+	if customSelectorCollides(selector) {
+		return 0, lookupError(InputChainID, FamilyEVM, strconv.FormatUint(chainID, 10),
+			fmt.Sprintf("generated custom selector %d is assigned to an official chain", selector))
+	}
+	return selector, nil
+}
+
+// hashCustomChainSelector derives the selector of a chain ID too large for the direct encoding
+func hashCustomChainSelector(chainID uint64) uint64 {
+	hash := sha256.Sum256([]byte(fmt.Sprintf("custom-testnet-chain-%d", chainID)))
+	selector := binary.BigEndian.Uint64(hash[:8])
+	return 0xE000000000000000 | (selector & 0x0FFFFFFFFFFFFFFF)
+}
+
+// customChainVarNamePrefix prefixes the variable style names generated for custom chains
+const customChainVarNamePrefix = "CUSTOM_TESTNET_"
+
+// nameBuffers recycles the scratch buffers custom chain names are formatted into
+var nameBuffers = sync.Pool{
+	New: func() any {
+		buf := make([]byte, 0, len(customChainVarNamePrefix)+20)
+		return &buf
+	},
+}
+
+// AppendCustomChainName appends the name generated for the custom chain to dst and returns the
+// extended buffer, like strconv.AppendUint. It lets callers generating many synthetic chains
+// reuse a single buffer instead of allocating a string per chain.
+func AppendCustomChainName(dst []byte, chainID uint64) []byte {
+	dst = append(dst, customChainNamePrefix...)
+	return strconv.AppendUint(dst, chainID, 10)
+}
+
 // generateCustomChainName creates a name for custom chains
 func generateCustomChainName(chainID uint64) string {
-	return fmt.Sprintf("custom-testnet-%d", chainID)
+	return formatPooled(chainID, AppendCustomChainName)
+}
+
+// customChainName returns the name the custom chain was registered under, or the generated one
+func customChainName(chainID uint64) string {
+	if name, registered := customChains.name(chainID); registered {
+		return name
+	}
+	return generateCustomChainName(chainID)
+}
+
+// customChainVarName returns the variable style name of customChainName
+func customChainVarName(chainID uint64) string {
+	if name, registered := customChains.name(chainID); registered {
+		return strings.ToUpper(strings.ReplaceAll(name, "-", "_"))
+	}
+	return generateCustomChainVarName(chainID)
+}
+
+// generateCustomChainVarName creates the variable style name of custom chains
+func generateCustomChainVarName(chainID uint64) string {
+	return formatPooled(chainID, func(dst []byte, chainID uint64) []byte {
+		dst = append(dst, customChainVarNamePrefix...)
+		return strconv.AppendUint(dst, chainID, 10)
+	})
+}
+
+// formatPooled formats the chain into a pooled buffer, so only the returned string is allocated
+func formatPooled(chainID uint64, format func([]byte, uint64) []byte) string {
+	buf := nameBuffers.Get().(*[]byte)
+	*buf = format((*buf)[:0], chainID)
+	s := string(*buf)
+	nameBuffers.Put(buf)
+	return s
 }
 
 // isCustomChain determines if a chain ID should be treated as custom
@@ -51,7 +259,7 @@ func isCustomSelector(selector uint64) bool {
 
 // isInOfficialSelectors checks if chain ID exists in official selectors
 func isInOfficialSelectors(chainID uint64) bool {
-	_, exists := evmChainIdToChainSelector[chainID]
+	_, exists := evmChainIdToChainSelector()[chainID]
 	return exists
 }
 
@@ -81,106 +289,135 @@ func populateCommonCustomChains() {
 	// No-op: direct encoding eliminates need for pre-population
 }
 
-// Enhanced GetChainDetailsByChainIDAndFamily that supports custom chains
-func GetChainDetailsByChainIDAndFamilyWithCustom(chainID string, family string) (ChainDetails, error) {
-	// First try the standard function
-	details, err := GetChainDetailsByChainIDAndFamily(chainID, family)
-	if err == nil {
-		return details, nil
+// RegisterCustomChain manually registers a custom chain for immediate use. It registers nothing
+// and returns 0 if the chain id lies in the range of LeaseEphemeralChain or the selector
+// generated for the chain is assigned to an official chain, RegisterCustomChains reports why a
+// chain cannot be registered.
+func RegisterCustomChain(chainID uint64, name string) uint64 {
+	if isEphemeralChainID(chainID) {
+		return 0
+	}
+	selector, err := customChainSelector(chainID)
+	if err != nil {
+		return 0
 	}
 
-	// If not found, check if it's a custom chain
-	if family == FamilyEVM {
-		evmChainId, parseErr := strconv.ParseUint(chainID, 10, 64)
-		if parseErr != nil {
-			return ChainDetails{}, fmt.Errorf("invalid chain id %s for %s", chainID, family)
-		}
+	previous, existed := customChains.register(chainID, name)
+	emitCustomSelector(CustomSelectorGenerated, chainID, selector)
+	_ = auditCustomRegistration(chainID, name, previous, existed)
 
-		if isCustomChain(evmChainId) {
-			// Generate deterministic selector for custom chain
-			selector := generateCustomChainSelector(evmChainId)
-			name := generateCustomChainName(evmChainId)
-
-			// Check if custom chain support is enabled
-			if os.Getenv("ENABLE_CUSTOM_CHAINS") != "false" {
-				fmt.Printf("🔧 Generated custom chain selector: %s (ID: %d, Selector: %d)\n",
-					name, evmChainId, selector)
-
-				return ChainDetails{
-					ChainSelector: selector,
-					ChainName:     name,
-				}, nil
-			} else {
-				fmt.Printf("⚠️  Custom chain %d detected but ENABLE_CUSTOM_CHAINS is disabled\n", evmChainId)
-			}
-		}
-	}
+	fmt.Printf("✅ Registered custom chain: %s (ID: %d, Selector: %d)\n",
+		name, chainID, selector)
 
-	// Return original error if not a custom chain or custom chains disabled
-	return ChainDetails{}, err
+	return selector
 }
 
-// Enhanced GetChainIDFromSelector that supports custom chains
-func GetChainIDFromSelectorWithCustom(selector uint64) (string, error) {
-	// First try the standard function
-	chainID, err := GetChainIDFromSelector(selector)
-	if err == nil {
-		return chainID, nil
-	}
+// CustomChainSpec describes a custom chain to register with RegisterCustomChains.
+type CustomChainSpec struct {
+	ChainID uint64
+	Name    string
+	// Metadata is optional, when set it is returned by GetChainMetadata for the chain.
+	Metadata *ChainMetadata
+}
 
-	// Check if it's a custom selector
-	if isCustomSelector(selector) {
-		evmChainId, extractErr := extractChainIdFromCustomSelector(selector)
-		if extractErr == nil {
-			return strconv.FormatUint(evmChainId, 10), nil
-		}
+func (s CustomChainSpec) validate() error {
+	if s.ChainID == 0 {
+		return errors.New("chain id must not be zero")
 	}
-
-	// Return original error if not custom
-	return "", err
+	if isInOfficialSelectors(s.ChainID) {
+		return errors.New("chain id belongs to an official chain")
+	}
+	if isEphemeralChainID(s.ChainID) {
+		return errors.New("chain id lies in the ephemeral range, lease it with LeaseEphemeralChain")
+	}
+	if _, err := customChainSelector(s.ChainID); err != nil {
+		return errors.New("generated selector is assigned to an official chain")
+	}
+	if s.Name == "" {
+		return errors.New("name must not be empty")
+	}
+	if _, exists := evmChainsByName()[s.Name]; exists {
+		return fmt.Errorf("name %q belongs to an official chain", s.Name)
+	}
+	if s.Metadata != nil {
+		return validateMetadata(*s.Metadata)
+	}
+	return nil
 }
 
-// RegisterCustomChain manually registers a custom chain for immediate use
-func RegisterCustomChain(chainID uint64, name string) uint64 {
-	selector := generateCustomChainSelector(chainID)
-
-	fmt.Printf("✅ Registered custom chain: %s (ID: %d, Selector: %d)\n",
-		name, chainID, selector)
+// RegisterCustomChains registers many custom chains at once and returns their selectors in
+// the order of the specs. The specs are validated together: a chain id must not be zero, must
+// not belong to an official chain or lie in the range of LeaseEphemeralChain, must not generate
+// the selector of an official chain and must not already be registered under another name, and
+// names must be non-empty, unique and not used by an official or another registered chain.
+// Either every chain is registered or, if any spec is invalid, none is and the returned
+// *MultiError holds one error per problem, indexed by the offending spec. With an audit sink
+// set by SetAuditSink, an error of the sink is returned along with the selectors of the
+// registered chains. Lookups return the chains under their registered names, and ChainByName
+// resolves them.
+func RegisterCustomChains(specs []CustomChainSpec) ([]uint64, error) {
+	added, err := customChains.registerAll(specs)
+	if err != nil {
+		return nil, err
+	}
+	return customChainsRegistered(specs, added)
+}
 
-	return selector
+// customChainsRegistered reports the registration of the specs, added holding the chain ids
+// registered for the first time, and returns their selectors.
+func customChainsRegistered(specs []CustomChainSpec, added map[uint64]bool) ([]uint64, error) {
+	selectors := make([]uint64, len(specs))
+	var auditErr error
+	for i, spec := range specs {
+		selectors[i] = generateCustomChainSelector(spec.ChainID)
+		emitCustomSelector(CustomSelectorGenerated, spec.ChainID, selectors[i])
+		if err := auditCustomRegistration(spec.ChainID, spec.Name, spec.Name, !added[spec.ChainID]); err != nil && auditErr == nil {
+			auditErr = fmt.Errorf("custom chains registered but not audited: %w", err)
+		}
+	}
+	return selectors, auditErr
 }
 
 // GetCustomChainSelector is the main function to get selector for any chain
 func GetCustomChainSelector(chainID uint64) (uint64, error) {
 	// First check if it's in official selectors
-	if details, exists := evmChainIdToChainSelector[chainID]; exists {
+	if details, exists := evmChainIdToChainSelector()[chainID]; exists {
 		return details.ChainSelector, nil
 	}
 
 	// Generate deterministic selector for custom chains
 	if isCustomChain(chainID) {
 		if os.Getenv("ENABLE_CUSTOM_CHAINS") != "false" {
-			selector := generateCustomChainSelector(chainID)
+			selector, err := customChainSelector(chainID)
+			if err != nil {
+				return 0, err
+			}
+			if err := checkCustomGeneration(chainID); err != nil {
+				return 0, err
+			}
 			name := generateCustomChainName(chainID)
 
 			fmt.Printf("🔧 Generated custom chain selector: %s (ID: %d, Selector: %d)\n",
 				name, chainID, selector)
+			emitCustomSelector(CustomSelectorGenerated, chainID, selector)
 
 			return selector, nil
 		} else {
-			return 0, fmt.Errorf("custom chain %d detected but ENABLE_CUSTOM_CHAINS is disabled", chainID)
+			return 0, lookupError(InputChainID, FamilyEVM, strconv.FormatUint(chainID, 10), "custom chains are disabled by ENABLE_CUSTOM_CHAINS")
 		}
 	}
 
-	return 0, fmt.Errorf("chain selector not found for chain %d", chainID)
+	return 0, chainIDNotFoundError(FamilyEVM, chainID)
 }
 
-// ListAllChains returns both official and custom chains in a range
+// ListAllChains returns both official and custom chains in a range. Custom chains are listed
+// only while selectors may be generated for them, see SetCustomSelectorLimits, listing them
+// does not count against the limits.
 func ListAllChains(startChainID, endChainID uint64) []ChainDetails {
 	var chains []ChainDetails
 
 	// Add official chains in range
-	for chainID, details := range evmChainIdToChainSelector {
+	for chainID, details := range evmChainIdToChainSelector() {
 		if chainID >= startChainID && chainID <= endChainID {
 			chains = append(chains, details)
 		}
@@ -189,15 +426,17 @@ func ListAllChains(startChainID, endChainID uint64) []ChainDetails {
 	// Add custom chains in range (if enabled)
 	if os.Getenv("ENABLE_CUSTOM_CHAINS") != "false" {
 		for chainID := startChainID; chainID <= endChainID; chainID++ {
-			if isCustomChain(chainID) && !isInOfficialSelectors(chainID) {
-				selector := generateCustomChainSelector(chainID)
-				name := generateCustomChainName(chainID)
-
-				chains = append(chains, ChainDetails{
-					ChainSelector: selector,
-					ChainName:     name,
-				})
+			if !isCustomChain(chainID) {
+				continue
+			}
+			selector, err := customChainSelector(chainID)
+			if err != nil || peekCustomGeneration(chainID) != nil {
+				continue
 			}
+			chains = append(chains, ChainDetails{
+				ChainSelector: selector,
+				ChainName:     customChainName(chainID),
+			})
 		}
 	}
 
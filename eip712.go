@@ -0,0 +1,42 @@
+package chain_selectors
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// EIP712Domain is the domain separator of EIP-712 typed data, JSON encoded like the domain of
+// eth_signTypedData_v4 requests.
+type EIP712Domain struct {
+	Name              string `json:"name"`
+	Version           string `json:"version"`
+	ChainID           uint64 `json:"chainId"`
+	VerifyingContract string `json:"verifyingContract"`
+}
+
+// DomainForSelector returns the EIP-712 domain of the contract deployed at verifyingContract on
+// the EVM chain identified by the selector. The chain id is the one of the chain rather than
+// one passed along with the selector, so signatures can't be produced for the wrong chain.
+func DomainForSelector(selector uint64, name, version, verifyingContract string) (EIP712Domain, error) {
+	info, err := getChainInfo(selector)
+	if err != nil {
+		return EIP712Domain{}, err
+	}
+	if info.Family != FamilyEVM {
+		return EIP712Domain{}, fmt.Errorf("chain %d is a %s chain, EIP-712 domains only apply to EVM chains", selector, info.Family)
+	}
+	chainID, err := strconv.ParseUint(info.ChainID, 10, 64)
+	if err != nil {
+		return EIP712Domain{}, fmt.Errorf("invalid chain id %q of selector %d: %w", info.ChainID, selector, err)
+	}
+	if !evmAddressFormat.MatchString(verifyingContract) {
+		return EIP712Domain{}, fmt.Errorf("invalid verifying contract address %q", verifyingContract)
+	}
+
+	return EIP712Domain{
+		Name:              name,
+		Version:           version,
+		ChainID:           chainID,
+		VerifyingContract: verifyingContract,
+	}, nil
+}
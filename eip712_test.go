@@ -0,0 +1,32 @@
+package chain_selectors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDomainForSelector(t *testing.T) {
+	const contract = "0xcA11bde05977b3631167028862bE2a173976CA11"
+
+	domain, err := DomainForSelector(ETHEREUM_MAINNET_BASE_1.Selector, "Permit2", "1", contract)
+	require.NoError(t, err)
+	assert.Equal(t, EIP712Domain{Name: "Permit2", Version: "1", ChainID: 8453, VerifyingContract: contract}, domain)
+
+	tests := []struct {
+		name     string
+		selector uint64
+		contract string
+	}{
+		{name: "unknown chain", selector: 1, contract: contract},
+		{name: "non evm chain", selector: SOLANA_MAINNET.Selector, contract: contract},
+		{name: "invalid contract", selector: ETHEREUM_MAINNET.Selector, contract: "0x1234"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := DomainForSelector(test.selector, "Permit2", "1", test.contract)
+			require.Error(t, err)
+		})
+	}
+}
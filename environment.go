@@ -0,0 +1,52 @@
+package chain_selectors
+
+import (
+	"strings"
+)
+
+const (
+	EnvironmentMainnet = "mainnet"
+	EnvironmentTestnet = "testnet"
+	EnvironmentDevnet  = "devnet"
+	EnvironmentUnknown = "unknown"
+)
+
+// environmentsByNameToken maps the network type component of a chain name to its environment.
+var environmentsByNameToken = map[string]string{
+	"mainnet":  EnvironmentMainnet,
+	"testnet":  EnvironmentTestnet,
+	"devnet":   EnvironmentDevnet,
+	"localnet": EnvironmentDevnet,
+	"dev":      EnvironmentDevnet,
+	"qa":       EnvironmentDevnet,
+	"stage":    EnvironmentDevnet,
+}
+
+// environmentFromName classifies a chain by the network type component of its name,
+// see the naming rules in the README.
+func environmentFromName(name string) string {
+	for _, token := range strings.Split(name, "-") {
+		if environment, exist := environmentsByNameToken[token]; exist {
+			return environment
+		}
+	}
+	return EnvironmentUnknown
+}
+
+// GetSelectorEnvironment returns whether the chain identified by the selector is a mainnet,
+// testnet or devnet. EVM chains from test_selectors.yml are always reported as devnets.
+func GetSelectorEnvironment(selector uint64) (string, error) {
+	info, err := getChainInfo(selector)
+	if err != nil {
+		return "", err
+	}
+
+	if info.Family == FamilyEVM {
+		if ch, exists := evmChainsBySelector()[selector]; exists {
+			if _, test := evmTestSelectorsMap()[ch.EvmChainID]; test {
+				return EnvironmentDevnet, nil
+			}
+		}
+	}
+	return environmentFromName(info.ChainDetails.ChainName), nil
+}
@@ -0,0 +1,174 @@
+package chain_selectors
+
+import (
+	_ "embed"
+	"fmt"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+)
+
+//go:embed overlays.yml
+var overlaysYml []byte
+
+var environmentOverlays = onceValue(func() map[string]map[uint64]ChainMetadata {
+	overlays, err := parseOverlaysYml(overlaysYml)
+	if err != nil {
+		panic(err)
+	}
+	return overlays
+})
+
+// overlayEntry is the metadata an overlay sets for a chain, identified by chain id and name to
+// check the selector it is keyed by.
+type overlayEntry struct {
+	ChainID       string `yaml:"chain_id,omitempty"`
+	Name          string `yaml:"name,omitempty"`
+	ChainMetadata `yaml:",inline"`
+}
+
+// parseOverlaysYml decodes and validates the overlays of every environment. The returned
+// *MultiError reports every invalid entry, indexed in environment then selector order.
+func parseOverlaysYml(ymlFile []byte) (map[string]map[uint64]ChainMetadata, error) {
+	var data struct {
+		Overlays map[string]map[uint64]overlayEntry `yaml:"overlays"`
+	}
+	if err := yaml.Unmarshal(ymlFile, &data); err != nil {
+		return nil, fmt.Errorf("failed to decode overlays: %w", err)
+	}
+
+	environments := make([]string, 0, len(data.Overlays))
+	for environment := range data.Overlays {
+		environments = append(environments, environment)
+	}
+	sort.Strings(environments)
+
+	var errs MultiError
+	overlays := make(map[string]map[uint64]ChainMetadata, len(data.Overlays))
+	index := 0
+	for _, environment := range environments {
+		entries := data.Overlays[environment]
+		selectors := make([]uint64, 0, len(entries))
+		for selector := range entries {
+			selectors = append(selectors, selector)
+		}
+		sort.Slice(selectors, func(i, j int) bool { return selectors[i] < selectors[j] })
+
+		overlays[environment] = make(map[uint64]ChainMetadata, len(entries))
+		for _, selector := range selectors {
+			entry := entries[selector]
+			if err := validateOverlayEntry(selector, entry); err != nil {
+				errs.add(index, fmt.Sprintf("%s overlay of selector %d", environment, selector), err)
+			}
+			overlays[environment][selector] = entry.ChainMetadata
+			index++
+		}
+	}
+	if err := errs.errOrNil(); err != nil {
+		return nil, err
+	}
+	return overlays, nil
+}
+
+// validateOverlayEntry checks that the entry shadows an embedded chain without changing its
+// chain id or name.
+func validateOverlayEntry(selector uint64, entry overlayEntry) error {
+	// generated custom chains are not in the index, overlays only shadow embedded chains
+	info, exists := indexedChainInfo(selector)
+	if !exists {
+		return fmt.Errorf("selector %d is not an embedded chain", selector)
+	}
+	if entry.ChainID != "" && entry.ChainID != info.ChainID {
+		return fmt.Errorf("chain id %s differs from the chain id %s of selector %d", entry.ChainID, info.ChainID, selector)
+	}
+	if entry.Name != "" && entry.Name != info.ChainDetails.ChainName {
+		return fmt.Errorf("name %s differs from the name %s of selector %d", entry.Name, info.ChainDetails.ChainName, selector)
+	}
+	return validateMetadata(entry.ChainMetadata)
+}
+
+// EnvironmentOverlays returns the names of the environment overlays embedded in the package,
+// sorted, see WithEnvironmentOverlay.
+func EnvironmentOverlays() []string {
+	names := make([]string, 0, len(environmentOverlays()))
+	for name := range environmentOverlays() {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// WithEnvironmentOverlay makes the registry return the metadata of the environment overlay of
+// overlays.yml, e.g. "staging", from ChainMetadata: the fields an overlay sets for a chain
+// replace the ones of metadata.yml, for instance to point staging services at other RPCs. An
+// overlay never changes which chain a selector identifies. NewRegistry panics if the package has
+// no overlay of that name, see EnvironmentOverlays.
+func WithEnvironmentOverlay(environment string) RegistryOption {
+	return func(r *Registry) {
+		overlay, exists := environmentOverlays()[environment]
+		if !exists {
+			panic(fmt.Errorf("unknown environment overlay %q, known overlays are %v", environment, EnvironmentOverlays()))
+		}
+		r.environment = environment
+		r.overlay = overlay
+	}
+}
+
+// EnvironmentOverlay returns the name of the overlay of the registry, empty if it has none.
+func (r *Registry) EnvironmentOverlay() string {
+	return r.environment
+}
+
+// ChainMetadata returns the metadata of the chain identified by the selector like
+// GetChainMetadata, shadowed by the environment overlay of the registry. It fails for chains the
+// registry does not resolve.
+func (r *Registry) ChainMetadata(selector uint64) (ChainMetadata, error) {
+	if _, err := r.GetChainDetailsBySelector(selector); err != nil {
+		return ChainMetadata{}, err
+	}
+	metadata, err := GetChainMetadata(selector)
+	overlay, shadowed := r.overlay[selector]
+	if !shadowed {
+		return metadata, err
+	}
+	return overlayMetadata(metadata, overlay), nil
+}
+
+// overlayMetadata returns the metadata with the fields set by the overlay replaced.
+func overlayMetadata(metadata, overlay ChainMetadata) ChainMetadata {
+	overlay = overlay.clone()
+	if overlay.DisplayName != "" {
+		metadata.DisplayName = overlay.DisplayName
+	}
+	if overlay.NativeCurrency != (NativeCurrency{}) {
+		metadata.NativeCurrency = overlay.NativeCurrency
+	}
+	if len(overlay.Explorers) > 0 {
+		metadata.Explorers = overlay.Explorers
+	}
+	if len(overlay.RPCs) > 0 {
+		metadata.RPCs = overlay.RPCs
+	}
+	if overlay.FinalityDepth != 0 {
+		metadata.FinalityDepth = overlay.FinalityDepth
+	}
+	if overlay.CoinType != 0 {
+		metadata.CoinType = overlay.CoinType
+	}
+	if overlay.LogoURI != "" {
+		metadata.LogoURI = overlay.LogoURI
+	}
+	if overlay.BrandColor != "" {
+		metadata.BrandColor = overlay.BrandColor
+	}
+	if overlay.Website != "" {
+		metadata.Website = overlay.Website
+	}
+	if overlay.Docs != "" {
+		metadata.Docs = overlay.Docs
+	}
+	if len(overlay.Faucets) > 0 {
+		metadata.Faucets = overlay.Faucets
+	}
+	return metadata
+}
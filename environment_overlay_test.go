@@ -0,0 +1,81 @@
+package chain_selectors
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_EmbeddedEnvironmentOverlays(t *testing.T) {
+	assert.Equal(t, []string{"dev", "staging"}, EnvironmentOverlays())
+
+	registry := NewRegistry(WithEnvironmentOverlay("staging"))
+	assert.Equal(t, "staging", registry.EnvironmentOverlay())
+	metadata, err := registry.ChainMetadata(ETHEREUM_MAINNET.Selector)
+	require.NoError(t, err)
+	expected, err := GetChainMetadata(ETHEREUM_MAINNET.Selector)
+	require.NoError(t, err)
+	assert.Equal(t, expected, metadata)
+
+	assert.PanicsWithError(t, `unknown environment overlay "qa", known overlays are [dev staging]`, func() {
+		NewRegistry(WithEnvironmentOverlay("qa"))
+	})
+}
+
+func Test_EnvironmentOverlayShadowsMetadata(t *testing.T) {
+	overlays, err := parseOverlaysYml([]byte(`
+overlays:
+  staging:
+    5009297550715157269:
+      chain_id: "1"
+      name: ethereum-mainnet
+      rpcs:
+        - https://ethereum.staging.example.org
+`))
+	require.NoError(t, err)
+	registry := NewRegistry()
+	registry.environment, registry.overlay = "staging", overlays["staging"]
+
+	metadata, err := registry.ChainMetadata(ETHEREUM_MAINNET.Selector)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"https://ethereum.staging.example.org"}, metadata.RPCs)
+	assert.Equal(t, []string{"https://etherscan.io"}, metadata.Explorers)
+	assert.Equal(t, "Ethereum Mainnet", metadata.DisplayName)
+
+	// the overlay does not leak into the package level lookups or other registries
+	metadata, err = NewRegistry().ChainMetadata(ETHEREUM_MAINNET.Selector)
+	require.NoError(t, err)
+	assert.NotEqual(t, []string{"https://ethereum.staging.example.org"}, metadata.RPCs)
+
+	// selectors keep resolving to the same chains
+	ch, exists := registry.ChainBySelector(ETHEREUM_MAINNET.Selector)
+	assert.True(t, exists)
+	assert.Equal(t, ETHEREUM_MAINNET, ch)
+}
+
+func Test_EnvironmentOverlayValidation(t *testing.T) {
+	_, err := parseOverlaysYml([]byte(`
+overlays:
+  dev:
+    5009297550715157269:
+      chain_id: "10"
+  staging:
+    5009297550715157269:
+      name: ethereum-mainnet-optimism-1
+    42:
+      rpcs:
+        - https://unknown.example.org
+    16015286601757825753:
+      website: http://sepolia.staging.example.org
+`))
+	var multi *MultiError
+	require.True(t, errors.As(err, &multi))
+	require.Len(t, multi.Errors, 4)
+	assert.ErrorContains(t, multi.Errors[0], "dev overlay of selector 5009297550715157269")
+	assert.ErrorContains(t, multi.Errors[0], "chain id 10 differs from the chain id 1")
+	assert.ErrorContains(t, multi.Errors[1], "selector 42 is not an embedded chain")
+	assert.ErrorContains(t, multi.Errors[2], "name ethereum-mainnet-optimism-1 differs from the name ethereum-mainnet")
+	assert.ErrorContains(t, multi.Errors[3], "website: url \"http://sepolia.staging.example.org\" must be an absolute https URL")
+}
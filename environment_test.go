@@ -0,0 +1,44 @@
+package chain_selectors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetSelectorEnvironment(t *testing.T) {
+	tests := []struct {
+		name        string
+		selector    uint64
+		environment string
+		expectErr   bool
+	}{
+		{"evm mainnet", ETHEREUM_MAINNET.Selector, EnvironmentMainnet, false},
+		{"legacy evm mainnet name", POLKADOT_MAINNET_MOONBEAM.Selector, EnvironmentMainnet, false},
+		{"evm testnet", ETHEREUM_TESTNET_SEPOLIA.Selector, EnvironmentTestnet, false},
+		{"evm test chain", TEST_90000001.Selector, EnvironmentDevnet, false},
+		{"solana devnet", SOLANA_DEVNET.Selector, EnvironmentDevnet, false},
+		{"aptos localnet", APTOS_LOCALNET.Selector, EnvironmentDevnet, false},
+		{"custom chain", generateCustomChainSelector(9388201), EnvironmentTestnet, false},
+		{"unknown selector", 120398123, "", true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			environment, err := GetSelectorEnvironment(test.selector)
+			if test.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.environment, environment)
+		})
+	}
+}
+
+func Test_EnvironmentFromName(t *testing.T) {
+	assert.Equal(t, EnvironmentDevnet, environmentFromName("nexon-qa"))
+	assert.Equal(t, EnvironmentTestnet, environmentFromName("private-testnet-mica"))
+	assert.Equal(t, EnvironmentUnknown, environmentFromName("90000013"))
+}
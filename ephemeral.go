@@ -0,0 +1,188 @@
+package chain_selectors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// EphemeralChainIDStart is the first chain id of the range reserved for leased ephemeral chains.
+	EphemeralChainIDStart = uint64(7_700_000_000)
+	// EphemeralChainIDCount is the number of chain ids in the ephemeral range.
+	EphemeralChainIDCount = uint64(1 << 16)
+)
+
+// isEphemeralChainID reports whether the chain id lies in the range leased by LeaseEphemeralChain.
+func isEphemeralChainID(chainID uint64) bool {
+	return chainID >= EphemeralChainIDStart && chainID-EphemeralChainIDStart < EphemeralChainIDCount
+}
+
+// ephemeralLockPollInterval is how often a lease waiting for a free chain id retries when the
+// range is exhausted and leases may be released by other processes.
+const ephemeralLockPollInterval = 50 * time.Millisecond
+
+// ChainLease is an ephemeral custom chain held exclusively until Release is called.
+type ChainLease struct {
+	Chain Chain
+
+	once    sync.Once
+	release func() error
+	err     error
+}
+
+// Release returns the chain id to the ephemeral range. It is safe to call more than once.
+func (l *ChainLease) Release() error {
+	l.once.Do(func() {
+		l.err = l.release()
+	})
+	return l.err
+}
+
+// LeaseOption configures LeaseEphemeralChain.
+type LeaseOption func(*leaseConfig)
+
+type leaseConfig struct {
+	lockDir string
+}
+
+// WithLeaseLockDir coordinates leases across processes by creating a lock file per leased chain
+// id in dir, for instance a directory shared by the parallel jobs of a CI runner. Lock files of
+// processes that exit without releasing their lease are not reclaimed, remove them when the
+// directory is no longer used.
+func WithLeaseLockDir(dir string) LeaseOption {
+	return func(c *leaseConfig) {
+		c.lockDir = dir
+	}
+}
+
+// ephemeralLeaser hands out the chain ids of a range, at most once at a time within the process.
+type ephemeralLeaser struct {
+	start uint64
+	count uint64
+
+	mu       sync.Mutex
+	leased   map[uint64]struct{}
+	next     uint64
+	released chan struct{}
+}
+
+var ephemeralChains = newEphemeralLeaser(EphemeralChainIDStart, EphemeralChainIDCount)
+
+func newEphemeralLeaser(start, count uint64) *ephemeralLeaser {
+	return &ephemeralLeaser{
+		start:    start,
+		count:    count,
+		leased:   make(map[uint64]struct{}),
+		released: make(chan struct{}),
+	}
+}
+
+// LeaseEphemeralChain leases a custom chain whose chain id, and therefore selector, is not held
+// by any other lease of this process, or of any process sharing the lock directory when
+// WithLeaseLockDir is given. The chain ids come from a dedicated range starting at
+// EphemeralChainIDStart that holds no official chain and in which custom chains cannot be
+// registered. When every chain id is leased it waits for a release until ctx is done.
+func LeaseEphemeralChain(ctx context.Context, opts ...LeaseOption) (*ChainLease, error) {
+	return ephemeralChains.lease(ctx, opts...)
+}
+
+func (e *ephemeralLeaser) lease(ctx context.Context, opts ...LeaseOption) (*ChainLease, error) {
+	var cfg leaseConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	if cfg.lockDir != "" {
+		if err := os.MkdirAll(cfg.lockDir, 0o755); err != nil {
+			return nil, fmt.Errorf("failed to create lease lock directory: %w", err)
+		}
+	}
+
+	for {
+		lease, released, err := e.tryLease(cfg)
+		if lease != nil || err != nil {
+			return lease, err
+		}
+
+		if err := e.wait(ctx, released, cfg.lockDir != ""); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// wait blocks until a lease is released, or the poll interval elapsed if other processes may
+// release leases too, or ctx is done.
+func (e *ephemeralLeaser) wait(ctx context.Context, released <-chan struct{}, poll bool) error {
+	var timeout <-chan time.Time
+	if poll {
+		timer := time.NewTimer(ephemeralLockPollInterval)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("no ephemeral chain available: %w", ctx.Err())
+	case <-released:
+	case <-timeout:
+	}
+	return nil
+}
+
+// tryLease leases the next free chain id of the range. If there is none it returns a channel
+// closed on the next release.
+func (e *ephemeralLeaser) tryLease(cfg leaseConfig) (*ChainLease, <-chan struct{}, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	for i := uint64(0); i < e.count; i++ {
+		chainID := e.start + (e.next+i)%e.count
+		if _, leased := e.leased[chainID]; leased {
+			continue
+		}
+
+		unlock := func() error { return nil }
+		if cfg.lockDir != "" {
+			path := filepath.Join(cfg.lockDir, "chain-"+strconv.FormatUint(chainID, 10)+".lock")
+			f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o644)
+			if errors.Is(err, os.ErrExist) {
+				continue
+			}
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to lock ephemeral chain %d: %w", chainID, err)
+			}
+			_, _ = f.WriteString(strconv.Itoa(os.Getpid()))
+			_ = f.Close()
+			unlock = func() error { return os.Remove(path) }
+		}
+
+		e.leased[chainID] = struct{}{}
+		e.next = (e.next + i + 1) % e.count
+		return &ChainLease{
+			Chain: Chain{
+				EvmChainID: chainID,
+				Selector:   generateCustomChainSelector(chainID),
+				Name:       generateCustomChainName(chainID),
+				VarName:    generateCustomChainVarName(chainID),
+			},
+			release: func() error {
+				err := unlock()
+				e.releaseID(chainID)
+				return err
+			},
+		}, nil, nil
+	}
+	return nil, e.released, nil
+}
+
+func (e *ephemeralLeaser) releaseID(chainID uint64) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	delete(e.leased, chainID)
+	close(e.released)
+	e.released = make(chan struct{})
+}
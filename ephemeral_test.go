@@ -0,0 +1,115 @@
+package chain_selectors
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEphemeralRangeHoldsNoOfficialChain(t *testing.T) {
+	for chainID := range evmChainIdToChainSelector() {
+		assert.False(t, chainID >= EphemeralChainIDStart && chainID < EphemeralChainIDStart+EphemeralChainIDCount,
+			"official chain %d is in the ephemeral range", chainID)
+	}
+
+	// The whole range fits the direct custom selector encoding.
+	last := EphemeralChainIDStart + EphemeralChainIDCount - 1
+	chainID, err := extractChainIdFromCustomSelector(generateCustomChainSelector(last))
+	require.NoError(t, err)
+	assert.Equal(t, last, chainID)
+}
+
+func TestLeaseEphemeralChainIsUnique(t *testing.T) {
+	const leases = 50
+
+	var mu sync.Mutex
+	seen := make(map[uint64]struct{})
+
+	var wg sync.WaitGroup
+	for i := 0; i < leases; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			lease, err := LeaseEphemeralChain(context.Background())
+			require.NoError(t, err)
+
+			ch, exists := ChainBySelector(lease.Chain.Selector)
+			assert.True(t, exists)
+			assert.Equal(t, lease.Chain, ch)
+
+			mu.Lock()
+			defer mu.Unlock()
+			_, duplicate := seen[lease.Chain.EvmChainID]
+			assert.False(t, duplicate, "chain %d leased twice", lease.Chain.EvmChainID)
+			seen[lease.Chain.EvmChainID] = struct{}{}
+		}()
+	}
+	wg.Wait()
+	assert.Len(t, seen, leases)
+}
+
+func TestLeaseEphemeralChainWaitsForRelease(t *testing.T) {
+	leaser := newEphemeralLeaser(EphemeralChainIDStart, 1)
+
+	first, err := leaser.lease(context.Background())
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err = leaser.lease(ctx)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		assert.NoError(t, first.Release())
+		assert.NoError(t, first.Release())
+	}()
+	second, err := leaser.lease(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, first.Chain, second.Chain)
+	require.NoError(t, second.Release())
+}
+
+func TestLeaseEphemeralChainLockDir(t *testing.T) {
+	dir := t.TempDir()
+
+	// Two leasers stand in for two processes sharing the lock directory.
+	a := newEphemeralLeaser(EphemeralChainIDStart, 2)
+	b := newEphemeralLeaser(EphemeralChainIDStart, 2)
+
+	leaseA, err := a.lease(context.Background(), WithLeaseLockDir(dir))
+	require.NoError(t, err)
+	leaseB, err := b.lease(context.Background(), WithLeaseLockDir(dir))
+	require.NoError(t, err)
+	assert.NotEqual(t, leaseA.Chain.EvmChainID, leaseB.Chain.EvmChainID)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	_, err = b.lease(ctx, WithLeaseLockDir(dir))
+	require.Error(t, err)
+
+	require.NoError(t, leaseA.Release())
+	leaseC, err := b.lease(context.Background(), WithLeaseLockDir(dir))
+	require.NoError(t, err)
+	assert.Equal(t, leaseA.Chain.EvmChainID, leaseC.Chain.EvmChainID)
+
+	require.NoError(t, leaseB.Release())
+	require.NoError(t, leaseC.Release())
+}
+
+func TestCustomChainsCannotBeRegisteredInTheEphemeralRange(t *testing.T) {
+	restoreCustomChains(t)
+	chainID := EphemeralChainIDStart + 7
+
+	assert.Zero(t, RegisterCustomChain(chainID, "explicit-ephemeral"))
+	_, err := RegisterCustomChains([]CustomChainSpec{{ChainID: chainID, Name: "explicit-ephemeral"}})
+	assert.ErrorContains(t, err, "ephemeral range")
+	_, registered := customChains.name(chainID)
+	assert.False(t, registered)
+
+	assert.NotZero(t, RegisterCustomChain(EphemeralChainIDStart+EphemeralChainIDCount, "next-to-ephemeral"))
+}
@@ -1,94 +1,193 @@
 package chain_selectors
 
 import (
-	_ "embed"
 	"fmt"
+	"sort"
 	"strconv"
+	"strings"
 
 	"gopkg.in/yaml.v3"
+
+	"github.com/fravlaca/chain-selectors/evm"
 )
 
 //go:generate go run genchains_evm.go
+//go:generate go run genanalysis.go
+//go:generate go run genbinary.go
+//go:generate go run genbuildinfo.go
 
-//go:embed selectors.yml
-var selectorsYml []byte
-
-//go:embed test_selectors.yml
-var testSelectorsYml []byte
+// The EVM datasets are embedded by the evm subpackage, see its SelectorsYAML.
+var (
+	selectorsYml     = evm.SelectorsYAML()
+	testSelectorsYml = evm.TestSelectorsYAML()
+)
 
 type ChainDetails struct {
 	ChainSelector uint64 `yaml:"selector"`
 	ChainName     string `yaml:"name"`
+	// NetworkID is only set for legacy EVM chains whose network id differs from their EIP-155 chain id.
+	NetworkID uint64 `yaml:"network_id,omitempty"`
+	// GenesisHash is only set for EVM chains whose chain id is reused by another chain.
+	GenesisHash string `yaml:"genesis_hash,omitempty"`
+	// Owner and ApprovedBy record who requested the entry and who approved it, see AuditInfo.
+	Owner      string `yaml:"owner,omitempty"`
+	ApprovedBy string `yaml:"approved_by,omitempty"`
 }
 
 var (
-	evmSelectorsMap           = parseYml(selectorsYml)
-	evmTestSelectorsMap       = parseYml(testSelectorsYml)
-	evmChainIdToChainSelector = loadAllEVMSelectors()
-	evmChainsBySelector       = make(map[uint64]Chain)
-	evmChainsByEvmChainID     = make(map[uint64]Chain)
+	evmSelectorsMap           = onceValue(func() map[uint64]ChainDetails { return parseYml(selectorsYml) })
+	evmTestSelectorsMap       = onceValue(func() map[uint64]ChainDetails { return parseYml(testSelectorsYml) })
+	evmChainIdToChainSelector = onceValue(loadAllEVMSelectors)
+	evmChains                 = onceValue(loadEVMChains)
+	evmChainIdsByNetworkID    = onceValue(func() map[uint64][]uint64 { return loadEVMNetworkIDs(evmChainIdToChainSelector()) })
 )
 
-func init() {
-	for _, ch := range ALL {
-		evmChainsBySelector[ch.Selector] = ch
-		evmChainsByEvmChainID[ch.EvmChainID] = ch
+// evmChainIndexes indexes the generated EVM chains and the forks reusing their chain ids. Forks
+// are resolved by selector and name only, their chain id identifies the chain they fork.
+type evmChainIndexes struct {
+	bySelector   map[uint64]Chain
+	byEvmChainID map[uint64]Chain
+	byName       map[string]Chain
+	byVarName    map[string]Chain
+	forkDetails  map[uint64]ChainDetails
+}
+
+func loadEVMChains() evmChainIndexes {
+	return indexEVMChains(ALL, evmForksByChainId())
+}
+
+func indexEVMChains(chains []Chain, forks map[uint64][]evmFork) evmChainIndexes {
+	idx := evmChainIndexes{
+		bySelector:   make(map[uint64]Chain, len(chains)),
+		byEvmChainID: make(map[uint64]Chain, len(chains)),
+		byName:       make(map[string]Chain, len(chains)),
+		byVarName:    make(map[string]Chain, len(chains)),
+		forkDetails:  make(map[uint64]ChainDetails),
+	}
+	for _, ch := range chains {
+		idx.bySelector[ch.Selector] = ch
+		idx.byEvmChainID[ch.EvmChainID] = ch
+		idx.byName[ch.Name] = ch
+		idx.byVarName[ch.VarName] = ch
 	}
+	for chainID, chainForks := range forks {
+		for _, fork := range chainForks {
+			ch := Chain{
+				EvmChainID: chainID,
+				Selector:   fork.Selector,
+				Name:       fork.Name,
+				VarName:    strings.ToUpper(strings.ReplaceAll(fork.Name, "-", "_")),
+			}
+			idx.bySelector[ch.Selector] = ch
+			idx.byName[ch.Name] = ch
+			idx.byVarName[ch.VarName] = ch
+			idx.forkDetails[ch.Selector] = ChainDetails{ChainSelector: fork.Selector, ChainName: fork.Name, GenesisHash: fork.GenesisHash}
+		}
+	}
+	return idx
 }
 
+func evmChainsBySelector() map[uint64]Chain   { return evmChains().bySelector }
+func evmChainsByEvmChainID() map[uint64]Chain { return evmChains().byEvmChainID }
+func evmChainsByName() map[string]Chain       { return evmChains().byName }
+func evmChainsByVarName() map[string]Chain    { return evmChains().byVarName }
+
 func loadAllEVMSelectors() map[uint64]ChainDetails {
-	output := make(map[uint64]ChainDetails, len(evmSelectorsMap)+len(evmTestSelectorsMap))
-	for k, v := range evmSelectorsMap {
+	output := make(map[uint64]ChainDetails, len(evmSelectorsMap())+len(evmTestSelectorsMap()))
+	for k, v := range evmSelectorsMap() {
 		output[k] = v
 	}
-	for k, v := range evmTestSelectorsMap {
+	for k, v := range evmTestSelectorsMap() {
 		output[k] = v
 	}
 	return output
 }
 
+func loadEVMNetworkIDs(chains map[uint64]ChainDetails) map[uint64][]uint64 {
+	output := make(map[uint64][]uint64, len(chains))
+	for chainID, details := range chains {
+		networkID := chainID
+		if details.NetworkID != 0 {
+			networkID = details.NetworkID
+		}
+		output[networkID] = append(output[networkID], chainID)
+	}
+	for _, chainIDs := range output {
+		sort.Slice(chainIDs, func(i, j int) bool { return chainIDs[i] < chainIDs[j] })
+	}
+	return output
+}
+
 func parseYml(ymlFile []byte) map[uint64]ChainDetails {
+	chains, err := decodeSelectorsYml(ymlFile)
+	if err != nil {
+		panic(err)
+	}
+	return chains
+}
+
+// decodeSelectorsYml decodes EVM chains in the selectors.yml format, keyed by chain id.
+func decodeSelectorsYml(ymlFile []byte) (map[uint64]ChainDetails, error) {
 	type ymlData struct {
 		SelectorsByEvmChainId map[uint64]ChainDetails `yaml:"selectors"`
 	}
 
 	var data ymlData
-	err := yaml.Unmarshal(ymlFile, &data)
-	if err != nil {
-		panic(err)
+	if err := yaml.Unmarshal(ymlFile, &data); err != nil {
+		return nil, err
 	}
-
-	return data.SelectorsByEvmChainId
+	return data.SelectorsByEvmChainId, nil
 }
 
 func EvmChainIdToChainSelector() map[uint64]uint64 {
-	copyMap := make(map[uint64]uint64, len(evmChainIdToChainSelector))
-	for k, v := range evmChainIdToChainSelector {
+	copyMap := make(map[uint64]uint64, len(evmChainIdToChainSelector()))
+	for k, v := range evmChainIdToChainSelector() {
 		copyMap[k] = v.ChainSelector
 	}
 	return copyMap
 }
 
-// Deprecated, this only supports EVM chains, use the chain agnostic `GetChainIDFromSelector` instead
-func ChainIdFromSelector(chainSelectorId uint64) (uint64, error) {
-	for k, v := range evmChainIdToChainSelector {
-		if v.ChainSelector == chainSelectorId {
-			return k, nil
-		}
+// EvmMainChainIdToChainSelector returns the selectors of the chains defined in selectors.yml, excluding test chains.
+func EvmMainChainIdToChainSelector() map[uint64]uint64 {
+	copyMap := make(map[uint64]uint64, len(evmSelectorsMap()))
+	for k, v := range evmSelectorsMap() {
+		copyMap[k] = v.ChainSelector
+	}
+	return copyMap
+}
+
+// EvmTestChainIdToChainSelector returns the selectors of the chains defined in test_selectors.yml.
+func EvmTestChainIdToChainSelector() map[uint64]uint64 {
+	copyMap := make(map[uint64]uint64, len(evmTestSelectorsMap()))
+	for k, v := range evmTestSelectorsMap() {
+		copyMap[k] = v.ChainSelector
+	}
+	return copyMap
+}
+
+// chainIdFromSelector returns the chain id of the EVM chain, official or custom, identified by
+// the selector, see ChainIdFromSelector.
+func chainIdFromSelector(chainSelectorId uint64) (uint64, error) {
+	if ch, exist := evmChainsBySelector()[chainSelectorId]; exist {
+		return ch.EvmChainID, nil
 	}
 
 	// Try custom selector lookup
 	if isCustomSelector(chainSelectorId) {
-		return extractChainIdFromCustomSelector(chainSelectorId)
+		chainID, err := extractChainIdFromCustomSelector(chainSelectorId)
+		if err == nil {
+			emitCustomSelector(CustomSelectorResolved, chainID, chainSelectorId)
+		}
+		return chainID, err
 	}
 
-	return 0, fmt.Errorf("chain not found for chain selector %d", chainSelectorId)
+	return 0, selectorNotFoundError(FamilyEVM, chainSelectorId)
 }
 
-// Deprecated, this only supports EVM chains, use the chain agnostic `GetChainDetailsByChainIDAndFamily` instead
-// ENHANCED: Now supports custom chains with deterministic generation
-func SelectorFromChainId(chainId uint64) (uint64, error) {
-	if chainSelectorId, exist := evmChainIdToChainSelector[chainId]; exist {
+// selectorFromChainId returns the selector of the EVM chain, generating one for custom chains,
+// see SelectorFromChainId.
+func selectorFromChainId(chainId uint64) (uint64, error) {
+	if chainSelectorId, exist := evmChainIdToChainSelector()[chainId]; exist {
 		return chainSelectorId.ChainSelector, nil
 	}
 
@@ -96,15 +195,19 @@ func SelectorFromChainId(chainId uint64) (uint64, error) {
 	return GetCustomChainSelector(chainId)
 }
 
-// Deprecated, this only supports EVM chains, use the chain agnostic `NameFromChainId` instead
+// NameFromChainId returns the name of the EVM chain, generating one for custom chains. It only
+// supports EVM chains, GetChainDetailsByChainIDAndFamily resolves the chains of every family.
 func NameFromChainId(chainId uint64) (string, error) {
-	details, exist := evmChainIdToChainSelector[chainId]
+	details, exist := evmChainIdToChainSelector()[chainId]
 	if !exist {
 		// Try custom chain name generation
 		if isCustomChain(chainId) {
-			return generateCustomChainName(chainId), nil
+			if _, err := customChainSelector(chainId); err != nil {
+				return "", err
+			}
+			return customChainName(chainId), nil
 		}
-		return "", fmt.Errorf("chain name not found for chain %d", chainId)
+		return "", chainIDNotFoundError(FamilyEVM, chainId)
 	}
 	if details.ChainName == "" {
 		return strconv.FormatUint(chainId, 10), nil
@@ -113,14 +216,14 @@ func NameFromChainId(chainId uint64) (string, error) {
 }
 
 func ChainIdFromName(name string) (uint64, error) {
-	for k, v := range evmChainIdToChainSelector {
+	for k, v := range evmChainIdToChainSelector() {
 		if v.ChainName == name {
 			return k, nil
 		}
 	}
 	chainId, err := strconv.ParseUint(name, 10, 64)
 	if err == nil {
-		if details, exist := evmChainIdToChainSelector[chainId]; exist && details.ChainName == "" {
+		if details, exist := evmChainIdToChainSelector()[chainId]; exist && details.ChainName == "" {
 			return chainId, nil
 		}
 		// ENHANCED: Check if it's a custom chain
@@ -128,12 +231,40 @@ func ChainIdFromName(name string) (uint64, error) {
 			return chainId, nil
 		}
 	}
-	return 0, fmt.Errorf("chain not found for name %s", name)
+	return 0, notFoundError(InputName, FamilyEVM, name)
+}
+
+// SelectorFromNetworkID resolves the selector of the EVM chain using the given devp2p network id.
+// For most chains the network id equals the chain id, legacy chains declare it explicitly.
+// Network ids are not guaranteed to be unique, an error is returned if several chains share it.
+func SelectorFromNetworkID(networkID uint64) (uint64, error) {
+	chainIDs := evmChainIdsByNetworkID()[networkID]
+	switch len(chainIDs) {
+	case 0:
+		return 0, notFoundError(InputNetworkID, FamilyEVM, strconv.FormatUint(networkID, 10))
+	case 1:
+		return evmChainIdToChainSelector()[chainIDs[0]].ChainSelector, nil
+	default:
+		return 0, lookupError(InputNetworkID, FamilyEVM, strconv.FormatUint(networkID, 10),
+			fmt.Sprintf("ambiguous, it is used by chains %v", chainIDs))
+	}
+}
+
+// NetworkID returns the devp2p network id of the chain.
+//
+// Compatibility note: EvmChainID is the EIP-155 chain id used for transaction signing and is
+// what selectors are keyed by. A few legacy chains advertise a different network id, tooling
+// that only knows the network id should resolve it with SelectorFromNetworkID.
+func (c Chain) NetworkID() uint64 {
+	if details, exist := evmChainIdToChainSelector()[c.EvmChainID]; exist && details.NetworkID != 0 {
+		return details.NetworkID
+	}
+	return c.EvmChainID
 }
 
 func TestChainIds() []uint64 {
-	chainIds := make([]uint64, 0, len(evmTestSelectorsMap))
-	for k := range evmTestSelectorsMap {
+	chainIds := make([]uint64, 0, len(evmTestSelectorsMap()))
+	for k := range evmTestSelectorsMap() {
 		chainIds = append(chainIds, k)
 	}
 	return chainIds
@@ -141,7 +272,7 @@ func TestChainIds() []uint64 {
 
 // ENHANCED: Now supports custom chains
 func ChainBySelector(sel uint64) (Chain, bool) {
-	ch, exists := evmChainsBySelector[sel]
+	ch, exists := evmChainsBySelector()[sel]
 	if exists {
 		return ch, true
 	}
@@ -150,12 +281,13 @@ func ChainBySelector(sel uint64) (Chain, bool) {
 	if isCustomSelector(sel) {
 		chainID, err := extractChainIdFromCustomSelector(sel)
 		if err == nil {
+			emitCustomSelector(CustomSelectorResolved, chainID, sel)
 			// Create a synthetic Chain for custom chains
 			return Chain{
 				EvmChainID: chainID,
 				Selector:   sel,
-				Name:       generateCustomChainName(chainID),
-				VarName:    fmt.Sprintf("CUSTOM_TESTNET_%d", chainID),
+				Name:       customChainName(chainID),
+				VarName:    customChainVarName(chainID),
 			}, true
 		}
 	}
@@ -165,27 +297,93 @@ func ChainBySelector(sel uint64) (Chain, bool) {
 
 // ENHANCED: Now supports custom chains
 func ChainByEvmChainID(evmChainID uint64) (Chain, bool) {
-	ch, exists := evmChainsByEvmChainID[evmChainID]
+	ch, exists := evmChainsByEvmChainID()[evmChainID]
 	if exists {
 		return ch, true
 	}
 
 	// Try custom chain lookup
 	if isCustomChain(evmChainID) {
-		selector := generateCustomChainSelector(evmChainID)
-		name := generateCustomChainName(evmChainID)
+		selector, err := customChainSelector(evmChainID)
+		if err != nil || checkCustomGeneration(evmChainID) != nil {
+			return Chain{}, false
+		}
+		name := customChainName(evmChainID)
+		emitCustomSelector(CustomSelectorGenerated, evmChainID, selector)
 
 		return Chain{
 			EvmChainID: evmChainID,
 			Selector:   selector,
 			Name:       name,
-			VarName:    fmt.Sprintf("CUSTOM_TESTNET_%d", evmChainID),
+			VarName:    customChainVarName(evmChainID),
 		}, true
 	}
 
 	return Chain{}, false
 }
 
+// SortKey selects the field chains are ordered by.
+type SortKey int
+
+const (
+	SortByName SortKey = iota
+	SortByChainID
+	SortBySelector
+)
+
+// AllChainsSorted returns a copy of ALL ordered by the given key.
+func AllChainsSorted(by SortKey) []Chain {
+	chains := make([]Chain, len(ALL))
+	copy(chains, ALL)
+
+	var less func(a, b Chain) bool
+	switch by {
+	case SortByChainID:
+		less = func(a, b Chain) bool { return a.EvmChainID < b.EvmChainID }
+	case SortBySelector:
+		less = func(a, b Chain) bool { return a.Selector < b.Selector }
+	default:
+		less = func(a, b Chain) bool { return a.Name < b.Name }
+	}
+	sort.SliceStable(chains, func(i, j int) bool {
+		if less(chains[i], chains[j]) {
+			return true
+		}
+		if less(chains[j], chains[i]) {
+			return false
+		}
+		return chains[i].Selector < chains[j].Selector
+	})
+	return chains
+}
+
+// ChainByName resolves a canonical name, an alias, the name a custom chain was registered under
+// or a generated custom chain name to the full Chain.
+func ChainByName(name string) (Chain, bool) {
+	if ch, exists := evmChainsByName()[ResolveAlias(name)]; exists {
+		return ch, true
+	}
+
+	if chainID, registered := customChains.chainID(name); registered {
+		return ChainByEvmChainID(chainID)
+	}
+
+	if suffix, found := strings.CutPrefix(name, customChainNamePrefix); found {
+		chainID, err := strconv.ParseUint(suffix, 10, 64)
+		if err == nil && generateCustomChainName(chainID) == name && isCustomChain(chainID) {
+			return ChainByEvmChainID(chainID)
+		}
+	}
+
+	return Chain{}, false
+}
+
+// ChainByVarName resolves the name of a generated variable, e.g. "ETHEREUM_MAINNET", to its Chain.
+func ChainByVarName(varName string) (Chain, bool) {
+	ch, exists := evmChainsByVarName()[varName]
+	return ch, exists
+}
+
 // ENHANCED: Now supports custom chains
 func IsEvm(chainSel uint64) (bool, error) {
 	_, exists := ChainBySelector(chainSel)
@@ -194,7 +392,7 @@ func IsEvm(chainSel uint64) (bool, error) {
 		if isCustomSelector(chainSel) {
 			return true, nil
 		}
-		return false, fmt.Errorf("chain %d not found", chainSel)
+		return false, selectorNotFoundError("", chainSel)
 	}
 	// We always return true since only evm chains are supported atm.
 	return true, nil
@@ -0,0 +1,73 @@
+// Package evm holds the dataset of the EVM chains, selectors.yml and test_selectors.yml, for programs
+// resolving EVM chains only: importing it does not embed the datasets of the other families,
+// which the root chain_selectors package aggregates.
+package evm
+
+import (
+	_ "embed"
+
+	"github.com/fravlaca/chain-selectors/internal/familydata"
+)
+
+//go:embed selectors.yml
+var selectorsYml []byte
+
+//go:embed test_selectors.yml
+var testSelectorsYml []byte
+
+//go:embed selectors_forks.yml
+var forksYml []byte
+
+var index = familydata.Lazy[uint64](selectorsYml, testSelectorsYml)
+
+// Chain is a EVM chain of the dataset.
+type Chain struct {
+	ChainID  uint64
+	Selector uint64
+	Name     string
+	// Test marks the chains of test_selectors.yml.
+	Test bool
+}
+
+// Chains returns the chains of the dataset sorted by chain id.
+func Chains() []Chain {
+	chains := index().Chains
+	output := make([]Chain, len(chains))
+	for i, ch := range chains {
+		output[i] = Chain(ch)
+	}
+	return output
+}
+
+// BySelector returns the chain identified by the selector.
+func BySelector(selector uint64) (Chain, bool) {
+	ch, exists := index().BySelector[selector]
+	return Chain(ch), exists
+}
+
+// ByChainID returns the chain with the chain id.
+func ByChainID(chainID uint64) (Chain, bool) {
+	ch, exists := index().ByChainID[chainID]
+	return Chain(ch), exists
+}
+
+// ByName returns the chain with the name.
+func ByName(name string) (Chain, bool) {
+	ch, exists := index().ByName[name]
+	return Chain(ch), exists
+}
+
+// SelectorsYAML returns the embedded selectors.yml. The returned slice must not be modified.
+func SelectorsYAML() []byte {
+	return selectorsYml
+}
+
+// TestSelectorsYAML returns the embedded test_selectors.yml. The returned slice must not be modified.
+func TestSelectorsYAML() []byte {
+	return testSelectorsYml
+}
+
+// ForksYAML returns the embedded selectors_forks.yml, the forks of EVM chains reusing the chain id of another chain. The returned slice must not be modified.
+func ForksYAML() []byte {
+	return forksYml
+}
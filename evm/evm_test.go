@@ -0,0 +1,48 @@
+package evm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLookups(t *testing.T) {
+	chains := Chains()
+	require.NotEmpty(t, chains)
+	for i, ch := range chains {
+		if i > 0 {
+			assert.Less(t, chains[i-1].ChainID, ch.ChainID)
+		}
+		bySelector, exists := BySelector(ch.Selector)
+		require.True(t, exists, "selector %d", ch.Selector)
+		assert.Equal(t, ch, bySelector)
+
+		byChainID, exists := ByChainID(ch.ChainID)
+		require.True(t, exists)
+		assert.Equal(t, ch, byChainID)
+
+		if ch.Name != "" {
+			byName, exists := ByName(ch.Name)
+			require.True(t, exists)
+			assert.Equal(t, ch, byName)
+		}
+	}
+
+	_, exists := BySelector(0)
+	assert.False(t, exists)
+}
+
+func TestEthereumMainnet(t *testing.T) {
+	ch, exists := ByChainID(1)
+	require.True(t, exists)
+	assert.Equal(t, Chain{ChainID: 1, Selector: 5009297550715157269, Name: "ethereum-mainnet"}, ch)
+
+	testChains := 0
+	for _, ch := range Chains() {
+		if ch.Test {
+			testChains++
+		}
+	}
+	assert.Positive(t, testChains)
+}
@@ -0,0 +1,90 @@
+package chain_selectors
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/fravlaca/chain-selectors/evm"
+)
+
+var forksSelectorsYml = evm.ForksYAML()
+
+// evmFork is an EVM chain sharing its chain id with another chain.
+type evmFork struct {
+	ChainID     uint64 `yaml:"chain_id"`
+	GenesisHash string `yaml:"genesis_hash"`
+	Selector    uint64 `yaml:"selector"`
+	Name        string `yaml:"name"`
+}
+
+var genesisHashFormat = regexp.MustCompile(`^0x[0-9a-f]{64}$`)
+
+var evmForksByChainId = onceValue(func() map[uint64][]evmFork {
+	return parseForksYml(forksSelectorsYml, evmChainIdToChainSelector())
+})
+
+func parseForksYml(ymlFile []byte, chains map[uint64]ChainDetails) map[uint64][]evmFork {
+	type ymlData struct {
+		Forks []evmFork `yaml:"forks"`
+	}
+
+	var data ymlData
+	err := yaml.Unmarshal(ymlFile, &data)
+	if err != nil {
+		panic(err)
+	}
+
+	output := make(map[uint64][]evmFork)
+	for _, fork := range data.Forks {
+		fork.GenesisHash = normalizeGenesisHash(fork.GenesisHash)
+		if !genesisHashFormat.MatchString(fork.GenesisHash) {
+			panic(fmt.Errorf("invalid genesis hash %q for fork %s", fork.GenesisHash, fork.Name))
+		}
+		if details, exist := chains[fork.ChainID]; exist && details.GenesisHash == "" {
+			panic(fmt.Errorf("chain %d is reused by fork %s but does not declare its genesis hash", fork.ChainID, fork.Name))
+		}
+		output[fork.ChainID] = append(output[fork.ChainID], fork)
+	}
+	return output
+}
+
+func normalizeGenesisHash(hash string) string {
+	hash = strings.ToLower(hash)
+	if !strings.HasPrefix(hash, "0x") {
+		hash = "0x" + hash
+	}
+	return hash
+}
+
+// SelectorFromChainIDAndGenesis resolves the selector of an EVM chain, using the genesis hash to
+// tell apart chains reusing the same chain id. Chain ids used by a single chain resolve as in
+// SelectorFromChainId as long as the genesis hash doesn't contradict the known one. The
+// selectors of forks are then resolved by the selector lookups, e.g. ChainBySelector, like the
+// selectors of any other chain.
+func SelectorFromChainIDAndGenesis(chainID uint64, genesisHash string) (uint64, error) {
+	return selectorFromChainIDAndGenesis(evmChainIdToChainSelector(), evmForksByChainId(), chainID, genesisHash)
+}
+
+func selectorFromChainIDAndGenesis(chains map[uint64]ChainDetails, forks map[uint64][]evmFork, chainID uint64, genesisHash string) (uint64, error) {
+	genesisHash = normalizeGenesisHash(genesisHash)
+
+	// chains reused by a fork always declare their genesis hash, see parseForksYml
+	details, exist := chains[chainID]
+	if exist && (details.GenesisHash == "" || normalizeGenesisHash(details.GenesisHash) == genesisHash) {
+		return details.ChainSelector, nil
+	}
+	for _, fork := range forks[chainID] {
+		if fork.GenesisHash == genesisHash {
+			return fork.Selector, nil
+		}
+	}
+
+	if !exist && len(forks[chainID]) == 0 {
+		return 0, chainIDNotFoundError(FamilyEVM, chainID)
+	}
+	return 0, lookupError(InputChainID, FamilyEVM, strconv.FormatUint(chainID, 10), "not found with genesis hash "+genesisHash)
+}
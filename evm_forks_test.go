@@ -0,0 +1,100 @@
+package chain_selectors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	mainnetGenesis = "0xd4e56740f876aef8c010b86a40d5f56745a118d0906a34e69aec8c0db1cb8fa3"
+	forkGenesis    = "0x1111111111111111111111111111111111111111111111111111111111111111"
+)
+
+func TestForkSelectorsAreUnique(t *testing.T) {
+	selectors := make(map[uint64]bool, len(ALL))
+	for _, ch := range ALL {
+		selectors[ch.Selector] = true
+	}
+	for chainID, forks := range evmForksByChainId() {
+		for _, fork := range forks {
+			assert.False(t, selectors[fork.Selector], "fork %s of chain %d reuses an existing selector %d", fork.Name, chainID, fork.Selector)
+			selectors[fork.Selector] = true
+		}
+	}
+}
+
+func Test_IndexEVMChainsWithForks(t *testing.T) {
+	mainnet := Chain{EvmChainID: 1, Selector: 100, Name: "ethereum", VarName: "ETHEREUM"}
+	forks := parseForksYml([]byte(`
+forks:
+  - chain_id: 1
+    genesis_hash: "1111111111111111111111111111111111111111111111111111111111111111"
+    selector: 200
+    name: ethereum-fork
+`), map[uint64]ChainDetails{1: {ChainSelector: 100, GenesisHash: mainnetGenesis}})
+
+	idx := indexEVMChains([]Chain{mainnet}, forks)
+	fork := Chain{EvmChainID: 1, Selector: 200, Name: "ethereum-fork", VarName: "ETHEREUM_FORK"}
+	assert.Equal(t, fork, idx.bySelector[200])
+	assert.Equal(t, fork, idx.byName["ethereum-fork"])
+	assert.Equal(t, fork, idx.byVarName["ETHEREUM_FORK"])
+	assert.Equal(t, mainnet, idx.byEvmChainID[1], "the chain id keeps resolving to the forked chain")
+	assert.Equal(t, ChainDetails{ChainSelector: 200, ChainName: "ethereum-fork", GenesisHash: forkGenesis}, idx.forkDetails[200])
+}
+
+func Test_SelectorFromChainIDAndGenesis(t *testing.T) {
+	selector, err := SelectorFromChainIDAndGenesis(1, mainnetGenesis)
+	require.NoError(t, err)
+	assert.Equal(t, ETHEREUM_MAINNET.Selector, selector)
+
+	_, err = SelectorFromChainIDAndGenesis(1, forkGenesis)
+	require.Error(t, err, "genesis hash contradicting the known one should not resolve")
+
+	selector, err = SelectorFromChainIDAndGenesis(ETHEREUM_MAINNET_ARBITRUM_1.EvmChainID, forkGenesis)
+	require.NoError(t, err, "chains without a declared genesis hash resolve by chain id only")
+	assert.Equal(t, ETHEREUM_MAINNET_ARBITRUM_1.Selector, selector)
+
+	_, err = SelectorFromChainIDAndGenesis(120398123, mainnetGenesis)
+	require.Error(t, err)
+}
+
+func Test_SelectorFromChainIDAndGenesisWithForks(t *testing.T) {
+	chains := map[uint64]ChainDetails{
+		1: {ChainSelector: 100, GenesisHash: mainnetGenesis},
+	}
+	forks := parseForksYml([]byte(`
+forks:
+  - chain_id: 1
+    genesis_hash: "1111111111111111111111111111111111111111111111111111111111111111"
+    selector: 200
+    name: ethereum-fork
+`), chains)
+
+	selector, err := selectorFromChainIDAndGenesis(chains, forks, 1, mainnetGenesis)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(100), selector)
+
+	selector, err = selectorFromChainIDAndGenesis(chains, forks, 1, forkGenesis)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(200), selector)
+
+	_, err = selectorFromChainIDAndGenesis(chains, forks, 1, "0x2222222222222222222222222222222222222222222222222222222222222222")
+	require.Error(t, err)
+}
+
+func Test_ParseForksYmlRequiresGenesisOnReusedChain(t *testing.T) {
+	chains := map[uint64]ChainDetails{
+		1: {ChainSelector: 100},
+	}
+	require.Panics(t, func() {
+		parseForksYml([]byte(`
+forks:
+  - chain_id: 1
+    genesis_hash: "0x1111111111111111111111111111111111111111111111111111111111111111"
+    selector: 200
+    name: ethereum-fork
+`), chains)
+	})
+}
@@ -13,7 +13,7 @@ import (
 func TestNoSameChainSelectorsAreGenerated(t *testing.T) {
 	chainSelectors := map[uint64]struct{}{}
 
-	for k, v := range evmChainIdToChainSelector {
+	for k, v := range evmChainIdToChainSelector() {
 		selector := v.ChainSelector
 		_, exist := chainSelectors[selector]
 		assert.False(t, exist, "Chain Selectors should be unique. Selector %d is duplicated for chain %d", selector, k)
@@ -22,18 +22,18 @@ func TestNoSameChainSelectorsAreGenerated(t *testing.T) {
 }
 
 func TestNoOverlapBetweenRealAndTestChains(t *testing.T) {
-	for k, _ := range evmSelectorsMap {
-		_, exist := evmTestSelectorsMap[k]
+	for k, _ := range evmSelectorsMap() {
+		_, exist := evmTestSelectorsMap()[k]
 		assert.False(t, exist, "Chain %d is duplicated between real and test chains", k)
 	}
 }
 
 func TestBothSelectorsYmlAndTestSelectorsYmlAreValid(t *testing.T) {
-	optimismGoerliSelector, err := SelectorFromChainId(420)
+	optimismGoerliSelector, err := selectorFromChainId(420)
 	require.NoError(t, err)
 	assert.Equal(t, uint64(2664363617261496610), optimismGoerliSelector)
 
-	testChainSelector, err := SelectorFromChainId(90000020)
+	testChainSelector, err := selectorFromChainId(90000020)
 	require.NoError(t, err)
 	assert.Equal(t, uint64(17810359353458878177), testChainSelector)
 }
@@ -42,10 +42,10 @@ func TestEvmChainIdToChainSelectorReturningCopiedMap(t *testing.T) {
 	selectors := EvmChainIdToChainSelector()
 	selectors[1] = 2
 
-	_, err := ChainIdFromSelector(2)
+	_, err := chainIdFromSelector(2)
 	assert.Error(t, err)
 
-	_, err = ChainIdFromSelector(1)
+	_, err = chainIdFromSelector(1)
 	assert.Error(t, err)
 }
 
@@ -98,8 +98,8 @@ func Test_ChainSelectors(t *testing.T) {
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			chainId, err1 := ChainIdFromSelector(test.chainSelector)
-			chainSelector, err2 := SelectorFromChainId(test.chainId)
+			chainId, err1 := chainIdFromSelector(test.chainSelector)
+			chainSelector, err2 := selectorFromChainId(test.chainId)
 			if test.expectErr {
 				require.Error(t, err1)
 				require.Error(t, err2)
@@ -116,10 +116,10 @@ func Test_ChainSelectors(t *testing.T) {
 
 func Test_TestChainIds(t *testing.T) {
 	chainIds := TestChainIds()
-	assert.Equal(t, len(chainIds), len(evmTestSelectorsMap), "Should return correct number of test chain ids")
+	assert.Equal(t, len(chainIds), len(evmTestSelectorsMap()), "Should return correct number of test chain ids")
 
 	for _, chainId := range chainIds {
-		_, exist := evmTestSelectorsMap[chainId]
+		_, exist := evmTestSelectorsMap()[chainId]
 		assert.True(t, exist)
 	}
 }
@@ -229,7 +229,7 @@ func Test_IsEvm(t *testing.T) {
 }
 
 func Test_EVMGetChainDetailsByChainIDAndFamily(t *testing.T) {
-	for k, v := range evmChainIdToChainSelector {
+	for k, v := range evmChainIdToChainSelector() {
 		strChainID := strconv.FormatUint(k, 10)
 		details, err := GetChainDetailsByChainIDAndFamily(strChainID, FamilyEVM)
 		assert.NoError(t, err)
@@ -238,9 +238,182 @@ func Test_EVMGetChainDetailsByChainIDAndFamily(t *testing.T) {
 }
 
 func Test_EVMGetChainIDByChainSelector(t *testing.T) {
-	for k, v := range evmSelectorsMap {
+	for k, v := range evmSelectorsMap() {
 		chainID, err := GetChainIDFromSelector(v.ChainSelector)
 		assert.NoError(t, err)
 		assert.Equal(t, chainID, fmt.Sprintf("%v", k))
 	}
 }
+
+func Test_SelectorFromNetworkID(t *testing.T) {
+	selector, err := SelectorFromNetworkID(1)
+	require.NoError(t, err)
+	assert.Equal(t, ETHEREUM_MAINNET.Selector, selector)
+
+	selector, err = SelectorFromNetworkID(TEST_90000001.EvmChainID)
+	require.NoError(t, err)
+	assert.Equal(t, TEST_90000001.Selector, selector)
+
+	_, err = SelectorFromNetworkID(120398123)
+	require.Error(t, err)
+}
+
+func Test_LoadEVMNetworkIDsExplicitNetworkID(t *testing.T) {
+	chains, err := decodeSelectorsYml([]byte(`
+selectors:
+  63:
+    selector: 63
+    name: legacy-testnet
+    network_id: 7
+  90000001:
+    selector: 90000001
+`))
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), chains[63].NetworkID)
+
+	networkIDs := loadEVMNetworkIDs(chains)
+	assert.Equal(t, []uint64{63}, networkIDs[7])
+	assert.Empty(t, networkIDs[63], "chain id of a chain with an explicit network id should not resolve as a network id")
+	assert.Equal(t, []uint64{90000001}, networkIDs[90000001])
+}
+
+func Test_LoadEVMNetworkIDsSharedNetworkID(t *testing.T) {
+	networkIDs := loadEVMNetworkIDs(map[uint64]ChainDetails{
+		1:  {ChainSelector: 1},
+		61: {ChainSelector: 61, NetworkID: 1},
+	})
+	assert.Equal(t, []uint64{1, 61}, networkIDs[1])
+	assert.Empty(t, networkIDs[61])
+}
+
+func Test_ChainNetworkID(t *testing.T) {
+	assert.Equal(t, uint64(1), ETHEREUM_MAINNET.NetworkID())
+	assert.Equal(t, TEST_90000001.EvmChainID, TEST_90000001.NetworkID())
+}
+
+func Test_ChainByName(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		chain  Chain
+		exists bool
+	}{
+		{
+			name:   "canonical name",
+			input:  "ethereum-mainnet",
+			chain:  ETHEREUM_MAINNET,
+			exists: true,
+		},
+		{
+			name:   "alias",
+			input:  "sepolia",
+			chain:  ETHEREUM_TESTNET_SEPOLIA,
+			exists: true,
+		},
+		{
+			name:   "unnamed test chain",
+			input:  "90000013",
+			chain:  TEST_90000013,
+			exists: true,
+		},
+		{
+			name:  "custom chain",
+			input: "custom-testnet-9388201",
+			chain: Chain{
+				EvmChainID: 9388201,
+				Selector:   generateCustomChainSelector(9388201),
+				Name:       "custom-testnet-9388201",
+				VarName:    "CUSTOM_TESTNET_9388201",
+			},
+			exists: true,
+		},
+		{
+			name:  "custom name of an official chain",
+			input: "custom-testnet-1",
+		},
+		{
+			name:  "non canonical custom name",
+			input: "custom-testnet-09388201",
+		},
+		{
+			name:  "unknown name",
+			input: "avalanche-testnet-mumbai-1",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			chain, exists := ChainByName(test.input)
+			require.Equal(t, test.exists, exists)
+			assert.Equal(t, test.chain, chain)
+		})
+	}
+}
+
+func Test_ChainByVarName(t *testing.T) {
+	for _, ch := range ALL {
+		v, exists := ChainByVarName(ch.VarName)
+		require.True(t, exists, "chain %s should be resolvable by its var name", ch.Name)
+		assert.Equal(t, ch, v)
+		assert.Equal(t, ch, ChainsByVarName[ch.VarName])
+	}
+	assert.Len(t, ChainsByVarName, len(ALL))
+
+	ch, exists := ChainByVarName("ETHEREUM_MAINNET")
+	require.True(t, exists)
+	assert.Equal(t, ETHEREUM_MAINNET, ch)
+
+	_, exists = ChainByVarName("ethereum-mainnet")
+	assert.False(t, exists)
+}
+
+func Test_AllChainsSorted(t *testing.T) {
+	tests := []struct {
+		name string
+		key  SortKey
+		less func(a, b Chain) bool
+	}{
+		{"name", SortByName, func(a, b Chain) bool { return a.Name < b.Name }},
+		{"chain id", SortByChainID, func(a, b Chain) bool { return a.EvmChainID < b.EvmChainID }},
+		{"selector", SortBySelector, func(a, b Chain) bool { return a.Selector < b.Selector }},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			chains := AllChainsSorted(test.key)
+			require.Len(t, chains, len(ALL))
+			for i := 1; i < len(chains); i++ {
+				assert.False(t, test.less(chains[i], chains[i-1]), "%v should not come after %v", chains[i], chains[i-1])
+			}
+			assert.Equal(t, chains, AllChainsSorted(test.key))
+		})
+	}
+
+	t.Run("does not modify ALL", func(t *testing.T) {
+		first := ALL[0]
+		AllChainsSorted(SortBySelector)[0] = Chain{}
+		assert.Equal(t, first, ALL[0])
+	})
+}
+
+func Test_EvmMainAndTestChainIdToChainSelector(t *testing.T) {
+	main := EvmMainChainIdToChainSelector()
+	test := EvmTestChainIdToChainSelector()
+
+	assert.Equal(t, ETHEREUM_MAINNET.Selector, main[ETHEREUM_MAINNET.EvmChainID])
+	assert.NotContains(t, main, TEST_90000001.EvmChainID)
+	assert.Equal(t, TEST_90000001.Selector, test[TEST_90000001.EvmChainID])
+	assert.NotContains(t, test, ETHEREUM_MAINNET.EvmChainID)
+
+	merged := EvmChainIdToChainSelector()
+	assert.Len(t, merged, len(main)+len(test))
+	for k, v := range main {
+		assert.Equal(t, merged[k], v)
+	}
+	for k, v := range test {
+		assert.Equal(t, merged[k], v)
+	}
+
+	main[1] = 2
+	assert.Equal(t, ETHEREUM_MAINNET.Selector, EvmMainChainIdToChainSelector()[1])
+}
@@ -0,0 +1,109 @@
+package chain_selectors
+
+import (
+	"errors"
+	"fmt"
+)
+
+func ExampleGetChainDetailsBySelector() {
+	details, err := GetChainDetailsBySelector(5009297550715157269)
+	if err != nil {
+		panic(err)
+	}
+	family, _ := GetSelectorFamily(details.ChainSelector)
+	chainID, _ := GetChainIDFromSelector(details.ChainSelector)
+	fmt.Println(details.ChainName, family, chainID)
+	// Output: ethereum-mainnet evm 1
+}
+
+func ExampleChainByName() {
+	// Aliases resolve to the canonical chain.
+	ch, exists := ChainByName("sepolia")
+	fmt.Println(exists, ch.Name, ch.EvmChainID, ch.Selector)
+	// Output: true ethereum-testnet-sepolia 11155111 16015286601757825753
+}
+
+func ExampleLookupError() {
+	_, err := ChainIdFromName("no-such-chain")
+
+	var lookupErr *LookupError
+	if errors.As(err, &lookupErr) {
+		fmt.Println(lookupErr.InputKind, lookupErr.Family, lookupErr.Input, lookupErr.Reason)
+	}
+	fmt.Println(errors.Is(err, ErrChainNotFound))
+	// Output:
+	// name evm no-such-chain not found
+	// true
+}
+
+func ExampleGetChainDetailsBySelectors() {
+	details, err := GetChainDetailsBySelectors([]uint64{5009297550715157269, 1})
+
+	var multi *MultiError
+	if errors.As(err, &multi) {
+		fmt.Println("failed:", multi.Indexes())
+	}
+	fmt.Println("resolved:", details[0].ChainName)
+	// Output:
+	// failed: [1]
+	// resolved: ethereum-mainnet
+}
+
+func ExampleRegisterCustomChains() {
+	selectors, err := RegisterCustomChains([]CustomChainSpec{
+		{ChainID: 5500000201, Name: "example-devnet-a"},
+		{ChainID: 5500000202, Name: "example-devnet-b"},
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	ch, _ := ChainBySelector(selectors[0])
+	fmt.Println(ch.EvmChainID, IsTestChain(selectors[0]))
+	// Output:
+	// 5500000201 true
+}
+
+func ExampleNewFederatedRegistry() {
+	team := NewRegistry(WithEmbeddedChains(false))
+	if err := team.LoadYAML([]byte("selectors:\n  77101:\n    selector: 7710100000000000001\n    name: team-devnet\n")); err != nil {
+		panic(err)
+	}
+
+	federated, err := NewFederatedRegistry(
+		RegistrySource{Name: "team", Registry: team},
+		RegistrySource{Name: "official", Registry: NewRegistry()},
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, selector := range []uint64{7710100000000000001, 5009297550715157269} {
+		resolution, err := federated.ResolveWithSource(selector)
+		if err != nil {
+			panic(err)
+		}
+		fmt.Println(resolution.Details.ChainName, "from", resolution.Source)
+	}
+	// Output:
+	// team-devnet from team
+	// ethereum-mainnet from official
+}
+
+func ExampleRegistry_GetChainDetailsWithProvenance() {
+	registry := NewRegistry()
+	if err := registry.LoadYAML([]byte("selectors:\n  77102:\n    selector: 7710200000000000001\n    name: override-devnet\n")); err != nil {
+		panic(err)
+	}
+
+	for _, selector := range []uint64{7710200000000000001, 5009297550715157269} {
+		details, provenance, err := registry.GetChainDetailsWithProvenance(selector)
+		if err != nil {
+			panic(err)
+		}
+		fmt.Println(details.ChainName, provenance.Source)
+	}
+	// Output:
+	// override-devnet override
+	// ethereum-mainnet embedded
+}
@@ -0,0 +1,135 @@
+package chain_selectors
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// FamilyChainDetails is the details of a chain together with its chain id in the native type
+// of its family. Switch on the concrete type to access the chain id:
+//
+//	switch d := details.(type) {
+//	case EVMDetails:
+//		fmt.Println(d.ChainID + 1)
+//	case SolanaDetails:
+//		fmt.Println(d.GenesisHash)
+//	}
+type FamilyChainDetails interface {
+	Family() string
+	// ChainIDString formats the chain id like GetChainIDFromSelector.
+	ChainIDString() string
+	Details() ChainDetails
+}
+
+// EVMDetails is an EVM chain, identified by its EIP-155 chain id.
+type EVMDetails struct {
+	ChainID uint64
+	ChainDetails
+}
+
+func (d EVMDetails) Family() string        { return FamilyEVM }
+func (d EVMDetails) ChainIDString() string { return strconv.FormatUint(d.ChainID, 10) }
+func (d EVMDetails) Details() ChainDetails { return d.ChainDetails }
+
+// SolanaDetails is a Solana chain, identified by the base58 hash of its genesis block.
+type SolanaDetails struct {
+	GenesisHash string
+	ChainDetails
+}
+
+func (d SolanaDetails) Family() string        { return FamilySolana }
+func (d SolanaDetails) ChainIDString() string { return d.GenesisHash }
+func (d SolanaDetails) Details() ChainDetails { return d.ChainDetails }
+
+// CosmosDetails is a Cosmos chain, identified by its chain-id string. No Cosmos chain is
+// defined yet.
+type CosmosDetails struct {
+	ChainID string
+	ChainDetails
+}
+
+func (d CosmosDetails) Family() string        { return FamilyCosmos }
+func (d CosmosDetails) ChainIDString() string { return d.ChainID }
+func (d CosmosDetails) Details() ChainDetails { return d.ChainDetails }
+
+// AptosDetails is an Aptos chain.
+type AptosDetails struct {
+	ChainID uint64
+	ChainDetails
+}
+
+func (d AptosDetails) Family() string        { return FamilyAptos }
+func (d AptosDetails) ChainIDString() string { return strconv.FormatUint(d.ChainID, 10) }
+func (d AptosDetails) Details() ChainDetails { return d.ChainDetails }
+
+// SuiDetails is a Sui chain.
+type SuiDetails struct {
+	ChainID uint64
+	ChainDetails
+}
+
+func (d SuiDetails) Family() string        { return FamilySui }
+func (d SuiDetails) ChainIDString() string { return strconv.FormatUint(d.ChainID, 10) }
+func (d SuiDetails) Details() ChainDetails { return d.ChainDetails }
+
+// TronDetails is a Tron chain.
+type TronDetails struct {
+	ChainID uint64
+	ChainDetails
+}
+
+func (d TronDetails) Family() string        { return FamilyTron }
+func (d TronDetails) ChainIDString() string { return strconv.FormatUint(d.ChainID, 10) }
+func (d TronDetails) Details() ChainDetails { return d.ChainDetails }
+
+// TonDetails is a TON chain, identified by its signed workchain id.
+type TonDetails struct {
+	ChainID int32
+	ChainDetails
+}
+
+func (d TonDetails) Family() string        { return FamilyTon }
+func (d TonDetails) ChainIDString() string { return strconv.FormatInt(int64(d.ChainID), 10) }
+func (d TonDetails) Details() ChainDetails { return d.ChainDetails }
+
+// DetailsBySelector returns the details of the chain identified by the selector, of any family
+// including custom chains, with its chain id in the native type of the family.
+func DetailsBySelector(selector uint64) (FamilyChainDetails, error) {
+	info, err := getChainInfo(selector)
+	if err != nil {
+		return nil, selectorNotFoundError("", selector)
+	}
+	return familyDetails(info)
+}
+
+func familyDetails(info chainInfo) (FamilyChainDetails, error) {
+	switch info.Family {
+	case FamilySolana:
+		return SolanaDetails{GenesisHash: info.ChainID, ChainDetails: info.ChainDetails}, nil
+	case FamilyCosmos:
+		return CosmosDetails{ChainID: info.ChainID, ChainDetails: info.ChainDetails}, nil
+	case FamilyTon:
+		chainID, err := strconv.ParseInt(info.ChainID, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s chain id %s: %w", info.Family, info.ChainID, err)
+		}
+		return TonDetails{ChainID: int32(chainID), ChainDetails: info.ChainDetails}, nil
+	}
+
+	chainID, err := strconv.ParseUint(info.ChainID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s chain id %s: %w", info.Family, info.ChainID, err)
+	}
+	switch info.Family {
+	case FamilyEVM:
+		return EVMDetails{ChainID: chainID, ChainDetails: info.ChainDetails}, nil
+	case FamilyAptos:
+		return AptosDetails{ChainID: chainID, ChainDetails: info.ChainDetails}, nil
+	case FamilySui:
+		return SuiDetails{ChainID: chainID, ChainDetails: info.ChainDetails}, nil
+	case FamilyTron:
+		return TronDetails{ChainID: chainID, ChainDetails: info.ChainDetails}, nil
+	default:
+		return nil, lookupError(InputChainID, info.Family, info.ChainID, reasonUnsupportedFamily)
+	}
+}
@@ -0,0 +1,77 @@
+package chain_selectors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDetailsBySelector(t *testing.T) {
+	tests := []struct {
+		name     string
+		selector uint64
+		expected FamilyChainDetails
+	}{
+		{
+			name:     "evm",
+			selector: ETHEREUM_MAINNET.Selector,
+			expected: EVMDetails{ChainID: 1, ChainDetails: evmChainIdToChainSelector()[1]},
+		},
+		{
+			name:     "solana",
+			selector: SOLANA_MAINNET.Selector,
+			expected: SolanaDetails{GenesisHash: SOLANA_MAINNET.ChainID, ChainDetails: solanaChainIdToChainSelector()[SOLANA_MAINNET.ChainID]},
+		},
+		{
+			name:     "aptos",
+			selector: APTOS_MAINNET.Selector,
+			expected: AptosDetails{ChainID: APTOS_MAINNET.ChainID, ChainDetails: aptosSelectorsMap()[APTOS_MAINNET.ChainID]},
+		},
+		{
+			name:     "sui",
+			selector: SUI_MAINNET.Selector,
+			expected: SuiDetails{ChainID: SUI_MAINNET.ChainID, ChainDetails: suiSelectorsMap()[SUI_MAINNET.ChainID]},
+		},
+		{
+			name:     "tron",
+			selector: TRON_MAINNET.Selector,
+			expected: TronDetails{ChainID: TRON_MAINNET.ChainID, ChainDetails: tronSelectorsMap()[TRON_MAINNET.ChainID]},
+		},
+		{
+			name:     "ton",
+			selector: TON_MAINNET.Selector,
+			expected: TonDetails{ChainID: -239, ChainDetails: tonSelectorsMap()[-239]},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			details, err := DetailsBySelector(test.selector)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, details)
+			assert.Equal(t, test.selector, details.Details().ChainSelector)
+
+			family, err := GetSelectorFamily(test.selector)
+			require.NoError(t, err)
+			assert.Equal(t, family, details.Family())
+
+			chainID, err := GetChainIDFromSelector(test.selector)
+			require.NoError(t, err)
+			assert.Equal(t, chainID, details.ChainIDString())
+		})
+	}
+}
+
+func TestDetailsBySelectorCustomAndUnknownChains(t *testing.T) {
+	selector, err := selectorFromChainId(5500000301)
+	require.NoError(t, err)
+	details, err := DetailsBySelector(selector)
+	require.NoError(t, err)
+	evm, ok := details.(EVMDetails)
+	require.True(t, ok)
+	assert.Equal(t, uint64(5500000301), evm.ChainID)
+
+	_, err = DetailsBySelector(1)
+	assert.ErrorIs(t, err, ErrChainNotFound)
+}
@@ -0,0 +1,74 @@
+package chain_selectors
+
+import (
+	_ "embed"
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+//go:embed features.yml
+var featuresYml []byte
+
+// Feature is a protocol level capability an EVM chain may or may not support.
+type Feature string
+
+const (
+	FeatureEIP1559          Feature = "eip-1559"
+	FeatureEIP4844          Feature = "eip-4844"
+	FeaturePush0            Feature = "push0"
+	FeatureCanonicalCreate2 Feature = "canonical-create2"
+)
+
+var knownFeatures = map[Feature]struct{}{
+	FeatureEIP1559:          {},
+	FeatureEIP4844:          {},
+	FeaturePush0:            {},
+	FeatureCanonicalCreate2: {},
+}
+
+var featuresBySelector = onceValue(func() map[uint64]map[Feature]struct{} { return parseFeaturesYml(featuresYml) })
+
+func parseFeaturesYml(ymlFile []byte) map[uint64]map[Feature]struct{} {
+	type ymlData struct {
+		Features map[uint64][]Feature `yaml:"features"`
+	}
+
+	var data ymlData
+	err := yaml.Unmarshal(ymlFile, &data)
+	if err != nil {
+		panic(err)
+	}
+
+	output := make(map[uint64]map[Feature]struct{}, len(data.Features))
+	for selector, features := range data.Features {
+		set := make(map[Feature]struct{}, len(features))
+		for _, feature := range features {
+			if _, known := knownFeatures[feature]; !known {
+				panic(fmt.Errorf("unknown feature %q for selector %d", feature, selector))
+			}
+			set[feature] = struct{}{}
+		}
+		output[selector] = set
+	}
+	return output
+}
+
+// SupportsFeature reports whether the EVM chain identified by the selector supports the feature.
+// Chains without an entry in the capability matrix are reported as not supporting any feature.
+func SupportsFeature(selector uint64, feature Feature) (bool, error) {
+	if _, known := knownFeatures[feature]; !known {
+		return false, fmt.Errorf("unknown feature %s", feature)
+	}
+
+	family, err := GetSelectorFamily(selector)
+	if err != nil {
+		return false, err
+	}
+	if family != FamilyEVM {
+		return false, fmt.Errorf("features are only defined for %s chains, selector %d is %s", FamilyEVM, selector, family)
+	}
+
+	_, supported := featuresBySelector()[selector][feature]
+	return supported, nil
+}
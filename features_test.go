@@ -0,0 +1,78 @@
+package chain_selectors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeatureSelectorsAreEvmChains(t *testing.T) {
+	for selector := range featuresBySelector() {
+		_, exist := evmChainsBySelector()[selector]
+		assert.True(t, exist, "features.yml references selector %d which is not an evm chain", selector)
+	}
+}
+
+func Test_SupportsFeature(t *testing.T) {
+	tests := []struct {
+		name      string
+		selector  uint64
+		feature   Feature
+		supported bool
+		expectErr bool
+	}{
+		{
+			name:      "ethereum supports blobs",
+			selector:  ETHEREUM_MAINNET.Selector,
+			feature:   FeatureEIP4844,
+			supported: true,
+		},
+		{
+			name:     "bsc has no dynamic fees",
+			selector: BINANCE_SMART_CHAIN_MAINNET.Selector,
+			feature:  FeatureEIP1559,
+		},
+		{
+			name:     "chain without capability entry",
+			selector: ZORA_MAINNET.Selector,
+			feature:  FeatureEIP1559,
+		},
+		{
+			name:      "unknown feature",
+			selector:  ETHEREUM_MAINNET.Selector,
+			feature:   Feature("eip-9999"),
+			expectErr: true,
+		},
+		{
+			name:      "non evm chain",
+			selector:  SOLANA_MAINNET.Selector,
+			feature:   FeatureEIP1559,
+			expectErr: true,
+		},
+		{
+			name:      "unknown selector",
+			selector:  120398123,
+			feature:   FeatureEIP1559,
+			expectErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			supported, err := SupportsFeature(test.selector, test.feature)
+			if test.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.supported, supported)
+		})
+	}
+}
+
+func Test_ParseFeaturesYmlRejectsUnknownFeatures(t *testing.T) {
+	require.Panics(t, func() {
+		parseFeaturesYml([]byte("features:\n  1: [ eip-9999 ]\n"))
+	})
+}
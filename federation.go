@@ -0,0 +1,115 @@
+package chain_selectors
+
+import (
+	"errors"
+	"fmt"
+)
+
+// RegistrySource is a named Registry taking part in a FederatedRegistry.
+type RegistrySource struct {
+	Name     string
+	Registry *Registry
+}
+
+// Resolution is a chain resolved by a FederatedRegistry together with the source it came from.
+type Resolution struct {
+	Source  string
+	Family  string
+	ChainID string
+	Details ChainDetails
+}
+
+// FederatedRegistry composes registries with explicit precedence, for instance a team overlay
+// on top of a company overlay on top of the official chains. A lookup is answered by the first
+// source, in the order given to NewFederatedRegistry, able to resolve it.
+//
+// Overlay registries are usually created with WithEmbeddedChains(false) so they only answer
+// for the chains loaded into them and lookups of official chains fall through to the source
+// holding the embedded datasets.
+type FederatedRegistry struct {
+	sources []RegistrySource
+}
+
+// NewFederatedRegistry composes the sources, highest precedence first. Every source needs a
+// unique, non-empty name and a registry.
+func NewFederatedRegistry(sources ...RegistrySource) (*FederatedRegistry, error) {
+	if len(sources) == 0 {
+		return nil, errors.New("federated registry needs at least one source")
+	}
+	seen := make(map[string]struct{}, len(sources))
+	for i, source := range sources {
+		if source.Name == "" {
+			return nil, fmt.Errorf("source %d has no name", i)
+		}
+		if source.Registry == nil {
+			return nil, fmt.Errorf("source %s has no registry", source.Name)
+		}
+		if _, exists := seen[source.Name]; exists {
+			return nil, fmt.Errorf("source name %s is used twice", source.Name)
+		}
+		seen[source.Name] = struct{}{}
+	}
+	return &FederatedRegistry{sources: append([]RegistrySource(nil), sources...)}, nil
+}
+
+// Sources returns the names of the sources, highest precedence first.
+func (f *FederatedRegistry) Sources() []string {
+	names := make([]string, len(f.sources))
+	for i, source := range f.sources {
+		names[i] = source.Name
+	}
+	return names
+}
+
+// ResolveWithSource resolves the chain identified by the selector and reports which source
+// resolved it.
+func (f *FederatedRegistry) ResolveWithSource(selector uint64) (Resolution, error) {
+	for _, source := range f.sources {
+		family, err := source.Registry.GetSelectorFamily(selector)
+		if err != nil {
+			continue
+		}
+		chainID, err := source.Registry.GetChainIDFromSelector(selector)
+		if err != nil {
+			return Resolution{}, fmt.Errorf("source %s: %w", source.Name, err)
+		}
+		details, err := source.Registry.GetChainDetailsBySelector(selector)
+		if err != nil {
+			return Resolution{}, fmt.Errorf("source %s: %w", source.Name, err)
+		}
+		return Resolution{Source: source.Name, Family: family, ChainID: chainID, Details: details}, nil
+	}
+	return Resolution{}, selectorNotFoundError("", selector)
+}
+
+// ResolveChainIDWithSource resolves the chain identified by its family specific chain id and
+// reports which source resolved it.
+func (f *FederatedRegistry) ResolveChainIDWithSource(chainID string, family string) (Resolution, error) {
+	for _, source := range f.sources {
+		details, err := source.Registry.GetChainDetailsByChainIDAndFamily(chainID, family)
+		if err != nil {
+			continue
+		}
+		return Resolution{Source: source.Name, Family: family, ChainID: chainID, Details: details}, nil
+	}
+	return Resolution{}, notFoundError(InputChainID, family, chainID)
+}
+
+// GetChainDetailsBySelector returns the details of the chain as resolved by the first source.
+func (f *FederatedRegistry) GetChainDetailsBySelector(selector uint64) (ChainDetails, error) {
+	resolution, err := f.ResolveWithSource(selector)
+	if err != nil {
+		return ChainDetails{}, err
+	}
+	return resolution.Details, nil
+}
+
+// SelectorFromChainID returns the selector of the EVM chain as resolved by the first source.
+func (f *FederatedRegistry) SelectorFromChainID(chainID uint64) (uint64, error) {
+	for _, source := range f.sources {
+		if selector, err := source.Registry.SelectorFromChainID(chainID); err == nil {
+			return selector, nil
+		}
+	}
+	return 0, chainIDNotFoundError(FamilyEVM, chainID)
+}
@@ -0,0 +1,108 @@
+package chain_selectors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestFederation(t *testing.T) *FederatedRegistry {
+	team := NewRegistry(WithEmbeddedChains(false))
+	require.NoError(t, team.LoadYAML([]byte(`
+selectors:
+  88001:
+    selector: 8800100000000000001
+    name: "team-devnet"
+  1:
+    selector: 5009297550715157269
+    name: "ethereum-mainnet-team"
+`)))
+
+	company := NewRegistry(WithEmbeddedChains(false))
+	require.NoError(t, company.LoadYAML([]byte(`
+selectors:
+  88001:
+    selector: 8800100000000000001
+    name: "company-devnet"
+  88002:
+    selector: 8800200000000000001
+    name: "company-staging"
+`)))
+
+	federated, err := NewFederatedRegistry(
+		RegistrySource{Name: "team", Registry: team},
+		RegistrySource{Name: "company", Registry: company},
+		RegistrySource{Name: "official", Registry: NewRegistry(WithTestChains(false))},
+	)
+	require.NoError(t, err)
+	return federated
+}
+
+func TestFederatedRegistryPrecedence(t *testing.T) {
+	federated := newTestFederation(t)
+	assert.Equal(t, []string{"team", "company", "official"}, federated.Sources())
+
+	tests := []struct {
+		name           string
+		selector       uint64
+		expectedSource string
+		expectedName   string
+		expectedFamily string
+	}{
+		{name: "team overrides company", selector: 8800100000000000001, expectedSource: "team", expectedName: "team-devnet", expectedFamily: FamilyEVM},
+		{name: "company only", selector: 8800200000000000001, expectedSource: "company", expectedName: "company-staging", expectedFamily: FamilyEVM},
+		{name: "team renames official", selector: ETHEREUM_MAINNET.Selector, expectedSource: "team", expectedName: "ethereum-mainnet-team", expectedFamily: FamilyEVM},
+		{name: "official evm", selector: AVALANCHE_MAINNET.Selector, expectedSource: "official", expectedName: AVALANCHE_MAINNET.Name, expectedFamily: FamilyEVM},
+		{name: "official solana", selector: SOLANA_MAINNET.Selector, expectedSource: "official", expectedName: "solana-mainnet", expectedFamily: FamilySolana},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resolution, err := federated.ResolveWithSource(test.selector)
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedSource, resolution.Source)
+			assert.Equal(t, test.expectedName, resolution.Details.ChainName)
+			assert.Equal(t, test.expectedFamily, resolution.Family)
+		})
+	}
+
+	resolution, err := federated.ResolveChainIDWithSource("88002", FamilyEVM)
+	require.NoError(t, err)
+	assert.Equal(t, "company", resolution.Source)
+
+	selector, err := federated.SelectorFromChainID(88001)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(8800100000000000001), selector)
+
+	// Test chains are hidden by the official source and no overlay defines them.
+	_, err = federated.ResolveWithSource(TEST_90000001.Selector)
+	assert.Error(t, err)
+}
+
+func TestNewFederatedRegistryInvalid(t *testing.T) {
+	_, err := NewFederatedRegistry()
+	assert.Error(t, err)
+
+	_, err = NewFederatedRegistry(RegistrySource{Registry: NewRegistry()})
+	assert.Error(t, err)
+
+	_, err = NewFederatedRegistry(RegistrySource{Name: "a"})
+	assert.Error(t, err)
+
+	_, err = NewFederatedRegistry(RegistrySource{Name: "a", Registry: NewRegistry()}, RegistrySource{Name: "a", Registry: NewRegistry()})
+	assert.Error(t, err)
+}
+
+func TestRegistryWithoutEmbeddedChains(t *testing.T) {
+	registry := NewRegistry(WithEmbeddedChains(false))
+
+	_, err := registry.SelectorFromChainID(1)
+	assert.Error(t, err)
+	_, exists := registry.ChainByName("ethereum-mainnet")
+	assert.False(t, exists)
+	_, err = registry.GetSelectorFamily(SOLANA_MAINNET.Selector)
+	assert.Error(t, err)
+	_, exists = registry.ChainBySelector(generateCustomChainSelector(9388201))
+	assert.False(t, exists)
+}
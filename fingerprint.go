@@ -0,0 +1,33 @@
+package chain_selectors
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+)
+
+// fingerprintHeader starts the canonical encoding hashed by Fingerprint, it is bumped whenever
+// the encoding changes so fingerprints of different encodings never match.
+const fingerprintHeader = "chain-selectors fingerprint v1\n"
+
+// Fingerprint returns the hex encoded SHA-256 of the canonical encoding of every chain the
+// registry resolves: the embedded chains it does not exclude merged with the chains loaded
+// into it. Services resolving selectors identically have the same fingerprint, so comparing
+// fingerprints at startup detects services running with stale or diverging datasets. Custom
+// chains generated on the fly and remote chains are not part of the fingerprint.
+//
+// The encoding is a header line followed by one line per chain, sorted by selector, holding
+// the selector, family, chain id and name separated by tabs.
+func (r *Registry) Fingerprint() string {
+	return r.fingerprintOf(r.loadState())
+}
+
+// fingerprintOf returns the fingerprint of the registry with the state loaded.
+func (r *Registry) fingerprintOf(state *registryState) string {
+	hash := sha256.New()
+	hash.Write([]byte(fingerprintHeader))
+	for _, ch := range r.chainsOf(state) {
+		fmt.Fprintf(hash, "%d\t%s\t%s\t%s\n", ch.Selector, ch.Family, ch.ChainID, ch.Name)
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
@@ -0,0 +1,25 @@
+package chain_selectors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistryFingerprint(t *testing.T) {
+	a, b := NewRegistry(), NewRegistry()
+	assert.Len(t, a.Fingerprint(), 64)
+	assert.Equal(t, a.Fingerprint(), b.Fingerprint(), "registries resolving the same chains")
+	assert.NotEqual(t, a.Fingerprint(), NewRegistry(WithTestChains(false)).Fingerprint())
+
+	overlay := []byte("selectors:\n  77001:\n    selector: 7700100000000000001\n    name: \"private-devnet-1\"\n")
+	require.NoError(t, a.LoadYAML(overlay))
+	assert.NotEqual(t, a.Fingerprint(), b.Fingerprint())
+
+	require.NoError(t, b.LoadYAML(overlay))
+	assert.Equal(t, a.Fingerprint(), b.Fingerprint(), "the same chains loaded")
+
+	require.NoError(t, b.LoadYAML([]byte("selectors:\n  1:\n    selector: 5009297550715157269\n    name: \"ethereum-mainnet-renamed\"\n")))
+	assert.NotEqual(t, a.Fingerprint(), b.Fingerprint(), "a renamed chain")
+}
@@ -0,0 +1,74 @@
+package chain_selectors
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// String returns a compact description, e.g. "ethereum-mainnet (id=1, selector=5009297550715157269)".
+func (c Chain) String() string {
+	return fmt.Sprintf("%s (id=%d, selector=%d)", c.Name, c.EvmChainID, c.Selector)
+}
+
+// Format implements fmt.Formatter. %v, %s and %q print String, honouring width and flags, %+v
+// additionally prints the generated variable name and %#v prints the Go syntax representation.
+// Other verbs format the fields like a plain struct.
+func (c Chain) Format(f fmt.State, verb rune) {
+	switch {
+	case verb == 'v' && f.Flag('#'):
+		fmt.Fprintf(f, "chain_selectors.Chain{EvmChainID:0x%x, Selector:0x%x, Name:%q, VarName:%q}",
+			c.EvmChainID, c.Selector, c.Name, c.VarName)
+	case verb == 'v' && f.Flag('+'):
+		formatString(f, verb, fmt.Sprintf("%s (id=%d, selector=%d, var=%s)", c.Name, c.EvmChainID, c.Selector, c.VarName))
+	case verb == 'v' || verb == 's' || verb == 'q':
+		formatString(f, verb, c.String())
+	default:
+		type fields Chain
+		fmt.Fprintf(f, fmt.FormatString(f, verb), fields(c))
+	}
+}
+
+// String returns a compact description, e.g. "ethereum-mainnet (selector=5009297550715157269)".
+func (d ChainDetails) String() string {
+	return d.describe(false)
+}
+
+// describe returns String, with the optional legacy fields if legacy is set.
+func (d ChainDetails) describe(legacy bool) string {
+	name := d.ChainName
+	if name == "" {
+		name = "unnamed"
+	}
+	fields := []string{"selector=" + strconv.FormatUint(d.ChainSelector, 10)}
+	if legacy && d.NetworkID != 0 {
+		fields = append(fields, "network_id="+strconv.FormatUint(d.NetworkID, 10))
+	}
+	if legacy && d.GenesisHash != "" {
+		fields = append(fields, "genesis="+d.GenesisHash)
+	}
+	return fmt.Sprintf("%s (%s)", name, strings.Join(fields, ", "))
+}
+
+// Format implements fmt.Formatter. %v, %s and %q print String, honouring width and flags, %+v
+// additionally prints the optional legacy fields and %#v prints the Go syntax representation.
+// Other verbs format the fields like a plain struct.
+func (d ChainDetails) Format(f fmt.State, verb rune) {
+	switch {
+	case verb == 'v' && f.Flag('#'):
+		fmt.Fprintf(f, "chain_selectors.ChainDetails{ChainSelector:0x%x, ChainName:%q, NetworkID:0x%x, GenesisHash:%q, Owner:%q, ApprovedBy:%q}",
+			d.ChainSelector, d.ChainName, d.NetworkID, d.GenesisHash, d.Owner, d.ApprovedBy)
+	case verb == 'v' && f.Flag('+'):
+		formatString(f, verb, d.describe(true))
+	case verb == 'v' || verb == 's' || verb == 'q':
+		formatString(f, verb, d.String())
+	default:
+		type fields ChainDetails
+		fmt.Fprintf(f, fmt.FormatString(f, verb), fields(d))
+	}
+}
+
+// formatString prints s with the verb, width and flags of the state.
+func formatString(f fmt.State, verb rune, s string) {
+	fmt.Fprintf(f, fmt.FormatString(f, verb), s)
+}
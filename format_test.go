@@ -0,0 +1,59 @@
+package chain_selectors
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ChainFormat(t *testing.T) {
+	tests := []struct {
+		format   string
+		expected string
+	}{
+		{"%v", "ethereum-mainnet (id=1, selector=5009297550715157269)"},
+		{"%s", "ethereum-mainnet (id=1, selector=5009297550715157269)"},
+		{"%q", `"ethereum-mainnet (id=1, selector=5009297550715157269)"`},
+		{"%+v", "ethereum-mainnet (id=1, selector=5009297550715157269, var=ETHEREUM_MAINNET)"},
+		{"%#v", `chain_selectors.Chain{EvmChainID:0x1, Selector:0x45849994fc9c7b15, Name:"ethereum-mainnet", VarName:"ETHEREUM_MAINNET"}`},
+		{"%-60s|", "ethereum-mainnet (id=1, selector=5009297550715157269)       |"},
+		{"%60v", "       ethereum-mainnet (id=1, selector=5009297550715157269)"},
+		{"%.16s", "ethereum-mainnet"},
+		{"%x", "{1 45849994fc9c7b15 657468657265756d2d6d61696e6e6574 455448455245554d5f4d41494e4e4554}"},
+		{"%d", "{1 5009297550715157269 %!d(string=ethereum-mainnet) %!d(string=ETHEREUM_MAINNET)}"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.format, func(t *testing.T) {
+			assert.Equal(t, test.expected, fmt.Sprintf(test.format, ETHEREUM_MAINNET))
+		})
+	}
+	assert.Equal(t, "ethereum-mainnet (id=1, selector=5009297550715157269)", ETHEREUM_MAINNET.String())
+}
+
+func Test_ChainDetailsFormat(t *testing.T) {
+	details := ChainDetails{ChainSelector: 5009297550715157269, ChainName: "ethereum-mainnet"}
+	legacy := ChainDetails{ChainSelector: 909606746561742123, NetworkID: 4242, GenesisHash: "0xabc"}
+
+	tests := []struct {
+		format   string
+		details  ChainDetails
+		expected string
+	}{
+		{"%v", details, "ethereum-mainnet (selector=5009297550715157269)"},
+		{"%s", legacy, "unnamed (selector=909606746561742123)"},
+		{"%+v", details, "ethereum-mainnet (selector=5009297550715157269)"},
+		{"%+v", legacy, "unnamed (selector=909606746561742123, network_id=4242, genesis=0xabc)"},
+		{"%-50s|", details, "ethereum-mainnet (selector=5009297550715157269)   |"},
+		{"%q", legacy, `"unnamed (selector=909606746561742123)"`},
+		{"%x", legacy, "{c9f9284461c852b  1092 3078616263  }"},
+		{"%#v", details, `chain_selectors.ChainDetails{ChainSelector:0x45849994fc9c7b15, ChainName:"ethereum-mainnet", NetworkID:0x0, GenesisHash:"", Owner:"", ApprovedBy:""}`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.format, func(t *testing.T) {
+			assert.Equal(t, test.expected, fmt.Sprintf(test.format, test.details))
+		})
+	}
+}
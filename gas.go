@@ -0,0 +1,80 @@
+package chain_selectors
+
+import (
+	_ "embed"
+	"fmt"
+	"strconv"
+
+	"gopkg.in/yaml.v3"
+)
+
+//go:embed gas.yml
+var gasYml []byte
+
+// GasPriceOracle describes how gas prices should be estimated on a chain.
+type GasPriceOracle string
+
+const (
+	GasPriceOracleEIP1559 GasPriceOracle = "eip-1559"
+	GasPriceOracleLegacy  GasPriceOracle = "legacy"
+)
+
+// L1DataFeeModel identifies how a chain charges for posting its data to a settlement layer.
+type L1DataFeeModel string
+
+const (
+	L1DataFeeNone     L1DataFeeModel = "none"
+	L1DataFeeOPStack  L1DataFeeModel = "op-stack"
+	L1DataFeeArbitrum L1DataFeeModel = "arbitrum"
+	L1DataFeeScroll   L1DataFeeModel = "scroll"
+	L1DataFeeZKSync   L1DataFeeModel = "zksync"
+)
+
+// GasConfig holds the gas hints needed to estimate fees on a chain.
+type GasConfig struct {
+	Oracle         GasPriceOracle `yaml:"oracle"`
+	MinGasPriceWei uint64         `yaml:"min_gas_price_wei"`
+	L1DataFee      L1DataFeeModel `yaml:"l1_data_fee"`
+}
+
+var gasConfigsBySelector = onceValue(func() map[uint64]GasConfig { return parseGasYml(gasYml) })
+
+func parseGasYml(ymlFile []byte) map[uint64]GasConfig {
+	type ymlData struct {
+		Gas map[uint64]GasConfig `yaml:"gas"`
+	}
+
+	var data ymlData
+	err := yaml.Unmarshal(ymlFile, &data)
+	if err != nil {
+		panic(err)
+	}
+
+	validateGasConfigs(data.Gas)
+	return data.Gas
+}
+
+func validateGasConfigs(data map[uint64]GasConfig) {
+	for selector, config := range data {
+		switch config.Oracle {
+		case GasPriceOracleEIP1559, GasPriceOracleLegacy:
+		default:
+			panic(fmt.Errorf("invalid gas price oracle %q for selector %d", config.Oracle, selector))
+		}
+
+		switch config.L1DataFee {
+		case L1DataFeeNone, L1DataFeeOPStack, L1DataFeeArbitrum, L1DataFeeScroll, L1DataFeeZKSync:
+		default:
+			panic(fmt.Errorf("invalid l1 data fee model %q for selector %d", config.L1DataFee, selector))
+		}
+	}
+}
+
+// GetGasConfig returns the gas hints of the chain identified by the selector.
+func GetGasConfig(selector uint64) (GasConfig, error) {
+	config, exist := gasConfigsBySelector()[selector]
+	if !exist {
+		return GasConfig{}, lookupError(InputSelector, FamilyEVM, strconv.FormatUint(selector, 10), "gas config not found")
+	}
+	return config, nil
+}
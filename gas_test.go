@@ -0,0 +1,41 @@
+package chain_selectors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGasConfigSelectorsAreKnownChains(t *testing.T) {
+	for selector := range gasConfigsBySelector() {
+		_, err := getChainInfo(selector)
+		assert.NoError(t, err, "gas.yml references unknown selector %d", selector)
+	}
+}
+
+func Test_GetGasConfig(t *testing.T) {
+	config, err := GetGasConfig(ETHEREUM_MAINNET_ARBITRUM_1.Selector)
+	require.NoError(t, err)
+	assert.Equal(t, GasConfig{
+		Oracle:         GasPriceOracleEIP1559,
+		MinGasPriceWei: 10000000,
+		L1DataFee:      L1DataFeeArbitrum,
+	}, config)
+
+	config, err = GetGasConfig(BINANCE_SMART_CHAIN_MAINNET.Selector)
+	require.NoError(t, err)
+	assert.Equal(t, GasPriceOracleLegacy, config.Oracle)
+
+	_, err = GetGasConfig(ZORA_TESTNET.Selector)
+	require.Error(t, err)
+}
+
+func Test_ParseGasYmlRejectsInvalidValues(t *testing.T) {
+	require.Panics(t, func() {
+		parseGasYml([]byte("gas:\n  1:\n    oracle: magic\n    l1_data_fee: none\n"))
+	})
+	require.Panics(t, func() {
+		parseGasYml([]byte("gas:\n  1:\n    oracle: legacy\n    l1_data_fee: magic\n"))
+	})
+}
@@ -0,0 +1,72 @@
+//go:build ignore
+
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"os"
+	"sort"
+	"text/template"
+
+	chain_selectors "github.com/fravlaca/chain-selectors"
+	"github.com/fravlaca/chain-selectors/gencheck"
+)
+
+// filename is the table of the hardcodedchain analyzer, which lives in its own module and
+// cannot import the selectors.
+const filename = "analysis/hardcodedchain/generated_chains.go"
+
+var chainsTemplate = template.Must(template.New("").Parse(`// Code generated by go generate please DO NOT EDIT
+package hardcodedchain
+
+// varNameByChainID maps the chain id of every EVM chain to the name of its variable.
+var varNameByChainID = map[uint64]string{
+{{ range . }}{{ .EvmChainID }}: "{{ .VarName }}",
+{{ end }}
+}
+
+// varNameBySelector maps the selector of every EVM chain to the name of its variable.
+var varNameBySelector = map[uint64]string{
+{{ range . }}{{ .Selector }}: "{{ .VarName }}",
+{{ end }}
+}
+`))
+
+func main() {
+	verify := flag.Bool("verify", false, "report an outdated "+filename+" instead of rewriting it")
+	flag.Parse()
+
+	chains := make([]chain_selectors.Chain, len(chain_selectors.ALL))
+	copy(chains, chain_selectors.ALL)
+	sort.Slice(chains, func(i, j int) bool { return chains[i].EvmChainID < chains[j].EvmChainID })
+
+	var src bytes.Buffer
+	if err := chainsTemplate.Execute(&src, chains); err != nil {
+		panic(err)
+	}
+	formatted, err := format.Source(src.Bytes())
+	if err != nil {
+		panic(err)
+	}
+
+	existingContent, err := os.ReadFile(filename)
+	if err != nil && !os.IsNotExist(err) {
+		panic(err)
+	}
+	if bytes.Equal(existingContent, formatted) {
+		fmt.Println("analysis: no changes detected")
+		return
+	}
+	if *verify {
+		fmt.Print(gencheck.Diff(filename, existingContent, formatted))
+		os.Exit(1)
+	}
+	fmt.Println("analysis: updating generations")
+
+	if err := os.WriteFile(filename, formatted, 0644); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,56 @@
+//go:build ignore
+
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+
+	chain_selectors "github.com/fravlaca/chain-selectors"
+)
+
+const filename = "selectors.bin"
+
+func main() {
+	verify := flag.Bool("verify", false, "report an outdated "+filename+" instead of rewriting it")
+	flag.Parse()
+
+	ymlFile, err := os.ReadFile(filepath.Join("evm", "selectors.yml"))
+	if err != nil {
+		panic(err)
+	}
+	var data struct {
+		SelectorsByEvmChainId map[uint64]chain_selectors.ChainDetails `yaml:"selectors"`
+	}
+	if err := yaml.Unmarshal(ymlFile, &data); err != nil {
+		panic(err)
+	}
+	encoded, err := chain_selectors.EncodeBinaryDataset(data.SelectorsByEvmChainId)
+	if err != nil {
+		panic(err)
+	}
+
+	existingContent, err := os.ReadFile(filename)
+	if err != nil && !os.IsNotExist(err) {
+		panic(err)
+	}
+	if bytes.Equal(existingContent, encoded) {
+		fmt.Println("binary: no changes detected")
+		return
+	}
+	if *verify {
+		// the encoding is binary, a diff of it would not help
+		fmt.Printf("%s is outdated, run go generate\n", filename)
+		os.Exit(1)
+	}
+	fmt.Println("binary: updating generations")
+
+	if err := os.WriteFile(filename, encoded, 0644); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,152 @@
+//go:build ignore
+
+package main
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/fravlaca/chain-selectors/gencheck"
+)
+
+const filename = "generated_build_info.go"
+
+// datasetFiles are the files EmbeddedDatasetVersion is computed from.
+var datasetFiles = []string{
+	"evm/selectors.yml",
+	"evm/test_selectors.yml",
+	"evm/selectors_forks.yml",
+	"solana/selectors_solana.yml",
+	"solana/test_selectors_solana.yml",
+	"aptos/selectors_aptos.yml",
+	"sui/selectors_sui.yml",
+	"tron/selectors_tron.yml",
+	"ton/selectors_ton.yml",
+}
+
+var buildInfoTemplate = template.Must(template.New("").Parse(`// Code generated by go generate please DO NOT EDIT
+package chain_selectors
+
+var generatedBuildInfo = generatedStamp{
+	GeneratedAt:      "{{ .GeneratedAt }}",
+	GeneratorVersion: "{{ .GeneratorVersion }}",
+	DatasetFiles: map[string]string{
+{{- range .Files }}
+		"{{ .Path }}": "{{ .BlobSHA }}",
+{{- end }}
+	},
+}
+`))
+
+var generatedAtPattern = regexp.MustCompile(`GeneratedAt:\s+"([^"]+)"`)
+
+type datasetFile struct {
+	Path    string
+	BlobSHA string
+}
+
+func main() {
+	verify := flag.Bool("verify", false, "report an outdated "+filename+" instead of rewriting it")
+	flag.Parse()
+
+	existingContent, err := os.ReadFile(filename)
+	if err != nil && !os.IsNotExist(err) {
+		panic(err)
+	}
+
+	// the stamp keeps its time as long as the datasets and generators are unchanged, so
+	// regenerating an up to date tree changes nothing
+	generatedAt := time.Now().UTC().Format(time.RFC3339)
+	if match := generatedAtPattern.FindSubmatch(existingContent); match != nil {
+		generatedAt = string(match[1])
+	}
+	formatted, err := render(generatedAt)
+	if err != nil {
+		panic(err)
+	}
+	if bytes.Equal(existingContent, formatted) {
+		fmt.Println("buildinfo: no changes detected")
+		return
+	}
+	if formatted, err = render(time.Now().UTC().Format(time.RFC3339)); err != nil {
+		panic(err)
+	}
+	if *verify {
+		fmt.Print(gencheck.Diff(filename, existingContent, formatted))
+		os.Exit(1)
+	}
+	fmt.Println("buildinfo: updating generations")
+
+	if err := os.WriteFile(filename, formatted, 0644); err != nil {
+		panic(err)
+	}
+}
+
+func render(generatedAt string) ([]byte, error) {
+	files := make([]datasetFile, len(datasetFiles))
+	for i, path := range datasetFiles {
+		content, err := os.ReadFile(filepath.FromSlash(path))
+		if err != nil {
+			return nil, err
+		}
+		files[i] = datasetFile{Path: path, BlobSHA: gitBlobSHA(content)}
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].Path < files[j].Path })
+
+	version, err := generatorVersion()
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	err = buildInfoTemplate.Execute(&buf, struct {
+		GeneratedAt      string
+		GeneratorVersion string
+		Files            []datasetFile
+	}{generatedAt, version, files})
+	if err != nil {
+		return nil, err
+	}
+	return format.Source(buf.Bytes())
+}
+
+// gitBlobSHA returns the object id git gives the content, which `git log --find-object` looks up.
+func gitBlobSHA(content []byte) string {
+	h := sha1.New()
+	fmt.Fprintf(h, "blob %d\x00", len(content))
+	h.Write(content)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// generatorVersion identifies the generators by the truncated SHA-256 of their sources.
+func generatorVersion() (string, error) {
+	sources, err := filepath.Glob("gen*.go")
+	if err != nil {
+		return "", err
+	}
+	sort.Strings(sources)
+	h := sha256.New()
+	for _, source := range sources {
+		if strings.HasPrefix(source, "generated_") {
+			continue
+		}
+		content, err := os.ReadFile(source)
+		if err != nil {
+			return "", err
+		}
+		h.Write(content)
+	}
+	return hex.EncodeToString(h.Sum(nil)[:8]), nil
+}
@@ -0,0 +1,154 @@
+//go:build ignore
+
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+
+	chain_selectors "github.com/fravlaca/chain-selectors"
+	"gopkg.in/yaml.v3"
+)
+
+const filename = "metadata.yml"
+
+const header = `# Chain metadata keyed by chain selector.
+# display_name is the name shown to users, in any script, e.g. "Ethereum Mainnet" for ethereum-mainnet. UIs must use it
+# as is rather than deriving one from the canonical name, see DisplayName.
+# logo_uri is optional, an https URL or a data URI of a small image, e.g. "data:image/svg+xml;base64,...", see LogoURI.
+# brand_color is optional, a "#RRGGBB" hex color, see BrandColor.
+# website and docs are optional https URLs of the official site and developer documentation, see WebsiteURL and DocsURL.
+# faucets are optional https URLs handing out test funds, testnets and devnets only, see FaucetURLs.
+# EVM entries can be refreshed from the ethereum-lists/chains dataset with ` + "`go run genchainlist.go`" + `.
+metadata:
+`
+
+func main() {
+	url := flag.String("url", chain_selectors.ChainlistURL, "location of the ethereum-lists/chains dataset")
+	reportMissing := flag.Bool("missing", false, "list upstream chains that have no selector")
+	flag.Parse()
+
+	resp, err := http.Get(*url)
+	if err != nil {
+		panic(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		panic(fmt.Errorf("failed to fetch %s: %s", *url, resp.Status))
+	}
+
+	chains, err := chain_selectors.ParseChainlist(resp.Body)
+	if err != nil {
+		panic(err)
+	}
+	imported := chain_selectors.ImportChainlist(chains)
+
+	existing, err := readMetadata()
+	if err != nil {
+		panic(err)
+	}
+	for selector, metadata := range imported.Metadata {
+		existing[selector] = merge(existing[selector], metadata)
+	}
+
+	content, err := renderMetadata(existing)
+	if err != nil {
+		panic(err)
+	}
+	if err := os.WriteFile(filename, content, 0644); err != nil {
+		panic(err)
+	}
+	fmt.Printf("chainlist: imported metadata for %d chains, %d upstream chains missing locally\n",
+		len(imported.Metadata), len(imported.Missing))
+
+	if *reportMissing {
+		for _, chain := range imported.Missing {
+			fmt.Printf("  %d\t%s\n", chain.ChainID, chain.Name)
+		}
+	}
+}
+
+func readMetadata() (map[uint64]chain_selectors.ChainMetadata, error) {
+	type ymlData struct {
+		Metadata map[uint64]chain_selectors.ChainMetadata `yaml:"metadata"`
+	}
+
+	content, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var data ymlData
+	if err := yaml.Unmarshal(content, &data); err != nil {
+		return nil, err
+	}
+	if data.Metadata == nil {
+		data.Metadata = make(map[uint64]chain_selectors.ChainMetadata)
+	}
+	return data.Metadata, nil
+}
+
+// merge replaces the fields of the current metadata chainlist provides, keeping the curated ones.
+func merge(current, imported chain_selectors.ChainMetadata) chain_selectors.ChainMetadata {
+	imported.FinalityDepth = current.FinalityDepth
+	imported.CoinType = current.CoinType
+	imported.LogoURI = current.LogoURI
+	imported.BrandColor = current.BrandColor
+	imported.Docs = current.Docs
+	if imported.Website == "" {
+		imported.Website = current.Website
+	}
+	if len(imported.Faucets) == 0 {
+		imported.Faucets = current.Faucets
+	}
+	return imported
+}
+
+func renderMetadata(metadata map[uint64]chain_selectors.ChainMetadata) ([]byte, error) {
+	selectors := make([]uint64, 0, len(metadata))
+	for selector := range metadata {
+		selectors = append(selectors, selector)
+	}
+	sort.Slice(selectors, func(i, j int) bool { return selectors[i] < selectors[j] })
+
+	var wr = new(bytes.Buffer)
+	wr.WriteString(header)
+	for _, selector := range selectors {
+		var entry bytes.Buffer
+		encoder := yaml.NewEncoder(&entry)
+		encoder.SetIndent(2)
+		if err := encoder.Encode(metadata[selector]); err != nil {
+			return nil, err
+		}
+
+		if name := chainName(selector); name != "" {
+			fmt.Fprintf(wr, "  # %s\n", name)
+		}
+		fmt.Fprintf(wr, "  %d:\n", selector)
+		for _, line := range strings.Split(strings.TrimRight(entry.String(), "\n"), "\n") {
+			fmt.Fprintf(wr, "    %s\n", line)
+		}
+	}
+	return wr.Bytes(), nil
+}
+
+func chainName(selector uint64) string {
+	family, err := chain_selectors.GetSelectorFamily(selector)
+	if err != nil {
+		return ""
+	}
+	chainID, err := chain_selectors.GetChainIDFromSelector(selector)
+	if err != nil {
+		return ""
+	}
+	details, err := chain_selectors.GetChainDetailsByChainIDAndFamily(chainID, family)
+	if err != nil {
+		return ""
+	}
+	return details.ChainName
+}
@@ -4,6 +4,7 @@ package main
 
 import (
 	"bytes"
+	"flag"
 	"fmt"
 	"go/format"
 	"html/template"
@@ -13,7 +14,8 @@ import (
 	"strings"
 	"unicode"
 
-	chain_selectors "github.com/smartcontractkit/chain-selectors"
+	chain_selectors "github.com/fravlaca/chain-selectors"
+	"github.com/fravlaca/chain-selectors/gencheck"
 )
 
 const filename = "generated_chains_aptos.go"
@@ -48,6 +50,9 @@ var AptosALL = []AptosChain{
 `)
 
 func main() {
+	verify := flag.Bool("verify", false, "report an outdated "+filename+" instead of rewriting it")
+	flag.Parse()
+
 	src, err := genChainsSourceCode()
 	if err != nil {
 		panic(err)
@@ -67,6 +72,10 @@ func main() {
 		fmt.Println("aptos: no changes detected")
 		return
 	}
+	if *verify {
+		fmt.Print(gencheck.Diff(filename, existingContent, formatted))
+		os.Exit(1)
+	}
 	fmt.Println("aptos: updating generations")
 
 	err = os.WriteFile(filename, formatted, 0644)
@@ -84,6 +93,12 @@ func genChainsSourceCode() (string, error) {
 		if err != nil {
 			return "", err
 		}
+		if err := chain_selectors.ValidateFamilySelector(chain_selectors.FamilyAptos, chainSel); err != nil {
+			return "", fmt.Errorf("chain %v (%s): %w", ChainID, name, err)
+		}
+		if err := chain_selectors.ValidateReservation(chainSel, name); err != nil {
+			return "", fmt.Errorf("chain %v (%s): %w", ChainID, name, err)
+		}
 
 		chains = append(chains, chain{
 			ChainID:  ChainID,
@@ -4,6 +4,7 @@ package main
 
 import (
 	"bytes"
+	"flag"
 	"fmt"
 	"go/format"
 	"html/template"
@@ -13,7 +14,8 @@ import (
 	"strings"
 	"unicode"
 
-	chain_selectors "github.com/smartcontractkit/chain-selectors"
+	chain_selectors "github.com/fravlaca/chain-selectors"
+	"github.com/fravlaca/chain-selectors/gencheck"
 )
 
 const filename = "generated_chains_evm.go"
@@ -37,17 +39,27 @@ type Chain struct {
 
 var (
 {{ range . }}
-	{{.VarName}} = Chain{EvmChainID: {{ .EvmChainID }}, Selector: {{ .Selector }}, Name: "{{ .Name }}"}{{ end }}
+	{{.VarName}} = Chain{EvmChainID: {{ .EvmChainID }}, Selector: {{ .Selector }}, Name: "{{ .Name }}", VarName: "{{ .VarName }}"}{{ end }}
 )
 
+// ALL lists every chain sorted by VarName.
 var ALL = []Chain{
 {{ range . }}{{ .VarName }},
 {{ end }}
 }
 
+// ChainsByVarName indexes every chain by the name of its generated variable.
+var ChainsByVarName = map[string]Chain{
+{{ range . }}"{{ .VarName }}": {{ .VarName }},
+{{ end }}
+}
+
 `)
 
 func main() {
+	verify := flag.Bool("verify", false, "report an outdated "+filename+" instead of rewriting it")
+	flag.Parse()
+
 	src, err := genChainsSourceCode()
 	if err != nil {
 		panic(err)
@@ -67,6 +79,10 @@ func main() {
 		fmt.Println("evm: no changes detected")
 		return
 	}
+	if *verify {
+		fmt.Print(gencheck.Diff(filename, existingContent, formatted))
+		os.Exit(1)
+	}
 	fmt.Println("evm: updating generations")
 
 	err = os.WriteFile(filename, formatted, 0644)
@@ -84,6 +100,12 @@ func genChainsSourceCode() (string, error) {
 		if err != nil {
 			return "", err
 		}
+		if err := chain_selectors.ValidateFamilySelector(chain_selectors.FamilyEVM, chainSel); err != nil {
+			return "", fmt.Errorf("chain %d (%s): %w", evmChainID, name, err)
+		}
+		if err := chain_selectors.ValidateReservation(chainSel, name); err != nil {
+			return "", fmt.Errorf("chain %d (%s): %w", evmChainID, name, err)
+		}
 
 		chains = append(chains, chain{
 			EvmChainID: evmChainID,
@@ -0,0 +1,81 @@
+package gencheck
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Diff returns the unified diff, without context lines, turning before into after. It is empty
+// if they are equal.
+func Diff(name string, before, after []byte) string {
+	a := splitLines(string(before))
+	b := splitLines(string(after))
+
+	// lcs[i][j] is the length of the longest common subsequence of a[i:] and b[j:].
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			switch {
+			case a[i] == b[j]:
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			case lcs[i+1][j] >= lcs[i][j+1]:
+				lcs[i][j] = lcs[i+1][j]
+			default:
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	var out strings.Builder
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		if i < len(a) && j < len(b) && a[i] == b[j] {
+			i, j = i+1, j+1
+			continue
+		}
+		// collect the hunk of consecutive removed and added lines
+		startA, startB := i, j
+		for i < len(a) || j < len(b) {
+			if i < len(a) && j < len(b) && a[i] == b[j] {
+				break
+			}
+			if j == len(b) || (i < len(a) && lcs[i+1][j] >= lcs[i][j+1]) {
+				i++
+			} else {
+				j++
+			}
+		}
+		if out.Len() == 0 {
+			fmt.Fprintf(&out, "--- a/%s\n+++ b/%s\n", name, name)
+		}
+		fmt.Fprintf(&out, "@@ -%s +%s @@\n", hunkRange(startA, i-startA), hunkRange(startB, j-startB))
+		for _, line := range a[startA:i] {
+			out.WriteString("-" + line + "\n")
+		}
+		for _, line := range b[startB:j] {
+			out.WriteString("+" + line + "\n")
+		}
+	}
+	return out.String()
+}
+
+// hunkRange formats the lines of a hunk, the line before it if it is empty.
+func hunkRange(start, count int) string {
+	if count == 0 {
+		return fmt.Sprintf("%d,0", start)
+	}
+	if count == 1 {
+		return fmt.Sprintf("%d", start+1)
+	}
+	return fmt.Sprintf("%d,%d", start+1, count)
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
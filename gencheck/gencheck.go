@@ -0,0 +1,121 @@
+// Package gencheck catches drift between the datasets of a module and the code generated from
+// them. Verify regenerates the code of a copy of the module and reports the generated files
+// that differ from the committed ones, so a fork can assert in its own test suite that
+// `go generate` was run after editing a dataset:
+//
+//	func TestGeneratedCodeIsUpToDate(t *testing.T) {
+//		gencheck.AssertUpToDate(t, ".")
+//	}
+//
+// The generators of this repository also take a -verify flag, reporting an outdated file
+// instead of rewriting it.
+package gencheck
+
+import (
+	"bytes"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// Drift is a file go generate changes.
+type Drift struct {
+	// Path is relative to the module root.
+	Path string
+	// Diff is the unified diff from the committed file to the generated one.
+	Diff string
+}
+
+// Verify copies the module rooted at dir to a temporary directory, runs go generate ./... in
+// the copy and returns the files it created or changed, in lexical order. The module itself is
+// left untouched.
+func Verify(dir string) ([]Drift, error) {
+	tmp, err := os.MkdirTemp("", "gencheck-*")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tmp)
+
+	if err := copyTree(dir, tmp); err != nil {
+		return nil, fmt.Errorf("failed to copy %s: %w", dir, err)
+	}
+	cmd := exec.Command("go", "generate", "./...")
+	cmd.Dir = tmp
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return nil, fmt.Errorf("go generate failed: %w\n%s", err, output)
+	}
+
+	var drifts []Drift
+	err = filepath.WalkDir(tmp, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(tmp, path)
+		if err != nil {
+			return err
+		}
+		generated, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		committed, err := os.ReadFile(filepath.Join(dir, rel))
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		if !bytes.Equal(committed, generated) {
+			rel = filepath.ToSlash(rel)
+			drifts = append(drifts, Drift{Path: rel, Diff: Diff(rel, committed, generated)})
+		}
+		return nil
+	})
+	return drifts, err
+}
+
+// AssertUpToDate fails the test with the diff of every file go generate changes in the module
+// rooted at dir, see Verify.
+func AssertUpToDate(t testing.TB, dir string) {
+	t.Helper()
+	drifts, err := Verify(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, drift := range drifts {
+		t.Errorf("%s is outdated, run go generate:\n%s", drift.Path, drift.Diff)
+	}
+}
+
+// copyTree copies the regular files under src to dst, skipping version control directories.
+func copyTree(src, dst string) error {
+	return filepath.WalkDir(src, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+		if entry.IsDir() {
+			if rel != "." && strings.HasPrefix(entry.Name(), ".") {
+				return filepath.SkipDir
+			}
+			return os.MkdirAll(target, 0o755)
+		}
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		return os.WriteFile(target, content, info.Mode().Perm())
+	})
+}
@@ -0,0 +1,63 @@
+package gencheck
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerify(t *testing.T) {
+	drifts, err := Verify("testdata/module")
+	require.NoError(t, err)
+	assert.Empty(t, drifts)
+
+	dir := t.TempDir()
+	require.NoError(t, copyTree("testdata/module", dir))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "names.txt"), []byte("alpha\ngamma\ndelta\n"), 0o644))
+
+	drifts, err = Verify(dir)
+	require.NoError(t, err)
+	require.Len(t, drifts, 1)
+	assert.Equal(t, "generated_names.go", drifts[0].Path)
+	assert.Equal(t, `--- a/generated_names.go
++++ b/generated_names.go
+@@ -6 +6,2 @@
+-	"beta",
++	"gamma",
++	"delta",
+`, drifts[0].Diff)
+
+	committed, err := os.ReadFile(filepath.Join(dir, "generated_names.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(committed), `"beta"`, "the module is left untouched")
+}
+
+func TestVerifyFailsIfGenerateFails(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, copyTree("testdata/module", dir))
+	require.NoError(t, os.Remove(filepath.Join(dir, "names.txt")))
+
+	_, err := Verify(dir)
+	require.ErrorContains(t, err, "go generate failed")
+}
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		name          string
+		before, after string
+		expected      string
+	}{
+		{name: "equal", before: "a\nb\n", after: "a\nb\n", expected: ""},
+		{name: "new file", before: "", after: "a\nb\n", expected: "--- a/f\n+++ b/f\n@@ -0,0 +1,2 @@\n+a\n+b\n"},
+		{name: "removed line", before: "a\nb\nc\n", after: "a\nc\n", expected: "--- a/f\n+++ b/f\n@@ -2 +1,0 @@\n-b\n"},
+		{name: "two hunks", before: "a\nb\nc\nd\n", after: "x\nb\nc\ny\n", expected: "--- a/f\n+++ b/f\n@@ -1 +1 @@\n-a\n+x\n@@ -4 +4 @@\n-d\n+y\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Diff("f", []byte(tt.before), []byte(tt.after)))
+		})
+	}
+}
@@ -0,0 +1,25 @@
+//go:build ignore
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+func main() {
+	names, err := os.ReadFile("names.txt")
+	if err != nil {
+		panic(err)
+	}
+	var src strings.Builder
+	src.WriteString("// Code generated by go generate please DO NOT EDIT\npackage generated\n\nvar Names = []string{\n")
+	for _, name := range strings.Fields(string(names)) {
+		fmt.Fprintf(&src, "\t%q,\n", name)
+	}
+	src.WriteString("}\n")
+	if err := os.WriteFile("generated_names.go", []byte(src.String()), 0644); err != nil {
+		panic(err)
+	}
+}
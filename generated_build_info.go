@@ -0,0 +1,18 @@
+// Code generated by go generate please DO NOT EDIT
+package chain_selectors
+
+var generatedBuildInfo = generatedStamp{
+	GeneratedAt:      "2026-10-17T04:44:57Z",
+	GeneratorVersion: "b536cade98f187a6",
+	DatasetFiles: map[string]string{
+		"aptos/selectors_aptos.yml":        "6012a4e239b52c5406777867a3f1aea805eb757f",
+		"evm/selectors.yml":                "d6b6d2f94073a70150110ed358b35aa9d5f8068c",
+		"evm/selectors_forks.yml":          "67c7830d82f81d691013976b8c2707ccd58bc08a",
+		"evm/test_selectors.yml":           "835508d3971fb78132c5af905c06d3041cac62f8",
+		"solana/selectors_solana.yml":      "b9fcb8b39afe8e2f9d9b24e33e84238099f7ce25",
+		"solana/test_selectors_solana.yml": "822028d74b191fcaf3de1435d43f521ea0d191cf",
+		"sui/selectors_sui.yml":            "454062ee229dd5684cc4881753a8c063050a7876",
+		"ton/selectors_ton.yml":            "9cb2d80e75427fbda0bf804a5d9ea3603d96df6c",
+		"tron/selectors_tron.yml":          "90e672385ee1fa9d6e91deacb42e70e69340e555",
+	},
+}
@@ -0,0 +1,167 @@
+package chain_selectors
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+)
+
+// GraphFormat is an encoding of the graph written by ExportGraph.
+type GraphFormat string
+
+const (
+	// GraphDOT is the Graphviz DOT language.
+	GraphDOT GraphFormat = "dot"
+	// GraphJSON is a {"nodes": [...], "edges": [...]} document, see GraphNode and GraphEdge.
+	GraphJSON GraphFormat = "json"
+)
+
+// GraphNodeKind is the kind of a node of the relationship graph.
+type GraphNodeKind string
+
+const (
+	GraphNodeChain GraphNodeKind = "chain"
+	GraphNodeGroup GraphNodeKind = "group"
+)
+
+// GraphEdgeKind is the relationship an edge of the graph stands for.
+type GraphEdgeKind string
+
+const (
+	// GraphEdgeLane goes from the source to the destination chain of a lane of lanes.yml.
+	GraphEdgeLane GraphEdgeKind = "lane"
+	// GraphEdgeMember goes from a chain to a group of groups.yml it belongs to.
+	GraphEdgeMember GraphEdgeKind = "member"
+	// GraphEdgeForkOf goes from a fork of selectors_forks.yml to the chain whose chain id it reuses.
+	GraphEdgeForkOf GraphEdgeKind = "fork_of"
+)
+
+// GraphNode is a chain, identified by its selector, or a group, identified by "group:" and its name.
+type GraphNode struct {
+	ID    string        `json:"id"`
+	Kind  GraphNodeKind `json:"kind"`
+	Label string        `json:"label"`
+}
+
+// GraphEdge is a relationship between two nodes. Enabled and RateLimitClass are only set for lanes.
+type GraphEdge struct {
+	From           string         `json:"from"`
+	To             string         `json:"to"`
+	Kind           GraphEdgeKind  `json:"kind"`
+	Enabled        *bool          `json:"enabled,omitempty"`
+	RateLimitClass RateLimitClass `json:"rate_limit_class,omitempty"`
+}
+
+// Graph holds the relationships between the chains of the package.
+type Graph struct {
+	Nodes []GraphNode `json:"nodes"`
+	Edges []GraphEdge `json:"edges"`
+}
+
+// RelationshipGraph returns the lanes, group memberships and forks of the embedded datasets as
+// a graph, nodes sorted by id and edges by kind, then source and destination.
+func RelationshipGraph() Graph {
+	var g Graph
+	nodes := make(map[string]GraphNode)
+	addChain := func(selector uint64) string {
+		id := strconv.FormatUint(selector, 10)
+		if _, exists := nodes[id]; !exists {
+			label := id
+			if info, err := getChainInfo(selector); err == nil && info.ChainDetails.ChainName != "" {
+				label = info.ChainDetails.ChainName
+			}
+			nodes[id] = GraphNode{ID: id, Kind: GraphNodeChain, Label: label}
+		}
+		return id
+	}
+
+	for _, lane := range Lanes().Lanes() {
+		enabled := lane.Enabled
+		g.Edges = append(g.Edges, GraphEdge{
+			From:           addChain(lane.Source),
+			To:             addChain(lane.Dest),
+			Kind:           GraphEdgeLane,
+			Enabled:        &enabled,
+			RateLimitClass: lane.RateLimitClass,
+		})
+	}
+	for name, group := range chainGroups() {
+		id := "group:" + name
+		nodes[id] = GraphNode{ID: id, Kind: GraphNodeGroup, Label: name}
+		for _, selector := range group.Selectors {
+			g.Edges = append(g.Edges, GraphEdge{From: addChain(selector), To: id, Kind: GraphEdgeMember})
+		}
+	}
+	for chainID, forks := range evmForksByChainId() {
+		for _, fork := range forks {
+			g.Edges = append(g.Edges, GraphEdge{
+				From: addChain(fork.Selector),
+				To:   addChain(evmChainIdToChainSelector()[chainID].ChainSelector),
+				Kind: GraphEdgeForkOf,
+			})
+		}
+	}
+
+	for _, node := range nodes {
+		g.Nodes = append(g.Nodes, node)
+	}
+	sort.Slice(g.Nodes, func(i, j int) bool { return g.Nodes[i].ID < g.Nodes[j].ID })
+	sort.Slice(g.Edges, func(i, j int) bool {
+		a, b := g.Edges[i], g.Edges[j]
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		if a.From != b.From {
+			return a.From < b.From
+		}
+		return a.To < b.To
+	})
+	return g
+}
+
+// ExportGraph writes the RelationshipGraph to w in the format, for visualization tooling, e.g.
+// piping GraphDOT into `dot -Tsvg`.
+func ExportGraph(w io.Writer, format GraphFormat) error {
+	g := RelationshipGraph()
+	switch format {
+	case GraphJSON:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(g)
+	case GraphDOT:
+		return writeDOT(w, g)
+	default:
+		return fmt.Errorf("unknown graph format %q", format)
+	}
+}
+
+func writeDOT(w io.Writer, g Graph) error {
+	if _, err := fmt.Fprintln(w, "digraph chains {"); err != nil {
+		return err
+	}
+	for _, node := range g.Nodes {
+		shape := "ellipse"
+		if node.Kind == GraphNodeGroup {
+			shape = "box"
+		}
+		if _, err := fmt.Fprintf(w, "  %q [label=%q, shape=%s];\n", node.ID, node.Label, shape); err != nil {
+			return err
+		}
+	}
+	for _, edge := range g.Edges {
+		attrs := fmt.Sprintf("label=%q", edge.Kind)
+		switch {
+		case edge.Kind == GraphEdgeLane && edge.Enabled != nil && !*edge.Enabled:
+			attrs += ", style=dashed"
+		case edge.Kind != GraphEdgeLane:
+			attrs += ", style=dotted"
+		}
+		if _, err := fmt.Fprintf(w, "  %q -> %q [%s];\n", edge.From, edge.To, attrs); err != nil {
+			return err
+		}
+	}
+	_, err := fmt.Fprintln(w, "}")
+	return err
+}
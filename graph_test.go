@@ -0,0 +1,56 @@
+package chain_selectors
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRelationshipGraph(t *testing.T) {
+	g := RelationshipGraph()
+
+	nodes := make(map[string]GraphNode, len(g.Nodes))
+	for _, node := range g.Nodes {
+		nodes[node.ID] = node
+	}
+	var lanes, members int
+	for _, edge := range g.Edges {
+		require.Contains(t, nodes, edge.From)
+		require.Contains(t, nodes, edge.To)
+		switch edge.Kind {
+		case GraphEdgeLane:
+			lanes++
+			require.NotNil(t, edge.Enabled)
+		case GraphEdgeMember:
+			members++
+			assert.Equal(t, GraphNodeGroup, nodes[edge.To].Kind)
+		}
+	}
+	assert.Equal(t, len(Lanes().Lanes()), lanes)
+	assert.Positive(t, members)
+
+	ethereum := nodes[strconv.FormatUint(ETHEREUM_MAINNET.Selector, 10)]
+	assert.Equal(t, GraphNode{ID: strconv.FormatUint(ETHEREUM_MAINNET.Selector, 10), Kind: GraphNodeChain, Label: ETHEREUM_MAINNET.Name}, ethereum)
+}
+
+func TestExportGraph(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, ExportGraph(&buf, GraphJSON))
+	var g Graph
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &g))
+	assert.Equal(t, RelationshipGraph(), g)
+
+	buf.Reset()
+	require.NoError(t, ExportGraph(&buf, GraphDOT))
+	dot := buf.String()
+	assert.True(t, strings.HasPrefix(dot, "digraph chains {\n"))
+	assert.Contains(t, dot, `"group:op-superchain" [label="op-superchain", shape=box];`)
+	assert.Contains(t, dot, `[label="lane"`)
+
+	require.Error(t, ExportGraph(&buf, "svg"))
+}
@@ -0,0 +1,84 @@
+package chain_selectors
+
+import (
+	_ "embed"
+	"fmt"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+)
+
+//go:embed groups.yml
+var groupsYml []byte
+
+// ChainGroup is a named set of chains that tooling can target as a whole.
+type ChainGroup struct {
+	Name        string   `yaml:"-"`
+	Description string   `yaml:"description"`
+	Selectors   []uint64 `yaml:"selectors"`
+}
+
+var (
+	chainGroups      = onceValue(func() map[string]ChainGroup { return parseGroupsYml(groupsYml) })
+	groupsBySelector = onceValue(func() map[uint64][]string { return loadGroupsBySelector(chainGroups()) })
+)
+
+func parseGroupsYml(ymlFile []byte) map[string]ChainGroup {
+	type ymlData struct {
+		Groups map[string]ChainGroup `yaml:"groups"`
+	}
+
+	var data ymlData
+	err := yaml.Unmarshal(ymlFile, &data)
+	if err != nil {
+		panic(err)
+	}
+
+	for name, group := range data.Groups {
+		group.Name = name
+		data.Groups[name] = group
+	}
+	return data.Groups
+}
+
+func loadGroupsBySelector(groups map[string]ChainGroup) map[uint64][]string {
+	output := make(map[uint64][]string)
+	for name, group := range groups {
+		for _, selector := range group.Selectors {
+			output[selector] = append(output[selector], name)
+		}
+	}
+	for _, names := range output {
+		sort.Strings(names)
+	}
+	return output
+}
+
+// GroupNames returns the sorted names of all defined chain groups.
+func GroupNames() []string {
+	names := make([]string, 0, len(chainGroups()))
+	for name := range chainGroups() {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// GroupMembers returns the selectors of the chains belonging to the named group.
+func GroupMembers(name string) ([]uint64, error) {
+	group, exist := chainGroups()[name]
+	if !exist {
+		return nil, fmt.Errorf("chain group %s not found", name)
+	}
+	members := make([]uint64, len(group.Selectors))
+	copy(members, group.Selectors)
+	return members, nil
+}
+
+// GroupsOf returns the sorted names of the groups the selector belongs to.
+func GroupsOf(selector uint64) []string {
+	names := groupsBySelector()[selector]
+	output := make([]string, len(names))
+	copy(output, names)
+	return output
+}
@@ -0,0 +1,47 @@
+package chain_selectors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupMembersAreKnownChains(t *testing.T) {
+	for _, name := range GroupNames() {
+		members, err := GroupMembers(name)
+		require.NoError(t, err)
+		require.NotEmpty(t, members, "group %s should not be empty", name)
+
+		seen := make(map[uint64]struct{})
+		for _, selector := range members {
+			_, err := getChainInfo(selector)
+			assert.NoError(t, err, "group %s references unknown selector %d", name, selector)
+
+			_, duplicated := seen[selector]
+			assert.False(t, duplicated, "group %s lists selector %d more than once", name, selector)
+			seen[selector] = struct{}{}
+		}
+	}
+}
+
+func Test_GroupMembers(t *testing.T) {
+	members, err := GroupMembers("op-superchain")
+	require.NoError(t, err)
+	assert.Contains(t, members, ETHEREUM_MAINNET_OPTIMISM_1.Selector)
+	assert.NotContains(t, members, ETHEREUM_MAINNET.Selector)
+
+	members[0] = 0
+	again, err := GroupMembers("op-superchain")
+	require.NoError(t, err)
+	assert.NotEqual(t, uint64(0), again[0])
+
+	_, err = GroupMembers("non-existing")
+	require.Error(t, err)
+}
+
+func Test_GroupsOf(t *testing.T) {
+	assert.Equal(t, []string{"ethereum-l2s", "op-superchain"}, GroupsOf(ETHEREUM_MAINNET_BASE_1.Selector))
+	assert.Equal(t, []string{"ethereum-l2s"}, GroupsOf(ETHEREUM_MAINNET_ARBITRUM_1.Selector))
+	assert.Empty(t, GroupsOf(ETHEREUM_MAINNET.Selector))
+}
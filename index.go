@@ -0,0 +1,140 @@
+package chain_selectors
+
+import (
+	"sort"
+	"sync"
+)
+
+// lookupIndexes holds the cross family indexes derived from the embedded datasets, for the
+// lookups scanning every chain. They are built on first use rather than during package
+// initialisation, see WarmUp.
+type lookupIndexes struct {
+	infoBySelector    map[uint64]chainInfo
+	selectorsByFamily map[string][]uint64
+}
+
+var (
+	lookupIndexOnce sync.Once
+	lookupIndexData *lookupIndexes
+)
+
+// lookupIndex returns the indexes, building them on the first call. It parses every family,
+// lookups of a single chain go through familyIndex instead.
+func lookupIndex() *lookupIndexes {
+	lookupIndexOnce.Do(func() {
+		lookupIndexData = buildLookupIndexes()
+	})
+	return lookupIndexData
+}
+
+func buildLookupIndexes() *lookupIndexes {
+	idx := &lookupIndexes{
+		infoBySelector:    make(map[uint64]chainInfo),
+		selectorsByFamily: make(map[string][]uint64, len(allFamilies)),
+	}
+	for _, family := range allFamilies {
+		familyIdx := familyIndex(family)
+		idx.selectorsByFamily[family] = familyIdx.selectors
+		for selector, info := range familyIdx.infoBySelector {
+			idx.infoBySelector[selector] = info
+		}
+	}
+	return idx
+}
+
+// familyIndexes holds the indexes of the chains of a single family.
+type familyIndexes struct {
+	selectors      []uint64
+	infoBySelector map[uint64]chainInfo
+}
+
+// familyIndexBuilders builds the indexes of each family on its first use, so that a lookup
+// only parses the datasets of the families it goes through. It is set by init as building the
+// indexes refers back to it.
+var familyIndexBuilders map[string]func() *familyIndexes
+
+func init() {
+	familyIndexBuilders = make(map[string]func() *familyIndexes, len(allFamilies))
+	for _, family := range allFamilies {
+		family := family
+		familyIndexBuilders[family] = onceValue(func() *familyIndexes { return buildFamilyIndexes(family) })
+	}
+}
+
+// familyIndex returns the indexes of the family, empty for an unknown family.
+func familyIndex(family string) *familyIndexes {
+	build, exists := familyIndexBuilders[family]
+	if !exists {
+		return &familyIndexes{}
+	}
+	return build()
+}
+
+func buildFamilyIndexes(family string) *familyIndexes {
+	selectors := familySelectors(family)
+	sort.Slice(selectors, func(i, j int) bool { return selectors[i] < selectors[j] })
+	idx := &familyIndexes{
+		selectors:      selectors,
+		infoBySelector: make(map[uint64]chainInfo, len(selectors)),
+	}
+	for _, selector := range selectors {
+		info, err := resolveChainInfo(selector)
+		if err != nil || info.Family != family {
+			continue
+		}
+		idx.infoBySelector[selector] = info
+	}
+	return idx
+}
+
+// indexedChainInfo looks the selector up in the indexes of one family after another, stopping
+// at the family defining it. Generated custom chains are not indexed.
+func indexedChainInfo(selector uint64) (chainInfo, bool) {
+	for _, family := range allFamilies {
+		if info, exists := familyIndex(family).infoBySelector[selector]; exists {
+			return info, true
+		}
+	}
+	return chainInfo{}, false
+}
+
+// familySelectors returns the unsorted selectors of every chain defined for the given family.
+func familySelectors(family string) []uint64 {
+	var selectors []uint64
+	switch family {
+	case FamilyEVM:
+		for sel := range evmChainsBySelector() {
+			selectors = append(selectors, sel)
+		}
+	case FamilySolana:
+		for sel := range solanaChainsBySelector() {
+			selectors = append(selectors, sel)
+		}
+	case FamilyAptos:
+		for sel := range aptosChainsBySelector() {
+			selectors = append(selectors, sel)
+		}
+	case FamilySui:
+		for sel := range suiChainsBySelector() {
+			selectors = append(selectors, sel)
+		}
+	case FamilyTron:
+		for sel := range tronChainIdBySelector() {
+			selectors = append(selectors, sel)
+		}
+	case FamilyTon:
+		for sel := range tonChainIdBySelector() {
+			selectors = append(selectors, sel)
+		}
+	}
+	return selectors
+}
+
+// WarmUp builds the lookup indexes eagerly, along with the embedded datasets when the package
+// is built with the chainsel_lazy tag. They are otherwise built by the first lookup that needs
+// them, latency sensitive services should call WarmUp during startup so no request pays for
+// it. Calling WarmUp more than once is cheap.
+func WarmUp() {
+	loadEmbeddedDatasets()
+	lookupIndex()
+}
@@ -0,0 +1,217 @@
+// Package apicompat extracts the exported API of a Go package as one line per symbol, so it can
+// be compared with the API of another version of the package.
+package apicompat
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"io"
+	"os"
+	"sort"
+	"strings"
+)
+
+// Extract returns the sorted exported API of the package in dir, ignoring test files and
+// files excluded by the ignore build tag. Parameter names are left out of signatures since
+// renaming a parameter is compatible.
+func Extract(dir string) ([]string, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(info os.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]struct{})
+	for _, pkg := range pkgs {
+		if pkg.Name == "main" {
+			continue
+		}
+		for _, file := range pkg.Files {
+			if ignored(file) {
+				continue
+			}
+			for _, decl := range file.Decls {
+				for _, line := range declLines(fset, decl) {
+					seen[line] = struct{}{}
+				}
+			}
+		}
+	}
+
+	api := make([]string, 0, len(seen))
+	for line := range seen {
+		api = append(api, line)
+	}
+	sort.Strings(api)
+	return api, nil
+}
+
+// ignored reports whether the file is excluded from builds with the ignore build tag, like the
+// generators.
+func ignored(file *ast.File) bool {
+	for _, group := range file.Comments {
+		if group.Pos() > file.Package {
+			return false
+		}
+		for _, comment := range group.List {
+			if strings.HasPrefix(comment.Text, "//go:build") && strings.Contains(comment.Text, "ignore") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func declLines(fset *token.FileSet, decl ast.Decl) []string {
+	switch d := decl.(type) {
+	case *ast.FuncDecl:
+		if !d.Name.IsExported() {
+			return nil
+		}
+		if d.Recv == nil {
+			return []string{"func " + d.Name.Name + signature(fset, d.Type)}
+		}
+		recv := typeString(fset, d.Recv.List[0].Type)
+		if !ast.IsExported(strings.TrimLeft(recv, "*")) {
+			return nil
+		}
+		return []string{"method (" + recv + ") " + d.Name.Name + signature(fset, d.Type)}
+	case *ast.GenDecl:
+		var lines []string
+		for _, spec := range d.Specs {
+			switch s := spec.(type) {
+			case *ast.TypeSpec:
+				lines = append(lines, typeLines(fset, s)...)
+			case *ast.ValueSpec:
+				for _, name := range s.Names {
+					if name.IsExported() {
+						lines = append(lines, d.Tok.String()+" "+name.Name)
+					}
+				}
+			}
+		}
+		return lines
+	}
+	return nil
+}
+
+func typeLines(fset *token.FileSet, spec *ast.TypeSpec) []string {
+	if !spec.Name.IsExported() {
+		return nil
+	}
+	name := spec.Name.Name
+	switch t := spec.Type.(type) {
+	case *ast.StructType:
+		lines := []string{"type " + name + " struct"}
+		for _, field := range t.Fields.List {
+			for _, fieldName := range field.Names {
+				if fieldName.IsExported() {
+					lines = append(lines, "field "+name+"."+fieldName.Name+" "+typeString(fset, field.Type))
+				}
+			}
+		}
+		return lines
+	case *ast.InterfaceType:
+		lines := []string{"type " + name + " interface"}
+		for _, method := range t.Methods.List {
+			for _, methodName := range method.Names {
+				lines = append(lines, "method ("+name+") "+methodName.Name+signature(fset, method.Type.(*ast.FuncType)))
+			}
+		}
+		return lines
+	default:
+		if spec.Assign.IsValid() {
+			return []string{"type " + name + " = " + typeString(fset, spec.Type)}
+		}
+		return []string{"type " + name + " " + typeString(fset, spec.Type)}
+	}
+}
+
+// signature renders the parameters and results of the function without their names.
+func signature(fset *token.FileSet, fn *ast.FuncType) string {
+	params := fieldTypes(fset, fn.Params)
+	results := fieldTypes(fset, fn.Results)
+
+	s := "(" + strings.Join(params, ", ") + ")"
+	switch len(results) {
+	case 0:
+		return s
+	case 1:
+		return s + " " + results[0]
+	default:
+		return s + " (" + strings.Join(results, ", ") + ")"
+	}
+}
+
+func fieldTypes(fset *token.FileSet, fields *ast.FieldList) []string {
+	if fields == nil {
+		return nil
+	}
+	var types []string
+	for _, field := range fields.List {
+		t := typeString(fset, field.Type)
+		for i := 0; i < len(field.Names) || (i == 0 && len(field.Names) == 0); i++ {
+			types = append(types, t)
+		}
+	}
+	return types
+}
+
+func typeString(fset *token.FileSet, expr ast.Expr) string {
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, fset, expr); err != nil {
+		panic(err)
+	}
+	return buf.String()
+}
+
+// Missing returns the lines of the pinned API that are not part of the current API.
+func Missing(pinned, current []string) []string {
+	present := make(map[string]struct{}, len(current))
+	for _, line := range current {
+		present[line] = struct{}{}
+	}
+	var missing []string
+	for _, line := range pinned {
+		if _, exists := present[line]; !exists {
+			missing = append(missing, line)
+		}
+	}
+	return missing
+}
+
+// Read reads an API written by Write, skipping empty lines and # comments.
+func Read(r io.Reader) ([]string, error) {
+	var api []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		api = append(api, line)
+	}
+	return api, scanner.Err()
+}
+
+// Write writes the API one line per symbol after the header comment.
+func Write(w io.Writer, header string, api []string) error {
+	for _, line := range strings.Split(header, "\n") {
+		if _, err := fmt.Fprintf(w, "# %s\n", line); err != nil {
+			return err
+		}
+	}
+	for _, line := range api {
+		if _, err := fmt.Fprintln(w, line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package apicompat
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const source = `package sample
+
+type Chain struct {
+	ID, Selector uint64
+	name         string
+}
+
+type Resolver interface {
+	Resolve(selector uint64) (Chain, error)
+}
+
+type Family = string
+
+const Mainnet, testnet = 1, 2
+
+func (c Chain) Name() string { return c.name }
+
+func Lookup(a, b uint64, opts ...string) (Chain, error) { return Chain{}, nil }
+
+func lookup() {}
+`
+
+func TestExtract(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "sample.go"), []byte(source), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "gen.go"), []byte("//go:build ignore\n\npackage main\n\nfunc Main() {}\n"), 0o644))
+
+	api, err := Extract(dir)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"const Mainnet",
+		"field Chain.ID uint64",
+		"field Chain.Selector uint64",
+		"func Lookup(uint64, uint64, ...string) (Chain, error)",
+		"method (Chain) Name() string",
+		"method (Resolver) Resolve(uint64) (Chain, error)",
+		"type Chain struct",
+		"type Family = string",
+		"type Resolver interface",
+	}, api)
+
+	assert.Equal(t, []string{"const Removed"}, Missing(append(api, "const Removed"), api))
+}
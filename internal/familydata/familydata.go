@@ -0,0 +1,92 @@
+// Package familydata parses and indexes the selectors file of a chain family, for the family
+// subpackages which cannot import the root package.
+package familydata
+
+import (
+	"sort"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ChainID is the type of the chain ids of a family.
+type ChainID interface {
+	~int32 | ~uint64 | ~string
+}
+
+// Chain is a chain of a selectors file.
+type Chain[K ChainID] struct {
+	ChainID  K
+	Selector uint64
+	Name     string
+	// Test marks the chains of the test selectors file of the family.
+	Test bool
+}
+
+// Index holds the chains of a family sorted by chain id, and indexes them.
+type Index[K ChainID] struct {
+	Chains     []Chain[K]
+	BySelector map[uint64]Chain[K]
+	ByChainID  map[K]Chain[K]
+	ByName     map[string]Chain[K]
+}
+
+// Lazy returns a function parsing the selectors file and test selectors file, nil if the family
+// has none, on its first call and returning their index from then on. It panics if a file is
+// invalid.
+func Lazy[K ChainID](selectorsYml, testSelectorsYml []byte) func() *Index[K] {
+	var (
+		once   sync.Once
+		index  *Index[K]
+		failed any
+	)
+	return func() *Index[K] {
+		once.Do(func() {
+			defer func() {
+				if index == nil {
+					failed = recover()
+				}
+			}()
+			index = build[K](selectorsYml, testSelectorsYml)
+		})
+		if index == nil {
+			// a previous call panicked parsing the files, panic again rather than returning nil
+			panic(failed)
+		}
+		return index
+	}
+}
+
+func build[K ChainID](selectorsYml, testSelectorsYml []byte) *Index[K] {
+	index := &Index[K]{
+		BySelector: make(map[uint64]Chain[K]),
+		ByChainID:  make(map[K]Chain[K]),
+		ByName:     make(map[string]Chain[K]),
+	}
+	for _, file := range []struct {
+		content []byte
+		test    bool
+	}{{selectorsYml, false}, {testSelectorsYml, true}} {
+		var data struct {
+			Selectors map[K]struct {
+				Selector uint64 `yaml:"selector"`
+				Name     string `yaml:"name"`
+			} `yaml:"selectors"`
+		}
+		if err := yaml.Unmarshal(file.content, &data); err != nil {
+			panic(err)
+		}
+		for chainID, details := range data.Selectors {
+			index.Chains = append(index.Chains, Chain[K]{ChainID: chainID, Selector: details.Selector, Name: details.Name, Test: file.test})
+		}
+	}
+	sort.Slice(index.Chains, func(i, j int) bool { return index.Chains[i].ChainID < index.Chains[j].ChainID })
+	for _, ch := range index.Chains {
+		index.BySelector[ch.Selector] = ch
+		index.ByChainID[ch.ChainID] = ch
+		if ch.Name != "" {
+			index.ByName[ch.Name] = ch
+		}
+	}
+	return index
+}
@@ -0,0 +1,21 @@
+package familydata
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLazy(t *testing.T) {
+	index := Lazy[uint64]([]byte("selectors:\n  1:\n    selector: 11\n    name: one\n"), []byte("selectors:\n  2:\n    selector: 22\n"))()
+	require.Len(t, index.Chains, 2)
+	assert.Equal(t, Chain[uint64]{ChainID: 1, Selector: 11, Name: "one"}, index.ByName["one"])
+	assert.True(t, index.BySelector[22].Test)
+}
+
+func TestLazyRepeatsPanics(t *testing.T) {
+	index := Lazy[uint64]([]byte("selectors: [invalid"), nil)
+	assert.Panics(t, func() { index() })
+	assert.Panics(t, func() { index() }, "later calls do not return nil")
+}
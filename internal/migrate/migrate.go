@@ -0,0 +1,207 @@
+// Package migrate rewrites the uses of deprecated functions of the selectors package in Go
+// source files.
+package migrate
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// selectorsPackage is the import path of the package whose deprecated functions are migrated.
+const selectorsPackage = "github.com/fravlaca/chain-selectors"
+
+// Rule migrates the calls of a deprecated function. Calls are rewritten to Replacement when it
+// is set, which requires the replacement to have the same signature, or to Template, a Go
+// expression of the same type as the call in which {pkg} stands for the name the package is
+// imported under and {args} for the arguments of the call. Otherwise, and for uses of the
+// function other than calls, they are only reported with the Advice.
+type Rule struct {
+	Deprecated  string
+	Replacement string
+	Template    string
+	Advice      string
+}
+
+// Rules lists the deprecated functions and how their calls are migrated.
+var Rules = []Rule{
+	{
+		Deprecated:  "GetChainIDFromSelectorWithCustom",
+		Replacement: "GetChainIDFromSelector",
+	},
+	{
+		Deprecated:  "GetChainDetailsByChainIDAndFamilyWithCustom",
+		Replacement: "GetChainDetailsByChainIDAndFamily",
+	},
+	{
+		Deprecated: "ChainIdFromSelector",
+		Template:   "{pkg}.EVMChainIDOf({pkg}.Lookup({pkg}.Selector({args})))",
+		Advice:     "use EVMChainIDOf(Lookup(Selector(selector))), or GetChainIDFromSelector, which returns the chain id of any family as a string",
+	},
+	{
+		Deprecated: "SelectorFromChainId",
+		Template:   "{pkg}.SelectorOf({pkg}.Lookup({pkg}.EVMChainID({args})))",
+		Advice:     "use SelectorOf(Lookup(EVMChainID(chainID))), or GetChainDetailsByChainIDAndFamily(chainID, FamilyEVM)",
+	},
+}
+
+// Finding is a call of a deprecated function that could not be rewritten.
+type Finding struct {
+	Position token.Position
+	Rule     Rule
+}
+
+func (f Finding) String() string {
+	return fmt.Sprintf("%s: %s is deprecated, %s", f.Position, f.Rule.Deprecated, f.Rule.Advice)
+}
+
+// Result is the outcome of migrating a file.
+type Result struct {
+	// Source is the migrated file, equal to the input if nothing was rewritten.
+	Source   []byte
+	Rewrites int
+	Findings []Finding
+}
+
+// File migrates the source of the named file. Only the rewritten calls are replaced, the rest
+// of the file, including the arguments of the calls, is kept byte for byte.
+func File(filename string, src []byte) (Result, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filename, src, parser.SkipObjectResolution)
+	if err != nil {
+		return Result{}, err
+	}
+	result := Result{Source: src}
+
+	name := importName(file)
+	if name == "" {
+		return result, nil
+	}
+	rules := make(map[string]Rule, len(Rules))
+	for _, rule := range Rules {
+		rules[rule.Deprecated] = rule
+	}
+
+	type edit struct {
+		offset int
+		old    string
+		new    string
+	}
+	var edits []edit
+	offset := func(pos token.Pos) int { return fset.Position(pos).Offset }
+	deprecatedRule := func(expr ast.Expr) (Rule, bool) {
+		sel, ok := expr.(*ast.SelectorExpr)
+		if !ok {
+			return Rule{}, false
+		}
+		pkg, ok := sel.X.(*ast.Ident)
+		if !ok || pkg.Name != name {
+			return Rule{}, false
+		}
+		rule, deprecated := rules[sel.Sel.Name]
+		return rule, deprecated
+	}
+	ast.Inspect(file, func(n ast.Node) bool {
+		if call, ok := n.(*ast.CallExpr); ok {
+			rule, deprecated := deprecatedRule(call.Fun)
+			if !deprecated || rule.Template == "" || call.Ellipsis.IsValid() {
+				return true
+			}
+			// calls nested in the arguments are rewritten by the next pass
+			args := string(src[offset(call.Lparen)+1 : offset(call.Rparen)])
+			edits = append(edits, edit{
+				offset: offset(call.Pos()),
+				old:    string(src[offset(call.Pos()):offset(call.End())]),
+				new:    strings.NewReplacer("{pkg}", name, "{args}", args).Replace(rule.Template),
+			})
+			return false
+		}
+		sel, ok := n.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		rule, deprecated := deprecatedRule(sel)
+		if !deprecated {
+			return true
+		}
+		if rule.Replacement == "" {
+			result.Findings = append(result.Findings, Finding{Position: fset.Position(sel.Pos()), Rule: rule})
+			return true
+		}
+		edits = append(edits, edit{offset: offset(sel.Sel.Pos()), old: sel.Sel.Name, new: rule.Replacement})
+		return true
+	})
+	if len(edits) == 0 {
+		return result, nil
+	}
+
+	sort.Slice(edits, func(i, j int) bool { return edits[i].offset < edits[j].offset })
+	var out bytes.Buffer
+	last := 0
+	for _, e := range edits {
+		out.Write(src[last:e.offset])
+		out.WriteString(e.new)
+		last = e.offset + len(e.old)
+	}
+	out.Write(src[last:])
+
+	next, err := File(filename, out.Bytes())
+	if err != nil {
+		return Result{}, err
+	}
+	next.Rewrites += len(edits)
+	return next, nil
+}
+
+// importName returns the name the file imports the selectors package under, empty if it does
+// not import it or imports it for its side effects or into the file scope.
+func importName(file *ast.File) string {
+	for _, spec := range file.Imports {
+		path, err := strconv.Unquote(spec.Path.Value)
+		if err != nil || path != selectorsPackage {
+			continue
+		}
+		if spec.Name == nil {
+			return "chain_selectors"
+		}
+		if spec.Name.Name == "_" || spec.Name.Name == "." {
+			return ""
+		}
+		return spec.Name.Name
+	}
+	return ""
+}
+
+// Diff returns a unified diff of the lines changed between before and after, which must have
+// the same number of lines as File keeps the line breaks of the rewritten calls.
+func Diff(filename string, before, after []byte) string {
+	oldLines := strings.SplitAfter(string(before), "\n")
+	newLines := strings.SplitAfter(string(after), "\n")
+	if len(oldLines) != len(newLines) {
+		panic("migrate: diff of sources with a different number of lines")
+	}
+
+	var out strings.Builder
+	for i := range oldLines {
+		if oldLines[i] == newLines[i] {
+			continue
+		}
+		if out.Len() == 0 {
+			fmt.Fprintf(&out, "--- a/%s\n+++ b/%s\n", filename, filename)
+		}
+		fmt.Fprintf(&out, "@@ -%d +%d @@\n-%s+%s", i+1, i+1, withNewline(oldLines[i]), withNewline(newLines[i]))
+	}
+	return out.String()
+}
+
+func withNewline(line string) string {
+	if strings.HasSuffix(line, "\n") {
+		return line
+	}
+	return line + "\n"
+}
@@ -0,0 +1,143 @@
+package migrate
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const source = `package sample
+
+import (
+	cs "github.com/fravlaca/chain-selectors"
+)
+
+func chainID(selector uint64) (string, error) {
+	return cs.GetChainIDFromSelectorWithCustom(selector)
+}
+
+func details(id string) (cs.ChainDetails, error) {
+	d, err := cs.GetChainDetailsByChainIDAndFamilyWithCustom(id, cs.FamilyEVM)
+	if err != nil {
+		return cs.GetChainDetailsByChainIDAndFamilyWithCustom(id, cs.FamilySolana)
+	}
+	return d, nil
+}
+
+func legacy(selector uint64) (uint64, error) {
+	return cs.ChainIdFromSelector(selector)
+}
+
+func roundTrip(chainID uint64) (uint64, error) {
+	return cs.ChainIdFromSelector(mustSelector(cs.SelectorFromChainId(
+		chainID,
+	)))
+}
+
+var lookup = cs.SelectorFromChainId
+`
+
+const migrated = `package sample
+
+import (
+	cs "github.com/fravlaca/chain-selectors"
+)
+
+func chainID(selector uint64) (string, error) {
+	return cs.GetChainIDFromSelector(selector)
+}
+
+func details(id string) (cs.ChainDetails, error) {
+	d, err := cs.GetChainDetailsByChainIDAndFamily(id, cs.FamilyEVM)
+	if err != nil {
+		return cs.GetChainDetailsByChainIDAndFamily(id, cs.FamilySolana)
+	}
+	return d, nil
+}
+
+func legacy(selector uint64) (uint64, error) {
+	return cs.EVMChainIDOf(cs.Lookup(cs.Selector(selector)))
+}
+
+func roundTrip(chainID uint64) (uint64, error) {
+	return cs.EVMChainIDOf(cs.Lookup(cs.Selector(mustSelector(cs.SelectorOf(cs.Lookup(cs.EVMChainID(
+		chainID,
+	)))))))
+}
+
+var lookup = cs.SelectorFromChainId
+`
+
+func TestFile(t *testing.T) {
+	result, err := File("sample.go", []byte(source))
+	require.NoError(t, err)
+	assert.Equal(t, migrated, string(result.Source))
+	assert.Equal(t, 6, result.Rewrites)
+	require.Len(t, result.Findings, 1, "references other than calls are reported")
+	assert.Equal(t, "SelectorFromChainId", result.Findings[0].Rule.Deprecated)
+	assert.Equal(t, 29, result.Findings[0].Position.Line)
+
+	assert.Equal(t, `--- a/sample.go
++++ b/sample.go
+@@ -8 +8 @@
+-	return cs.GetChainIDFromSelectorWithCustom(selector)
++	return cs.GetChainIDFromSelector(selector)
+@@ -12 +12 @@
+-	d, err := cs.GetChainDetailsByChainIDAndFamilyWithCustom(id, cs.FamilyEVM)
++	d, err := cs.GetChainDetailsByChainIDAndFamily(id, cs.FamilyEVM)
+@@ -14 +14 @@
+-		return cs.GetChainDetailsByChainIDAndFamilyWithCustom(id, cs.FamilySolana)
++		return cs.GetChainDetailsByChainIDAndFamily(id, cs.FamilySolana)
+@@ -20 +20 @@
+-	return cs.ChainIdFromSelector(selector)
++	return cs.EVMChainIDOf(cs.Lookup(cs.Selector(selector)))
+@@ -24 +24 @@
+-	return cs.ChainIdFromSelector(mustSelector(cs.SelectorFromChainId(
++	return cs.EVMChainIDOf(cs.Lookup(cs.Selector(mustSelector(cs.SelectorOf(cs.Lookup(cs.EVMChainID(
+@@ -26 +26 @@
+-	)))
++	)))))))
+`, Diff("sample.go", []byte(source), result.Source))
+}
+
+func TestFileIgnoresOtherPackages(t *testing.T) {
+	src := `package sample
+
+import (
+	cs "example.com/other"
+)
+
+var _, _ = cs.GetChainIDFromSelectorWithCustom(1)
+`
+	result, err := File("sample.go", []byte(src))
+	require.NoError(t, err)
+	assert.Equal(t, src, string(result.Source))
+	assert.Zero(t, result.Rewrites)
+	assert.Empty(t, result.Findings)
+
+	_, err = File("broken.go", []byte("package"))
+	require.Error(t, err)
+}
+
+// TestRulesCoverDeprecatedFunctions checks a rule migrates every function excluded by the
+// chainsel_strict_api build tag.
+func TestRulesCoverDeprecatedFunctions(t *testing.T) {
+	file, err := parser.ParseFile(token.NewFileSet(), "../../deprecated.go", nil, parser.SkipObjectResolution)
+	require.NoError(t, err)
+
+	var deprecated []string
+	for _, decl := range file.Decls {
+		if fn, ok := decl.(*ast.FuncDecl); ok && fn.Name.IsExported() {
+			deprecated = append(deprecated, fn.Name.Name)
+		}
+	}
+	var migrated []string
+	for _, rule := range Rules {
+		migrated = append(migrated, rule.Deprecated)
+	}
+	assert.ElementsMatch(t, migrated, deprecated)
+}
@@ -0,0 +1,136 @@
+// Package site renders the chains of a registry as a static directory of JSON documents and
+// HTML pages, which can be published to object storage as a read-only chain directory.
+//
+// The directory holds:
+//
+//	index.json              every chain, see Index
+//	index.html              a searchable table of every chain
+//	chains/<selector>.json  the details of a chain, see Chain
+//	chains/<selector>.html  the details of a chain
+package site
+
+import (
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	chainselectors "github.com/fravlaca/chain-selectors"
+)
+
+// Index is the document written to index.json.
+type Index struct {
+	// Fingerprint is the fingerprint of the registry the directory was generated from.
+	Fingerprint string  `json:"fingerprint"`
+	Chains      []Chain `json:"chains"`
+}
+
+// Chain describes a chain. The selector is a string as selectors do not fit in the numbers of
+// JavaScript. Metadata is only written to the documents of the chains, not to the index.
+type Chain struct {
+	Selector    uint64    `json:"selector,string"`
+	Family      string    `json:"family"`
+	ChainID     string    `json:"chain_id"`
+	Name        string    `json:"name"`
+	DisplayName string    `json:"display_name"`
+	Environment string    `json:"environment"`
+	Tags        []string  `json:"tags,omitempty"`
+	Groups      []string  `json:"groups,omitempty"`
+	Metadata    *Metadata `json:"metadata,omitempty"`
+}
+
+// Metadata is the metadata of a chain, see chainselectors.ChainMetadata.
+type Metadata struct {
+	NativeCurrency *chainselectors.NativeCurrency `json:"native_currency,omitempty"`
+	Explorers      []string                       `json:"explorers,omitempty"`
+	Website        string                         `json:"website,omitempty"`
+	Docs           string                         `json:"docs,omitempty"`
+	Faucets        []string                       `json:"faucets,omitempty"`
+	LogoURI        string                         `json:"logo_uri,omitempty"`
+	BrandColor     string                         `json:"brand_color,omitempty"`
+}
+
+// Generate writes the directory of the chains the registry resolves to dir, creating it if
+// needed. Files of a previous generation are overwritten, files of chains no longer resolved
+// are left in place.
+func Generate(dir string, registry *chainselectors.Registry) error {
+	if err := os.MkdirAll(filepath.Join(dir, "chains"), 0o755); err != nil {
+		return err
+	}
+
+	index := Index{Fingerprint: registry.Fingerprint(), Chains: []Chain{}}
+	for _, ch := range registry.Chains() {
+		chain := newChain(ch)
+		index.Chains = append(index.Chains, chain)
+
+		if metadata, err := chainselectors.GetChainMetadata(ch.Selector); err == nil {
+			chain.Metadata = newMetadata(metadata)
+		}
+		base := filepath.Join(dir, "chains", strconv.FormatUint(ch.Selector, 10))
+		if err := writeJSON(base+".json", chain); err != nil {
+			return err
+		}
+		if err := writeHTML(base+".html", chainPage, chain); err != nil {
+			return err
+		}
+	}
+
+	if err := writeJSON(filepath.Join(dir, "index.json"), index); err != nil {
+		return err
+	}
+	return writeHTML(filepath.Join(dir, "index.html"), indexPage, index)
+}
+
+func newChain(ch chainselectors.RegistryChain) Chain {
+	displayName := ch.Name
+	if name, err := chainselectors.DisplayName(ch.Selector); err == nil && name != "" {
+		displayName = name
+	}
+	return Chain{
+		Selector:    ch.Selector,
+		Family:      ch.Family,
+		ChainID:     ch.ChainID,
+		Name:        ch.Name,
+		DisplayName: displayName,
+		Environment: ch.Environment,
+		Tags:        chainselectors.TagsOf(ch.Selector),
+		Groups:      chainselectors.GroupsOf(ch.Selector),
+	}
+}
+
+func newMetadata(metadata chainselectors.ChainMetadata) *Metadata {
+	m := &Metadata{
+		Explorers:  metadata.Explorers,
+		Website:    metadata.Website,
+		Docs:       metadata.Docs,
+		Faucets:    metadata.Faucets,
+		LogoURI:    metadata.LogoURI,
+		BrandColor: metadata.BrandColor,
+	}
+	if metadata.NativeCurrency != (chainselectors.NativeCurrency{}) {
+		m.NativeCurrency = &metadata.NativeCurrency
+	}
+	return m
+}
+
+func writeJSON(path string, document any) error {
+	content, err := json.MarshalIndent(document, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode %s: %w", path, err)
+	}
+	return os.WriteFile(path, append(content, '\n'), 0o644)
+}
+
+func writeHTML(path string, page *template.Template, data any) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := page.Execute(f, data); err != nil {
+		f.Close()
+		return fmt.Errorf("failed to render %s: %w", path, err)
+	}
+	return f.Close()
+}
@@ -0,0 +1,50 @@
+package site
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	chainselectors "github.com/fravlaca/chain-selectors"
+)
+
+func TestGenerate(t *testing.T) {
+	dir := t.TempDir()
+	registry := chainselectors.NewRegistry(chainselectors.WithTestChains(false))
+	require.NoError(t, registry.LoadYAML([]byte("selectors:\n  77001:\n    selector: 11\n    name: devnet-a\n")))
+	require.NoError(t, Generate(dir, registry))
+
+	content, err := os.ReadFile(filepath.Join(dir, "index.json"))
+	require.NoError(t, err)
+	var index Index
+	require.NoError(t, json.Unmarshal(content, &index))
+	assert.Equal(t, registry.Fingerprint(), index.Fingerprint)
+	require.Len(t, index.Chains, len(registry.Chains()))
+	for _, ch := range index.Chains {
+		assert.Nil(t, ch.Metadata, "the index does not hold metadata")
+	}
+
+	selector := strconv.FormatUint(chainselectors.ETHEREUM_MAINNET.Selector, 10)
+	content, err = os.ReadFile(filepath.Join(dir, "chains", selector+".json"))
+	require.NoError(t, err)
+	var chain Chain
+	require.NoError(t, json.Unmarshal(content, &chain))
+	assert.Equal(t, chainselectors.ETHEREUM_MAINNET.Name, chain.Name)
+	assert.Equal(t, "1", chain.ChainID)
+	require.NotNil(t, chain.Metadata)
+	assert.Equal(t, "ETH", chain.Metadata.NativeCurrency.Symbol)
+
+	page, err := os.ReadFile(filepath.Join(dir, "chains", selector+".html"))
+	require.NoError(t, err)
+	assert.Contains(t, string(page), "<code>"+selector+"</code>")
+
+	listing, err := os.ReadFile(filepath.Join(dir, "index.html"))
+	require.NoError(t, err)
+	assert.Contains(t, string(listing), `href="chains/11.html">devnet-a</a>`)
+	assert.FileExists(t, filepath.Join(dir, "chains", "11.json"))
+}
@@ -0,0 +1,99 @@
+package site
+
+import "html/template"
+
+var funcs = template.FuncMap{
+	// the logo and brand color were validated when the metadata was loaded, logos may be data
+	// URIs html/template would otherwise reject
+	"logo":  func(uri string) template.URL { return template.URL(uri) },
+	"color": func(color string) template.CSS { return template.CSS(color) },
+}
+
+var indexPage = template.Must(template.New("index").Funcs(funcs).Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>Chain directory</title>
+<style>
+body { font-family: sans-serif; margin: 2em; }
+table { border-collapse: collapse; width: 100%; }
+th, td { text-align: left; padding: 0.3em 0.6em; border-bottom: 1px solid #ddd; }
+input { font-size: 1em; padding: 0.3em; width: 30em; max-width: 100%; margin-bottom: 1em; }
+</style>
+</head>
+<body>
+<h1>Chain directory</h1>
+<p>{{len .Chains}} chains, registry fingerprint <code>{{.Fingerprint}}</code>, also available as <a href="index.json">JSON</a>.</p>
+<input id="search" type="search" placeholder="Search by name, selector, chain id, family, environment or tag" autofocus>
+<table>
+<thead><tr><th>Name</th><th>Selector</th><th>Family</th><th>Chain id</th><th>Environment</th><th>Tags</th></tr></thead>
+<tbody id="chains">
+{{- range .Chains}}
+<tr data-search="{{.Name}} {{.DisplayName}} {{.Selector}} {{.Family}} {{.ChainID}} {{.Environment}}{{range .Tags}} {{.}}{{end}}">
+<td><a href="chains/{{.Selector}}.html">{{.DisplayName}}</a></td><td><code>{{.Selector}}</code></td><td>{{.Family}}</td><td>{{.ChainID}}</td><td>{{.Environment}}</td><td>{{range $i, $tag := .Tags}}{{if $i}}, {{end}}{{$tag}}{{end}}</td>
+</tr>
+{{- end}}
+</tbody>
+</table>
+<script>
+document.getElementById("search").addEventListener("input", function (event) {
+  var terms = event.target.value.toLowerCase().split(/\s+/).filter(Boolean);
+  document.querySelectorAll("#chains tr").forEach(function (row) {
+    var text = row.dataset.search.toLowerCase();
+    row.hidden = !terms.every(function (term) { return text.indexOf(term) >= 0; });
+  });
+});
+</script>
+</body>
+</html>
+`))
+
+var chainPage = template.Must(template.New("chain").Funcs(funcs).Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>{{.DisplayName}}</title>
+<style>
+body { font-family: sans-serif; margin: 2em; }
+th, td { text-align: left; padding: 0.3em 0.6em; vertical-align: top; }
+img { height: 2em; vertical-align: middle; }
+</style>
+</head>
+<body>
+<p><a href="../index.html">Chain directory</a></p>
+<h1{{with .Metadata}}{{with .BrandColor}} style="border-left: 0.4em solid {{color .}}; padding-left: 0.4em"{{end}}{{end}}>
+{{- with .Metadata}}{{with .LogoURI}}<img src="{{logo .}}" alt=""> {{end}}{{end}}{{.DisplayName}}</h1>
+<table>
+<tr><th>Name</th><td><code>{{.Name}}</code></td></tr>
+<tr><th>Selector</th><td><code>{{.Selector}}</code></td></tr>
+<tr><th>Family</th><td>{{.Family}}</td></tr>
+<tr><th>Chain id</th><td><code>{{.ChainID}}</code></td></tr>
+<tr><th>Environment</th><td>{{.Environment}}</td></tr>
+{{- with .Tags}}
+<tr><th>Tags</th><td>{{range $i, $tag := .}}{{if $i}}, {{end}}{{$tag}}{{end}}</td></tr>
+{{- end}}
+{{- with .Groups}}
+<tr><th>Groups</th><td>{{range $i, $group := .}}{{if $i}}, {{end}}{{$group}}{{end}}</td></tr>
+{{- end}}
+{{- with .Metadata}}
+{{- with .NativeCurrency}}
+<tr><th>Native currency</th><td>{{.Name}} ({{.Symbol}}, {{.Decimals}} decimals)</td></tr>
+{{- end}}
+{{- with .Website}}
+<tr><th>Website</th><td><a href="{{.}}">{{.}}</a></td></tr>
+{{- end}}
+{{- with .Docs}}
+<tr><th>Docs</th><td><a href="{{.}}">{{.}}</a></td></tr>
+{{- end}}
+{{- with .Explorers}}
+<tr><th>Explorers</th><td>{{range .}}<a href="{{.}}">{{.}}</a><br>{{end}}</td></tr>
+{{- end}}
+{{- with .Faucets}}
+<tr><th>Faucets</th><td>{{range .}}<a href="{{.}}">{{.}}</a><br>{{end}}</td></tr>
+{{- end}}
+{{- end}}
+</table>
+<p>Also available as <a href="{{.Selector}}.json">JSON</a>.</p>
+</body>
+</html>
+`))
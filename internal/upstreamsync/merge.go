@@ -0,0 +1,225 @@
+// Package upstreamsync three-way merges the selector datasets of the upstream
+// smartcontractkit/chain-selectors repository into the datasets of this fork.
+package upstreamsync
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Conflict is a chain changed differently by the fork and by upstream since the last sync.
+type Conflict struct {
+	ChainID uint64
+	Reason  string
+}
+
+func (c Conflict) String() string {
+	return fmt.Sprintf("chain %d: %s", c.ChainID, c.Reason)
+}
+
+// dataset is a parsed selectors file, keeping the document so it can be written back with its
+// comments and layout.
+type dataset struct {
+	document  *yaml.Node
+	selectors *yaml.Node
+	entries   map[uint64]entry
+}
+
+type entry struct {
+	key    *yaml.Node
+	value  *yaml.Node
+	fields map[string]interface{}
+}
+
+func (e entry) selector() uint64 {
+	var chain struct {
+		Selector uint64 `yaml:"selector"`
+	}
+	_ = e.value.Decode(&chain)
+	return chain.Selector
+}
+
+// Merge applies the changes upstream made to its dataset since base, the upstream dataset
+// merged last, to ours and returns the merged dataset. Chains added, modified or removed only
+// by upstream are merged, chains only the fork changed are kept. A chain both changed
+// differently, or an upstream chain using a selector the fork assigned to another chain, is a
+// conflict: nothing is merged and the conflicts are returned instead. Ours is returned as is
+// if upstream changed nothing.
+func Merge(base, ours, theirs []byte) ([]byte, []Conflict, error) {
+	baseSet, err := parse(base)
+	if err != nil {
+		return nil, nil, fmt.Errorf("base: %w", err)
+	}
+	ourSet, err := parse(ours)
+	if err != nil {
+		return nil, nil, fmt.Errorf("ours: %w", err)
+	}
+	theirSet, err := parse(theirs)
+	if err != nil {
+		return nil, nil, fmt.Errorf("theirs: %w", err)
+	}
+
+	selectorOwners := make(map[uint64]uint64, len(ourSet.entries))
+	for chainID, e := range ourSet.entries {
+		selectorOwners[e.selector()] = chainID
+	}
+
+	var conflicts []Conflict
+	changed := false
+	for _, chainID := range chainIDs(baseSet, ourSet, theirSet) {
+		b, inBase := baseSet.entries[chainID]
+		o, inOurs := ourSet.entries[chainID]
+		t, inTheirs := theirSet.entries[chainID]
+
+		switch {
+		case same(b, inBase, t, inTheirs), same(o, inOurs, t, inTheirs):
+			// upstream did not change the chain, or made the same change as the fork
+		case same(b, inBase, o, inOurs):
+			if inTheirs {
+				if owner, used := selectorOwners[t.selector()]; used && owner != chainID {
+					conflicts = append(conflicts, Conflict{ChainID: chainID,
+						Reason: fmt.Sprintf("upstream selector %d is used by chain %d here", t.selector(), owner)})
+					continue
+				}
+			}
+			ourSet.apply(chainID, t, inOurs, inTheirs)
+			changed = true
+		case inOurs && inTheirs && o.selector() != t.selector():
+			conflicts = append(conflicts, Conflict{ChainID: chainID,
+				Reason: fmt.Sprintf("selector %d here but %d upstream", o.selector(), t.selector())})
+		default:
+			conflicts = append(conflicts, Conflict{ChainID: chainID, Reason: "changed differently here and upstream"})
+		}
+	}
+	if len(conflicts) > 0 {
+		return nil, conflicts, nil
+	}
+	if !changed {
+		return ours, nil, nil
+	}
+
+	merged, err := ourSet.encode(ours)
+	return merged, nil, err
+}
+
+func parse(file []byte) (*dataset, error) {
+	var document yaml.Node
+	if err := yaml.Unmarshal(file, &document); err != nil {
+		return nil, err
+	}
+	if document.Kind != yaml.DocumentNode || len(document.Content) != 1 || document.Content[0].Kind != yaml.MappingNode {
+		return nil, errors.New("not a selectors file")
+	}
+
+	d := &dataset{document: &document, entries: make(map[uint64]entry)}
+	root := document.Content[0]
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		if root.Content[i].Value == "selectors" {
+			d.selectors = root.Content[i+1]
+		}
+	}
+	if d.selectors == nil || d.selectors.Kind != yaml.MappingNode {
+		return nil, errors.New("no selectors mapping")
+	}
+
+	for i := 0; i+1 < len(d.selectors.Content); i += 2 {
+		key, value := d.selectors.Content[i], d.selectors.Content[i+1]
+		chainID, err := strconv.ParseUint(key.Value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid chain id %q: %w", key.Value, err)
+		}
+		var fields map[string]interface{}
+		if err := value.Decode(&fields); err != nil {
+			return nil, fmt.Errorf("chain %d: %w", chainID, err)
+		}
+		d.entries[chainID] = entry{key: key, value: value, fields: fields}
+	}
+	return d, nil
+}
+
+func chainIDs(sets ...*dataset) []uint64 {
+	seen := make(map[uint64]struct{})
+	var ids []uint64
+	for _, set := range sets {
+		for chainID := range set.entries {
+			if _, exists := seen[chainID]; !exists {
+				seen[chainID] = struct{}{}
+				ids = append(ids, chainID)
+			}
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+func same(a entry, inA bool, b entry, inB bool) bool {
+	if inA != inB {
+		return false
+	}
+	return !inA || reflect.DeepEqual(a.fields, b.fields)
+}
+
+// apply replaces our version of the chain with theirs, adding or removing it as needed.
+func (d *dataset) apply(chainID uint64, theirs entry, inOurs, inTheirs bool) {
+	content := d.selectors.Content
+	switch {
+	case inOurs && inTheirs:
+		for i := 1; i < len(content); i += 2 {
+			if content[i] == d.entries[chainID].value {
+				content[i] = theirs.value
+			}
+		}
+	case inOurs:
+		for i := 0; i+1 < len(content); i += 2 {
+			if content[i] == d.entries[chainID].key {
+				d.selectors.Content = append(content[:i:i], content[i+2:]...)
+				break
+			}
+		}
+	case inTheirs:
+		key := &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!int", Value: strconv.FormatUint(chainID, 10)}
+		d.selectors.Content = append(content, key, theirs.value)
+	}
+	if inTheirs {
+		d.entries[chainID] = theirs
+	} else {
+		delete(d.entries, chainID)
+	}
+}
+
+// encode writes the dataset back, keeping the document start marker of the original file
+// which the encoder drops.
+func (d *dataset) encode(original []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(d.document); err != nil {
+		return nil, err
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+
+	encoded := buf.Bytes()
+	lines := strings.Split(string(original), "\n")
+	for i, line := range lines {
+		if line == "---" {
+			encodedLines := strings.SplitN(string(encoded), "\n", i+1)
+			if len(encodedLines) == i+1 {
+				encoded = []byte(strings.Join(append(encodedLines[:i:i], "---", encodedLines[i]), "\n"))
+			}
+			break
+		}
+		if !strings.HasPrefix(line, "#") {
+			break
+		}
+	}
+	return encoded, nil
+}
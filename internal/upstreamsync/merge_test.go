@@ -0,0 +1,127 @@
+package upstreamsync
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const base = `# EVM selectors.
+---
+selectors:
+  1:
+    selector: 100
+    name: "chain-1"
+  2:
+    selector: 200
+    name: "chain-2"
+  3:
+    selector: 300
+    name: "chain-3"
+`
+
+func TestMergeAppliesUpstreamChanges(t *testing.T) {
+	ours := `# EVM selectors.
+---
+selectors:
+  1:
+    selector: 100
+    name: "chain-1"
+    network_id: 11 # fork addition
+  2:
+    selector: 200
+    name: "chain-2"
+  3:
+    selector: 300
+    name: "chain-3"
+  90:
+    selector: 900
+    name: "fork-chain"
+`
+	theirs := `selectors:
+  1:
+    selector: 100
+    name: "chain-1"
+  2:
+    selector: 200
+    name: "chain-2-renamed"
+  4:
+    selector: 400
+    name: "chain-4"
+`
+
+	merged, conflicts, err := Merge([]byte(base), []byte(ours), []byte(theirs))
+	require.NoError(t, err)
+	require.Empty(t, conflicts)
+	assert.Equal(t, `# EVM selectors.
+---
+selectors:
+  1:
+    selector: 100
+    name: "chain-1"
+    network_id: 11 # fork addition
+  2:
+    selector: 200
+    name: "chain-2-renamed"
+  90:
+    selector: 900
+    name: "fork-chain"
+  4:
+    selector: 400
+    name: "chain-4"
+`, string(merged))
+}
+
+func TestMergeWithoutUpstreamChangesKeepsOurs(t *testing.T) {
+	ours := base + "\n  # Mainnets\n  90:\n    selector: 900\n"
+	merged, conflicts, err := Merge([]byte(base), []byte(ours), []byte(base))
+	require.NoError(t, err)
+	require.Empty(t, conflicts)
+	assert.Equal(t, ours, string(merged))
+}
+
+func TestMergeReportsConflicts(t *testing.T) {
+	ours := `selectors:
+  1:
+    selector: 101
+    name: "chain-1"
+  2:
+    selector: 200
+    name: "chain-2-here"
+  3:
+    selector: 300
+    name: "chain-3"
+  90:
+    selector: 900
+    name: "fork-chain"
+`
+	theirs := `selectors:
+  1:
+    selector: 102
+    name: "chain-1"
+  2:
+    selector: 200
+    name: "chain-2-upstream"
+  3:
+    selector: 300
+    name: "chain-3"
+  5:
+    selector: 900
+    name: "chain-5"
+`
+
+	merged, conflicts, err := Merge([]byte(base), []byte(ours), []byte(theirs))
+	require.NoError(t, err)
+	assert.Nil(t, merged)
+	assert.Equal(t, []Conflict{
+		{ChainID: 1, Reason: "selector 101 here but 102 upstream"},
+		{ChainID: 2, Reason: "changed differently here and upstream"},
+		{ChainID: 5, Reason: "upstream selector 900 is used by chain 90 here"},
+	}, conflicts)
+}
+
+func TestMergeRejectsInvalidFiles(t *testing.T) {
+	_, _, err := Merge([]byte(base), []byte("chains: {}"), []byte(base))
+	assert.Error(t, err)
+}
@@ -0,0 +1,129 @@
+package chain_selectors
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+)
+
+// Lane is a directional pair of chains, identified by their selectors.
+type Lane struct {
+	Source uint64
+	Dest   uint64
+}
+
+// LaneID is the deterministic 16 byte encoding of a Lane: the big-endian source
+// selector followed by the big-endian destination selector. Read as a big-endian
+// uint128 it equals source<<64 | dest.
+type LaneID [16]byte
+
+// NewLane returns the lane between source and dest, failing if it is not valid.
+func NewLane(source, dest uint64) (Lane, error) {
+	lane := Lane{Source: source, Dest: dest}
+	if err := lane.Validate(); err != nil {
+		return Lane{}, err
+	}
+	return lane, nil
+}
+
+// Validate checks that both ends of the lane are known chains and that they differ.
+func (l Lane) Validate() error {
+	if l.Source == l.Dest {
+		return fmt.Errorf("lane source and destination are the same chain selector %d", l.Source)
+	}
+	if _, err := getChainInfo(l.Source); err != nil {
+		return fmt.Errorf("invalid lane source: %w", err)
+	}
+	if _, err := getChainInfo(l.Dest); err != nil {
+		return fmt.Errorf("invalid lane destination: %w", err)
+	}
+	return nil
+}
+
+// ID returns the encoded identifier of the lane.
+func (l Lane) ID() LaneID {
+	var id LaneID
+	binary.BigEndian.PutUint64(id[:8], l.Source)
+	binary.BigEndian.PutUint64(id[8:], l.Dest)
+	return id
+}
+
+// Reverse returns the lane going in the opposite direction.
+func (l Lane) Reverse() Lane {
+	return Lane{Source: l.Dest, Dest: l.Source}
+}
+
+func (l Lane) String() string {
+	return fmt.Sprintf("%d->%d", l.Source, l.Dest)
+}
+
+// Lane decodes the identifier back into its source and destination selectors.
+func (id LaneID) Lane() Lane {
+	return Lane{
+		Source: binary.BigEndian.Uint64(id[:8]),
+		Dest:   binary.BigEndian.Uint64(id[8:]),
+	}
+}
+
+// Big returns the identifier as an unsigned 128 bit integer.
+func (id LaneID) Big() *big.Int {
+	return new(big.Int).SetBytes(id[:])
+}
+
+// String returns the 0x prefixed hex encoding of the identifier.
+func (id LaneID) String() string {
+	return "0x" + hex.EncodeToString(id[:])
+}
+
+// ParseLaneID parses a hex encoded lane identifier, with or without a 0x prefix,
+// and validates the lane it describes.
+func ParseLaneID(s string) (LaneID, error) {
+	raw := strings.TrimPrefix(strings.TrimPrefix(s, "0x"), "0X")
+	if len(raw) != 2*len(LaneID{}) {
+		return LaneID{}, fmt.Errorf("invalid lane id %q: expected %d hex characters", s, 2*len(LaneID{}))
+	}
+
+	var id LaneID
+	if _, err := hex.Decode(id[:], []byte(raw)); err != nil {
+		return LaneID{}, fmt.Errorf("invalid lane id %q: %w", s, err)
+	}
+	if err := id.Lane().Validate(); err != nil {
+		return LaneID{}, fmt.Errorf("invalid lane id %q: %w", s, err)
+	}
+	return id, nil
+}
+
+// LanesBetween enumerates every directional lane between the known chains of the
+// given families, sorted by source and then destination selector. When no families
+// are given all supported families are used.
+func LanesBetween(families ...string) []Lane {
+	if len(families) == 0 {
+		families = allFamilies
+	}
+
+	seen := make(map[uint64]struct{})
+	var selectors []uint64
+	for _, family := range families {
+		for _, sel := range knownSelectors(family) {
+			if _, ok := seen[sel]; ok {
+				continue
+			}
+			seen[sel] = struct{}{}
+			selectors = append(selectors, sel)
+		}
+	}
+	sort.Slice(selectors, func(i, j int) bool { return selectors[i] < selectors[j] })
+
+	lanes := make([]Lane, 0, len(selectors)*(len(selectors)-1))
+	for _, source := range selectors {
+		for _, dest := range selectors {
+			if source != dest {
+				lanes = append(lanes, Lane{Source: source, Dest: dest})
+			}
+		}
+	}
+	return lanes
+}
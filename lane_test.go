@@ -0,0 +1,91 @@
+package chain_selectors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_LaneIDRoundTrip(t *testing.T) {
+	lane, err := NewLane(ETHEREUM_MAINNET.Selector, SOLANA_MAINNET.Selector)
+	require.NoError(t, err)
+
+	id := lane.ID()
+	assert.Equal(t, lane, id.Lane())
+
+	parsed, err := ParseLaneID(id.String())
+	require.NoError(t, err)
+	assert.Equal(t, id, parsed)
+
+	expected := "0x" + "45849994fc9c7b15" + "01bab8fb6197c9e7"
+	assert.Equal(t, expected, id.String())
+	assert.Equal(t, expected[2:], id.Big().Text(16))
+}
+
+func Test_LaneValidation(t *testing.T) {
+	tests := []struct {
+		name      string
+		source    uint64
+		dest      uint64
+		expectErr bool
+	}{
+		{
+			name:   "evm to evm",
+			source: ETHEREUM_MAINNET.Selector,
+			dest:   AVALANCHE_MAINNET.Selector,
+		},
+		{
+			name:   "evm to aptos",
+			source: ETHEREUM_MAINNET.Selector,
+			dest:   APTOS_MAINNET.Selector,
+		},
+		{
+			name:      "same chain",
+			source:    ETHEREUM_MAINNET.Selector,
+			dest:      ETHEREUM_MAINNET.Selector,
+			expectErr: true,
+		},
+		{
+			name:      "unknown destination",
+			source:    ETHEREUM_MAINNET.Selector,
+			dest:      120398123,
+			expectErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := NewLane(test.source, test.dest)
+			if test.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func Test_ParseLaneIDInvalid(t *testing.T) {
+	_, err := ParseLaneID("0x1234")
+	require.Error(t, err)
+
+	_, err = ParseLaneID("0xzz849994fc9c7b1501bab8fb6197c9e7")
+	require.Error(t, err)
+
+	_, err = ParseLaneID(Lane{Source: 1, Dest: 2}.ID().String())
+	require.Error(t, err)
+}
+
+func Test_LanesBetween(t *testing.T) {
+	lanes := LanesBetween(FamilyAptos, FamilySui)
+	require.Len(t, lanes, 6*5)
+
+	for _, lane := range lanes {
+		require.NoError(t, lane.Validate())
+	}
+	for i := 1; i < len(lanes); i++ {
+		prev, cur := lanes[i-1], lanes[i]
+		assert.True(t, prev.Source < cur.Source || (prev.Source == cur.Source && prev.Dest < cur.Dest))
+	}
+}
@@ -0,0 +1,137 @@
+package chain_selectors
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+)
+
+//go:embed lanes.yml
+var lanesYml []byte
+
+// RateLimitClass names the rate limits applied to the messages of a lane.
+type RateLimitClass string
+
+const (
+	RateLimitStandard       RateLimitClass = "standard"
+	RateLimitHighThroughput RateLimitClass = "high-throughput"
+	RateLimitRestricted     RateLimitClass = "restricted"
+)
+
+var knownRateLimitClasses = map[RateLimitClass]struct{}{
+	RateLimitStandard:       {},
+	RateLimitHighThroughput: {},
+	RateLimitRestricted:     {},
+}
+
+// LaneConfig is the configuration of a lane of the deployment.
+type LaneConfig struct {
+	Lane           `yaml:",inline"`
+	Enabled        bool           `yaml:"enabled"`
+	RateLimitClass RateLimitClass `yaml:"rate_limit_class"`
+}
+
+// LaneTopology is a set of configured lanes, in the lanes.yml format.
+type LaneTopology struct {
+	lanes    []LaneConfig
+	bySource map[uint64][]LaneConfig
+}
+
+// The embedded lanes are parsed on first use as validating them needs the chain datasets.
+var (
+	laneTopologyOnce sync.Once
+	laneTopology     *LaneTopology
+)
+
+// ParseLaneTopology parses lanes in the lanes.yml format. Every lane must be valid, see
+// Lane.Validate, configured once and have a known rate limit class, otherwise the returned
+// *MultiError holds one error per invalid lane, indexed by its position in the file.
+func ParseLaneTopology(ymlFile []byte) (*LaneTopology, error) {
+	type ymlData struct {
+		Lanes []LaneConfig `yaml:"lanes"`
+	}
+
+	var data ymlData
+	if err := yaml.Unmarshal(ymlFile, &data); err != nil {
+		return nil, fmt.Errorf("failed to parse lanes: %w", err)
+	}
+
+	errs := &MultiError{}
+	topology := &LaneTopology{bySource: make(map[uint64][]LaneConfig)}
+	seen := make(map[Lane]struct{}, len(data.Lanes))
+	for i, lane := range data.Lanes {
+		item := "lane " + lane.Lane.String()
+		if err := lane.Validate(); err != nil {
+			errs.add(i, item, err)
+			continue
+		}
+		if _, known := knownRateLimitClasses[lane.RateLimitClass]; !known {
+			errs.add(i, item, fmt.Errorf("unknown rate limit class %q", lane.RateLimitClass))
+			continue
+		}
+		if _, duplicate := seen[lane.Lane]; duplicate {
+			errs.add(i, item, errors.New("lane configured twice"))
+			continue
+		}
+		seen[lane.Lane] = struct{}{}
+		topology.lanes = append(topology.lanes, lane)
+		topology.bySource[lane.Source] = append(topology.bySource[lane.Source], lane)
+	}
+	if err := errs.errOrNil(); err != nil {
+		return nil, err
+	}
+
+	for _, lanes := range topology.bySource {
+		sort.Slice(lanes, func(i, j int) bool { return lanes[i].Dest < lanes[j].Dest })
+	}
+	return topology, nil
+}
+
+// Lanes returns every configured lane, in the order of the file.
+func (t *LaneTopology) Lanes() []LaneConfig {
+	return append([]LaneConfig(nil), t.lanes...)
+}
+
+// Lane returns the configuration of the lane from source to dest, if it is configured.
+func (t *LaneTopology) Lane(source, dest uint64) (LaneConfig, bool) {
+	for _, lane := range t.bySource[source] {
+		if lane.Dest == dest {
+			return lane, true
+		}
+	}
+	return LaneConfig{}, false
+}
+
+// EnabledLanesFrom returns the enabled lanes leaving the chain identified by the selector,
+// sorted by destination selector.
+func (t *LaneTopology) EnabledLanesFrom(selector uint64) []LaneConfig {
+	var enabled []LaneConfig
+	for _, lane := range t.bySource[selector] {
+		if lane.Enabled {
+			enabled = append(enabled, lane)
+		}
+	}
+	return enabled
+}
+
+// Lanes returns the lane topology of the deployment, embedded from lanes.yml.
+func Lanes() *LaneTopology {
+	laneTopologyOnce.Do(func() {
+		topology, err := ParseLaneTopology(lanesYml)
+		if err != nil {
+			panic(err)
+		}
+		laneTopology = topology
+	})
+	return laneTopology
+}
+
+// EnabledLanesFrom returns the enabled lanes of lanes.yml leaving the chain identified by the
+// selector, sorted by destination selector.
+func EnabledLanesFrom(selector uint64) []LaneConfig {
+	return Lanes().EnabledLanesFrom(selector)
+}
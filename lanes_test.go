@@ -0,0 +1,58 @@
+package chain_selectors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLanesAreConnected(t *testing.T) {
+	for _, lane := range Lanes().Lanes() {
+		assert.Contains(t, Connected(lane.Source), lane.Dest, "lane %s joins chains not connected in connectivity.yml", lane)
+	}
+}
+
+func TestEnabledLanesFrom(t *testing.T) {
+	lanes := EnabledLanesFrom(ETHEREUM_MAINNET.Selector)
+	require.Len(t, lanes, 3, "the lane to binance_smart_chain-mainnet is disabled")
+	assert.Equal(t, LaneConfig{
+		Lane:           Lane{Source: ETHEREUM_MAINNET.Selector, Dest: ETHEREUM_MAINNET_OPTIMISM_1.Selector},
+		Enabled:        true,
+		RateLimitClass: RateLimitStandard,
+	}, lanes[0])
+
+	lane, configured := Lanes().Lane(ETHEREUM_MAINNET.Selector, BINANCE_SMART_CHAIN_MAINNET.Selector)
+	require.True(t, configured)
+	assert.False(t, lane.Enabled)
+
+	assert.Empty(t, EnabledLanesFrom(ZORA_TESTNET.Selector))
+}
+
+func TestParseLaneTopologyErrors(t *testing.T) {
+	_, err := ParseLaneTopology([]byte(`
+lanes:
+  - source: 5009297550715157269
+    dest: 4949039107694359620
+    enabled: true
+    rate_limit_class: standard
+  - source: 5009297550715157269
+    dest: 1
+    enabled: true
+    rate_limit_class: standard
+  - source: 5009297550715157269
+    dest: 3734403246176062136
+    enabled: true
+    rate_limit_class: unlimited
+  - source: 5009297550715157269
+    dest: 4949039107694359620
+    enabled: false
+    rate_limit_class: standard
+`))
+	var multi *MultiError
+	require.ErrorAs(t, err, &multi)
+	assert.Equal(t, []int{1, 2, 3}, multi.Indexes())
+
+	_, err = ParseLaneTopology([]byte("lanes: {"))
+	require.Error(t, err)
+}
@@ -0,0 +1,78 @@
+package chain_selectors
+
+import "sync"
+
+// onceValue returns a function calling build on its first call and returning its result from
+// then on, like sync.OnceValue of Go 1.21. If build panics, every call panics with the same
+// value.
+//
+// The datasets embedded in the package are parsed and indexed through onceValue, one family or
+// file at a time, so a lookup only pays for the datasets it needs. They are all loaded during
+// package initialisation unless the package is built with the chainsel_lazy tag, see
+// loadEmbeddedDatasets.
+func onceValue[T any](build func() T) func() T {
+	var (
+		once   sync.Once
+		value  T
+		done   bool
+		failed any
+	)
+	return func() T {
+		once.Do(func() {
+			defer func() {
+				if !done {
+					failed = recover()
+				}
+			}()
+			value = build()
+			done = true
+		})
+		if !done {
+			panic(failed)
+		}
+		return value
+	}
+}
+
+// loadEmbeddedDatasets parses and indexes every embedded dataset, panicking if one of them is
+// invalid.
+func loadEmbeddedDatasets() {
+	evmSelectorsMap()
+	evmTestSelectorsMap()
+	evmChainIdToChainSelector()
+	evmChains()
+	evmChainIdsByNetworkID()
+	evmForksByChainId()
+	solanaSelectorsMap()
+	solanaTestSelectorsMap()
+	solanaChainIdToChainSelector()
+	solanaChainsBySelector()
+	aptosSelectorsMap()
+	aptosChainsBySelector()
+	suiSelectorsMap()
+	suiChainsBySelector()
+	tronSelectorsMap()
+	tronChainIdBySelector()
+	tonSelectorsMap()
+	tonChainIdBySelector()
+	chainDetailsBySelector()
+	testChainsBySelector()
+	embeddedDatasetVersion()
+
+	nameByAlias()
+	tokensBySelector()
+	contractsDataset()
+	featuresBySelector()
+	gasConfigsBySelector()
+	chainGroups()
+	groupsBySelector()
+	lifecycleBySelector()
+	metadataBySelector()
+	environmentOverlays()
+	selectorMigrations()
+	quarantinedChainIDs()
+	reservationsBySelector()
+	neighborsBySelector()
+	tagsBySelector()
+	selectorsByTag()
+}
@@ -0,0 +1,10 @@
+//go:build !chainsel_lazy
+
+package chain_selectors
+
+// Without the chainsel_lazy build tag the embedded datasets are loaded during package
+// initialisation, so an invalid dataset fails the program at startup and no lookup pays for
+// parsing them.
+func init() {
+	loadEmbeddedDatasets()
+}
@@ -0,0 +1,30 @@
+package chain_selectors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_OnceValueBuildsOnce(t *testing.T) {
+	builds := 0
+	value := onceValue(func() int {
+		builds++
+		return 42
+	})
+	assert.Equal(t, 0, builds, "nothing is built before the first call")
+	assert.Equal(t, 42, value())
+	assert.Equal(t, 42, value())
+	assert.Equal(t, 1, builds)
+}
+
+func Test_OnceValueRepeatsPanics(t *testing.T) {
+	builds := 0
+	value := onceValue(func() map[uint64]ChainDetails {
+		builds++
+		return parseYml([]byte("selectors: [invalid"))
+	})
+	assert.Panics(t, func() { value() })
+	assert.Panics(t, func() { value() }, "later calls do not return a zero value")
+	assert.Equal(t, 1, builds)
+}
@@ -0,0 +1,101 @@
+package chain_selectors
+
+import (
+	_ "embed"
+	"fmt"
+	"sort"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+//go:embed lifecycle.yml
+var lifecycleYml []byte
+
+const lifecycleDateLayout = "2006-01-02"
+
+// ChainLifecycle holds the dates a chain became available and stopped being available.
+// Zero values mean the date is unknown or, for SunsetDate, that the chain is not sunset.
+type ChainLifecycle struct {
+	LaunchDate time.Time
+	SunsetDate time.Time
+}
+
+var lifecycleBySelector = onceValue(func() map[uint64]ChainLifecycle { return parseLifecycleYml(lifecycleYml) })
+
+func parseLifecycleYml(ymlFile []byte) map[uint64]ChainLifecycle {
+	type ymlLifecycle struct {
+		LaunchDate string `yaml:"launch_date"`
+		SunsetDate string `yaml:"sunset_date"`
+	}
+	type ymlData struct {
+		Lifecycle map[uint64]ymlLifecycle `yaml:"lifecycle"`
+	}
+
+	var data ymlData
+	err := yaml.Unmarshal(ymlFile, &data)
+	if err != nil {
+		panic(err)
+	}
+
+	output := make(map[uint64]ChainLifecycle, len(data.Lifecycle))
+	for selector, entry := range data.Lifecycle {
+		var lifecycle ChainLifecycle
+		if entry.LaunchDate != "" {
+			lifecycle.LaunchDate, err = time.Parse(lifecycleDateLayout, entry.LaunchDate)
+			if err != nil {
+				panic(fmt.Errorf("invalid launch date for selector %d: %w", selector, err))
+			}
+		}
+		if entry.SunsetDate != "" {
+			lifecycle.SunsetDate, err = time.Parse(lifecycleDateLayout, entry.SunsetDate)
+			if err != nil {
+				panic(fmt.Errorf("invalid sunset date for selector %d: %w", selector, err))
+			}
+		}
+		if !lifecycle.LaunchDate.IsZero() && !lifecycle.SunsetDate.IsZero() && !lifecycle.SunsetDate.After(lifecycle.LaunchDate) {
+			panic(fmt.Errorf("sunset date of selector %d is not after its launch date", selector))
+		}
+		output[selector] = lifecycle
+	}
+	return output
+}
+
+// ActiveAt reports whether the chain is available at the given time.
+func (l ChainLifecycle) ActiveAt(t time.Time) bool {
+	if !l.LaunchDate.IsZero() && t.Before(l.LaunchDate) {
+		return false
+	}
+	if !l.SunsetDate.IsZero() && !t.Before(l.SunsetDate) {
+		return false
+	}
+	return true
+}
+
+// GetChainLifecycle returns the lifecycle dates of the chain identified by the selector.
+func GetChainLifecycle(selector uint64) (ChainLifecycle, bool) {
+	lifecycle, exist := lifecycleBySelector()[selector]
+	return lifecycle, exist
+}
+
+// IsActiveAt reports whether the chain identified by the selector is available at the given time.
+func IsActiveAt(selector uint64, t time.Time) (bool, error) {
+	if _, err := getChainInfo(selector); err != nil {
+		return false, err
+	}
+	return lifecycleBySelector()[selector].ActiveAt(t), nil
+}
+
+// ActiveChains returns the sorted selectors of all known chains available at the given time.
+func ActiveChains(asOf time.Time) []uint64 {
+	var selectors []uint64
+	for _, family := range allFamilies {
+		for _, selector := range knownSelectors(family) {
+			if lifecycleBySelector()[selector].ActiveAt(asOf) {
+				selectors = append(selectors, selector)
+			}
+		}
+	}
+	sort.Slice(selectors, func(i, j int) bool { return selectors[i] < selectors[j] })
+	return selectors
+}
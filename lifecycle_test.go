@@ -0,0 +1,92 @@
+package chain_selectors
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLifecycleSelectorsAreKnownChains(t *testing.T) {
+	for selector := range lifecycleBySelector() {
+		_, err := getChainInfo(selector)
+		assert.NoError(t, err, "lifecycle.yml references unknown selector %d", selector)
+	}
+}
+
+func Test_IsActiveAt(t *testing.T) {
+	tests := []struct {
+		name      string
+		selector  uint64
+		at        time.Time
+		active    bool
+		expectErr bool
+	}{
+		{
+			name:     "before launch",
+			selector: ETHEREUM_MAINNET_BASE_1.Selector,
+			at:       time.Date(2023, 8, 8, 23, 59, 0, 0, time.UTC),
+		},
+		{
+			name:     "on launch day",
+			selector: ETHEREUM_MAINNET_BASE_1.Selector,
+			at:       time.Date(2023, 8, 9, 0, 0, 0, 0, time.UTC),
+			active:   true,
+		},
+		{
+			name:     "before sunset",
+			selector: POLYGON_TESTNET_MUMBAI.Selector,
+			at:       time.Date(2024, 4, 12, 0, 0, 0, 0, time.UTC),
+			active:   true,
+		},
+		{
+			name:     "after sunset",
+			selector: POLYGON_TESTNET_MUMBAI.Selector,
+			at:       time.Date(2024, 4, 13, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "chain without lifecycle",
+			selector: AVALANCHE_MAINNET.Selector,
+			at:       time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC),
+			active:   true,
+		},
+		{
+			name:      "unknown chain",
+			selector:  120398123,
+			at:        time.Now(),
+			expectErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			active, err := IsActiveAt(test.selector, test.at)
+			if test.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.active, active)
+		})
+	}
+}
+
+func Test_ActiveChains(t *testing.T) {
+	before := ActiveChains(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	after := ActiveChains(time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC))
+
+	assert.Contains(t, before, POLYGON_TESTNET_MUMBAI.Selector)
+	assert.NotContains(t, after, POLYGON_TESTNET_MUMBAI.Selector)
+	assert.Contains(t, after, SOLANA_MAINNET.Selector)
+	assert.Len(t, after, len(before)-1)
+}
+
+func Test_ParseLifecycleYmlRejectsInvalidDates(t *testing.T) {
+	require.Panics(t, func() {
+		parseLifecycleYml([]byte("lifecycle:\n  1:\n    launch_date: 30/07/2015\n"))
+	})
+	require.Panics(t, func() {
+		parseLifecycleYml([]byte("lifecycle:\n  1:\n    launch_date: 2024-01-02\n    sunset_date: 2024-01-01\n"))
+	})
+}
@@ -0,0 +1,101 @@
+package chain_selectors
+
+import (
+	"strconv"
+	"strings"
+)
+
+// EVMChainID, Selector, Name and CAIP2 type the keys accepted by Lookup.
+type (
+	// EVMChainID is the EIP-155 chain id of an EVM chain.
+	EVMChainID uint64
+	// Selector is a CCIP chain selector. Selectors identify chains, they are not ordinal: their
+	// numeric order and any arithmetic on them mean nothing. Order them with CompareByName or
+	// CompareByChainID, the selectorarith analyzer of the analysis module reports misuse.
+	Selector uint64
+	// Name is a canonical chain name, an alias or a generated custom chain name.
+	Name string
+	// CAIP2 is a CAIP-2 chain id, e.g. "eip155:1". Only the eip155 namespace is supported.
+	CAIP2 string
+)
+
+// ChainKey is the set of keys identifying a chain for Lookup.
+type ChainKey interface {
+	EVMChainID | Selector | Name | CAIP2
+}
+
+// caip2EVMNamespace is the CAIP-2 namespace of EVM chains.
+const caip2EVMNamespace = "eip155"
+
+// Lookup resolves the EVM chain identified by the key, the type of the key selecting how:
+//
+//	Lookup(EVMChainID(1))
+//	Lookup(Selector(5009297550715157269))
+//	Lookup(Name("ethereum-mainnet"))
+//	Lookup(CAIP2("eip155:1"))
+func Lookup[K ChainKey](key K) (Chain, error) {
+	switch k := any(key).(type) {
+	case EVMChainID:
+		if ch, exists := ChainByEvmChainID(uint64(k)); exists {
+			return ch, nil
+		}
+		return Chain{}, chainIDNotFoundError(FamilyEVM, uint64(k))
+	case Selector:
+		if ch, exists := ChainBySelector(uint64(k)); exists {
+			return ch, nil
+		}
+		return Chain{}, selectorNotFoundError(FamilyEVM, uint64(k))
+	case Name:
+		if ch, exists := ChainByName(string(k)); exists {
+			return ch, nil
+		}
+		return Chain{}, notFoundError(InputName, FamilyEVM, string(k))
+	case CAIP2:
+		chainID, err := k.evmChainID()
+		if err != nil {
+			return Chain{}, err
+		}
+		return Lookup(chainID)
+	}
+	panic("unreachable")
+}
+
+// EVMChainIDOf returns the EVM chain id of the chain returned by Lookup, or its error:
+//
+//	chainID, err := EVMChainIDOf(Lookup(Selector(selector)))
+func EVMChainIDOf(ch Chain, err error) (uint64, error) {
+	if err != nil {
+		return 0, err
+	}
+	return ch.EvmChainID, nil
+}
+
+// SelectorOf returns the selector of the chain returned by Lookup, or its error:
+//
+//	selector, err := SelectorOf(Lookup(EVMChainID(chainID)))
+func SelectorOf(ch Chain, err error) (uint64, error) {
+	if err != nil {
+		return 0, err
+	}
+	return ch.Selector, nil
+}
+
+func (c CAIP2) evmChainID() (EVMChainID, error) {
+	namespace, reference, found := strings.Cut(string(c), ":")
+	if !found {
+		return 0, lookupError(InputCAIP2, "", string(c), reasonMalformed)
+	}
+	if namespace != caip2EVMNamespace {
+		return 0, lookupError(InputCAIP2, "", string(c), "unsupported namespace "+namespace)
+	}
+	chainID, err := strconv.ParseUint(reference, 10, 64)
+	if err != nil {
+		return 0, lookupError(InputCAIP2, FamilyEVM, string(c), reasonMalformed)
+	}
+	return EVMChainID(chainID), nil
+}
+
+// CAIP2 returns the CAIP-2 chain id of the chain.
+func (c Chain) CAIP2() CAIP2 {
+	return CAIP2(caip2EVMNamespace + ":" + strconv.FormatUint(c.EvmChainID, 10))
+}
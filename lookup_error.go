@@ -0,0 +1,95 @@
+package chain_selectors
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// InputKind names the kind of input a lookup was given.
+type InputKind string
+
+const (
+	InputSelector  InputKind = "selector"
+	InputChainID   InputKind = "chain_id"
+	InputName      InputKind = "name"
+	InputNetworkID InputKind = "network_id"
+	InputCAIP2     InputKind = "caip2"
+)
+
+const (
+	reasonNotFound          = "not found"
+	reasonMalformed         = "malformed"
+	reasonUnsupportedFamily = "unsupported family"
+	reasonQuarantined       = "quarantined"
+)
+
+// LookupError is returned by failed lookups. It carries the input of the lookup so services can
+// render actionable errors without parsing the message. A LookupError for a chain that does not
+// exist matches ErrChainNotFound with errors.Is.
+type LookupError struct {
+	Input     string
+	InputKind InputKind
+	// Family is the family the lookup was restricted to, empty if it was not.
+	Family string
+	// Reason explains why the lookup failed, e.g. "not found" or "malformed".
+	Reason string
+	// Suggestions holds the known chains closest to the input, see SetMissLogSize.
+	Suggestions []Suggestion
+}
+
+// String describes the failed lookup, e.g. "evm chain id 11155112: not found".
+func (e *LookupError) String() string {
+	var b strings.Builder
+	if e.Family != "" {
+		b.WriteString(e.Family)
+		b.WriteByte(' ')
+	}
+	b.WriteString("chain ")
+	switch e.InputKind {
+	case InputChainID:
+		b.WriteString("id")
+	case InputNetworkID:
+		b.WriteString("network id")
+	default:
+		b.WriteString(string(e.InputKind))
+	}
+	fmt.Fprintf(&b, " %s: %s", e.Input, e.Reason)
+
+	if len(e.Suggestions) > 0 {
+		formatted := make([]string, len(e.Suggestions))
+		for i, s := range e.Suggestions {
+			formatted[i] = s.String()
+		}
+		fmt.Fprintf(&b, ", did you mean %s?", strings.Join(formatted, " or "))
+	}
+	return b.String()
+}
+
+func (e *LookupError) Error() string {
+	return e.String()
+}
+
+// Is reports whether the lookup failed because the chain does not exist or because its chain id
+// is quarantined.
+func (e *LookupError) Is(target error) bool {
+	return (target == ErrChainNotFound && e.Reason == reasonNotFound) ||
+		(target == ErrChainQuarantined && e.Reason == reasonQuarantined)
+}
+
+// notFoundError reports a chain that does not exist, recording the miss, see SetMissLogSize.
+func notFoundError(kind InputKind, family, input string) error {
+	return recordMiss(&LookupError{Input: input, InputKind: kind, Family: family, Reason: reasonNotFound})
+}
+
+func selectorNotFoundError(family string, selector uint64) error {
+	return notFoundError(InputSelector, family, strconv.FormatUint(selector, 10))
+}
+
+func chainIDNotFoundError(family string, chainID uint64) error {
+	return notFoundError(InputChainID, family, strconv.FormatUint(chainID, 10))
+}
+
+func lookupError(kind InputKind, family, input, reason string) error {
+	return &LookupError{Input: input, InputKind: kind, Family: family, Reason: reason}
+}
@@ -0,0 +1,85 @@
+package chain_selectors
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLookupErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		lookup   func() error
+		expected LookupError
+		notFound bool
+		message  string
+	}{
+		{
+			name:     "evm selector",
+			lookup:   func() error { _, err := chainIdFromSelector(1); return err },
+			expected: LookupError{Input: "1", InputKind: InputSelector, Family: FamilyEVM, Reason: "not found"},
+			notFound: true,
+			message:  "evm chain selector 1: not found",
+		},
+		{
+			name:     "any family selector",
+			lookup:   func() error { _, err := GetChainDetailsBySelector(1); return err },
+			expected: LookupError{Input: "1", InputKind: InputSelector, Reason: "not found"},
+			notFound: true,
+			message:  "chain selector 1: not found",
+		},
+		{
+			name:     "evm name",
+			lookup:   func() error { _, err := ChainIdFromName("no-such-chain"); return err },
+			expected: LookupError{Input: "no-such-chain", InputKind: InputName, Family: FamilyEVM, Reason: "not found"},
+			notFound: true,
+			message:  "evm chain name no-such-chain: not found",
+		},
+		{
+			name:     "solana chain id",
+			lookup:   func() error { _, err := SolanaNameFromChainId("unknown"); return err },
+			expected: LookupError{Input: "unknown", InputKind: InputChainID, Family: FamilySolana, Reason: "not found"},
+			notFound: true,
+			message:  "solana chain id unknown: not found",
+		},
+		{
+			name:     "ton chain id",
+			lookup:   func() error { _, err := GetChainDetailsByChainIDAndFamily("-1", FamilyTon); return err },
+			expected: LookupError{Input: "-1", InputKind: InputChainID, Family: FamilyTon, Reason: "not found"},
+			notFound: true,
+			message:  "ton chain id -1: not found",
+		},
+		{
+			name:     "malformed chain id",
+			lookup:   func() error { _, err := GetChainDetailsByChainIDAndFamily("abc", FamilyAptos); return err },
+			expected: LookupError{Input: "abc", InputKind: InputChainID, Family: FamilyAptos, Reason: "malformed"},
+			message:  "aptos chain id abc: malformed",
+		},
+		{
+			name:     "unsupported family",
+			lookup:   func() error { _, err := GetChainDetailsByChainIDAndFamily("1", FamilyCosmos); return err },
+			expected: LookupError{Input: "1", InputKind: InputChainID, Family: FamilyCosmos, Reason: "unsupported family"},
+			message:  "cosmos chain id 1: unsupported family",
+		},
+		{
+			name:     "network id",
+			lookup:   func() error { _, err := SelectorFromNetworkID(0); return err },
+			expected: LookupError{Input: "0", InputKind: InputNetworkID, Family: FamilyEVM, Reason: "not found"},
+			notFound: true,
+			message:  "evm chain network id 0: not found",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.lookup()
+			var lookupErr *LookupError
+			require.ErrorAs(t, err, &lookupErr)
+			assert.Equal(t, test.expected, *lookupErr)
+			assert.Equal(t, test.notFound, errors.Is(err, ErrChainNotFound))
+			assert.EqualError(t, err, test.message)
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package chain_selectors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLookup(t *testing.T) {
+	tests := []struct {
+		name   string
+		lookup func() (Chain, error)
+	}{
+		{name: "chain id", lookup: func() (Chain, error) { return Lookup(EVMChainID(1)) }},
+		{name: "selector", lookup: func() (Chain, error) { return Lookup(Selector(ETHEREUM_MAINNET.Selector)) }},
+		{name: "name", lookup: func() (Chain, error) { return Lookup(Name("ethereum-mainnet")) }},
+		{name: "alias", lookup: func() (Chain, error) { return Lookup(Name("ethereum")) }},
+		{name: "caip2", lookup: func() (Chain, error) { return Lookup(CAIP2("eip155:1")) }},
+		{name: "chain caip2", lookup: func() (Chain, error) { return Lookup(ETHEREUM_MAINNET.CAIP2()) }},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ch, err := test.lookup()
+			require.NoError(t, err)
+			assert.Equal(t, ETHEREUM_MAINNET, ch)
+		})
+	}
+}
+
+func TestLookupUnknownKeys(t *testing.T) {
+	var lookupErr *LookupError
+
+	_, err := Lookup(Selector(1))
+	assert.ErrorIs(t, err, ErrChainNotFound)
+
+	_, err = Lookup(Name("no-such-chain"))
+	assert.ErrorIs(t, err, ErrChainNotFound)
+
+	for _, key := range []CAIP2{"eip155", "eip155:abc", "solana:5eykt4UsFv8P8NJdTREpY1vzqKqZKvdpKuc147dw2N9d"} {
+		_, err = Lookup(key)
+		require.ErrorAs(t, err, &lookupErr, key)
+		assert.Equal(t, InputCAIP2, lookupErr.InputKind)
+		assert.NotErrorIs(t, err, ErrChainNotFound)
+	}
+}
+
+func TestLookupFields(t *testing.T) {
+	chainID, err := EVMChainIDOf(Lookup(Selector(ETHEREUM_MAINNET.Selector)))
+	require.NoError(t, err)
+	assert.Equal(t, ETHEREUM_MAINNET.EvmChainID, chainID)
+
+	selector, err := SelectorOf(Lookup(EVMChainID(1)))
+	require.NoError(t, err)
+	assert.Equal(t, ETHEREUM_MAINNET.Selector, selector)
+
+	_, err = EVMChainIDOf(Lookup(Selector(1)))
+	assert.ErrorIs(t, err, ErrChainNotFound)
+}
@@ -0,0 +1,133 @@
+package chain_selectors
+
+import (
+	"sort"
+	"strings"
+)
+
+// MatchPolicy controls how strictly name based lookups match the name they are given. Infra as
+// code wants exact names so a typo fails loudly, command line tools may prefer to be forgiving.
+type MatchPolicy int
+
+const (
+	// MatchExact accepts canonical names, aliases and generated custom chain names as written.
+	MatchExact MatchPolicy = iota
+	// MatchCaseInsensitive also accepts them in any case, ignoring surrounding whitespace.
+	MatchCaseInsensitive
+	// MatchFuzzy also accepts the closest name if it is close enough and the only one that
+	// close, e.g. "ethereum-testnet-sepolia-arbitrum" for "ethereum-testnet-sepolia-arbitrum-1".
+	MatchFuzzy
+)
+
+func (p MatchPolicy) String() string {
+	switch p {
+	case MatchExact:
+		return "exact"
+	case MatchCaseInsensitive:
+		return "case-insensitive"
+	case MatchFuzzy:
+		return "fuzzy"
+	}
+	return "unknown"
+}
+
+// Match returns the candidate the name matches under the policy. An exact match always wins,
+// a fuzzy match must be unambiguous: no other candidate may be as close.
+func (p MatchPolicy) Match(name string, candidates []string) (string, bool) {
+	for _, candidate := range candidates {
+		if candidate == name {
+			return candidate, true
+		}
+	}
+	if p == MatchExact {
+		return "", false
+	}
+
+	normalized := strings.ToLower(strings.TrimSpace(name))
+	for _, candidate := range candidates {
+		if strings.ToLower(candidate) == normalized {
+			return candidate, true
+		}
+	}
+	if p != MatchFuzzy || normalized == "" {
+		return "", false
+	}
+
+	best, bestDistance, bestFull, ambiguous := "", len(normalized)/3+1, 0, false
+	for _, candidate := range candidates {
+		distance, full := nameDistance(normalized, strings.ToLower(candidate))
+		switch {
+		case distance < bestDistance || (distance == bestDistance && full < bestFull):
+			best, bestDistance, bestFull, ambiguous = candidate, distance, full, false
+		case distance == bestDistance && full == bestFull && best != "":
+			ambiguous = true
+		}
+	}
+	if best == "" || ambiguous {
+		return "", false
+	}
+	return best, true
+}
+
+// WithMatchPolicy sets how ChainByName matches names, MatchExact by default. The policy can be
+// overridden per lookup with ChainByNameMatching.
+func WithMatchPolicy(policy MatchPolicy) RegistryOption {
+	return func(r *Registry) {
+		r.matchPolicy = policy
+	}
+}
+
+// MatchPolicy returns the policy ChainByName matches names with.
+func (r *Registry) MatchPolicy() MatchPolicy {
+	return r.matchPolicy
+}
+
+// ChainByNameMatching is ChainByName matching the name with the given policy instead of the
+// one the registry was created with.
+func (r *Registry) ChainByNameMatching(name string, policy MatchPolicy) (Chain, bool) {
+	if ch, exists := r.chainByExactName(name); exists {
+		return ch, true
+	}
+	if policy == MatchExact {
+		return Chain{}, false
+	}
+	matched, exists := policy.Match(name, r.ChainNames())
+	if !exists {
+		return Chain{}, false
+	}
+	return r.chainByExactName(matched)
+}
+
+// ChainNames returns the sorted names and aliases of the EVM chains the registry resolves by
+// name, for instance to complete names in a command line tool. Generated custom chain names
+// are left out.
+func (r *Registry) ChainNames() []string {
+	var names []string
+	for name := range r.loadState().evmByName {
+		names = append(names, name)
+	}
+	if r.embedded {
+		for _, ch := range ALL {
+			if !r.excludesSelector(ch.Selector) {
+				names = append(names, ch.Name)
+			}
+		}
+		for alias, canonical := range nameByAlias() {
+			if ch, exists := evmChainsByName()[canonical]; exists && !r.excludesSelector(ch.Selector) {
+				names = append(names, alias)
+			}
+		}
+	}
+	sort.Strings(names)
+	return dedupSorted(names)
+}
+
+func dedupSorted(names []string) []string {
+	out := names[:0]
+	for i, name := range names {
+		if i == 0 || name != names[i-1] {
+			out = append(out, name)
+		}
+	}
+	return out
+}
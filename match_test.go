@@ -0,0 +1,67 @@
+package chain_selectors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchPolicy(t *testing.T) {
+	candidates := []string{"ethereum-mainnet", "ethereum-testnet-sepolia", "ethereum-testnet-sepolia-arbitrum-1", "polygon-mainnet", "polygon-testnet", "Mixed-Case"}
+
+	tests := []struct {
+		name     string
+		input    string
+		policy   MatchPolicy
+		expected string
+	}{
+		{name: "exact", input: "ethereum-mainnet", policy: MatchExact, expected: "ethereum-mainnet"},
+		{name: "exact is case sensitive", input: "Ethereum-Mainnet", policy: MatchExact},
+		{name: "case insensitive", input: " Ethereum-Mainnet ", policy: MatchCaseInsensitive, expected: "ethereum-mainnet"},
+		{name: "case insensitive candidate", input: "mixed-case", policy: MatchCaseInsensitive, expected: "Mixed-Case"},
+		{name: "case insensitive is not fuzzy", input: "ethereum-mainet", policy: MatchCaseInsensitive},
+		{name: "fuzzy typo", input: "ethereum-mainet", policy: MatchFuzzy, expected: "ethereum-mainnet"},
+		{name: "fuzzy segments", input: "ethereum-testnet-sepolia-arbitrum", policy: MatchFuzzy, expected: "ethereum-testnet-sepolia-arbitrum-1"},
+		{name: "fuzzy prefers the closest full name", input: "sepolia", policy: MatchFuzzy, expected: "ethereum-testnet-sepolia"},
+		{name: "fuzzy rejects distant names", input: "solana-devnet", policy: MatchFuzzy},
+		{name: "fuzzy rejects ambiguous names", input: "polygon", policy: MatchFuzzy},
+		{name: "fuzzy rejects empty names", input: " ", policy: MatchFuzzy},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matched, exists := tt.policy.Match(tt.input, candidates)
+			assert.Equal(t, tt.expected != "", exists)
+			assert.Equal(t, tt.expected, matched)
+		})
+	}
+}
+
+func TestRegistryChainByNameMatching(t *testing.T) {
+	exact := NewRegistry()
+	fuzzy := NewRegistry(WithMatchPolicy(MatchFuzzy))
+	assert.Equal(t, MatchExact, exact.MatchPolicy())
+	assert.Equal(t, MatchFuzzy, fuzzy.MatchPolicy())
+
+	_, exists := exact.ChainByName("Ethereum-Mainnet")
+	assert.False(t, exists)
+	ch, exists := exact.ChainByNameMatching("Ethereum-Mainnet", MatchCaseInsensitive)
+	require.True(t, exists)
+	assert.Equal(t, ETHEREUM_MAINNET, ch)
+
+	ch, exists = fuzzy.ChainByName("ethereum-testnet-sepolia-arbitrum")
+	require.True(t, exists)
+	assert.Equal(t, ETHEREUM_TESTNET_SEPOLIA_ARBITRUM_1, ch)
+	ch, exists = fuzzy.ChainByName("SEPOLIA")
+	require.True(t, exists, "aliases are matched too")
+	assert.Equal(t, ETHEREUM_TESTNET_SEPOLIA, ch)
+	_, exists = fuzzy.ChainByNameMatching("ethereum-testnet-sepolia-arbitrum", MatchExact)
+	assert.False(t, exists, "the policy can be overridden per lookup")
+
+	production := NewRegistry(WithTestChains(false), WithMatchPolicy(MatchFuzzy))
+	for _, name := range production.ChainNames() {
+		ch, exists := production.ChainByName(name)
+		require.True(t, exists, name)
+		assert.NotContains(t, testChainsBySelector(), ch.Selector, name)
+	}
+}
@@ -0,0 +1,42 @@
+package chain_selectors
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+)
+
+// MessageIDVersion is the version of the encoding ComputeMessageID hashes, it is the first byte
+// of the preimage so future encodings never collide with it.
+const MessageIDVersion uint8 = 1
+
+// MessageID identifies a cross-chain message.
+type MessageID [32]byte
+
+// ComputeMessageID derives the identifier of the message with the given nonce and payload hash
+// sent on the lane from source to dest. It is the SHA-256 of the 57 byte preimage
+//
+//	version (1 byte) || source (8 bytes) || dest (8 bytes) || nonce (8 bytes) || payloadHash (32 bytes)
+//
+// with the integers big-endian, which contracts compute as
+// sha256(abi.encodePacked(uint8(1), uint64(source), uint64(dest), uint64(nonce), payloadHash)).
+// The lane must be valid, see Lane.Validate.
+func ComputeMessageID(sourceSelector, destSelector, nonce uint64, payloadHash [32]byte) (MessageID, error) {
+	lane, err := NewLane(sourceSelector, destSelector)
+	if err != nil {
+		return MessageID{}, err
+	}
+
+	var preimage [1 + 8 + 8 + 8 + 32]byte
+	preimage[0] = MessageIDVersion
+	laneID := lane.ID()
+	copy(preimage[1:17], laneID[:])
+	binary.BigEndian.PutUint64(preimage[17:25], nonce)
+	copy(preimage[25:], payloadHash[:])
+	return sha256.Sum256(preimage[:]), nil
+}
+
+// String returns the 0x prefixed hex encoding of the identifier.
+func (id MessageID) String() string {
+	return "0x" + hex.EncodeToString(id[:])
+}
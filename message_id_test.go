@@ -0,0 +1,27 @@
+package chain_selectors
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComputeMessageID(t *testing.T) {
+	payloadHash := sha256.Sum256([]byte("hello"))
+
+	// pinned so the encoding can't change without bumping MessageIDVersion
+	id, err := ComputeMessageID(ETHEREUM_MAINNET.Selector, ETHEREUM_MAINNET_ARBITRUM_1.Selector, 7, payloadHash)
+	require.NoError(t, err)
+	assert.Equal(t, "0xd967a4f68f1516437b8c7e9e62c504cd6738eaab459829e3c4998017d055726a", id.String())
+
+	reverse, err := ComputeMessageID(ETHEREUM_MAINNET_ARBITRUM_1.Selector, ETHEREUM_MAINNET.Selector, 7, payloadHash)
+	require.NoError(t, err)
+	assert.NotEqual(t, id, reverse)
+
+	_, err = ComputeMessageID(ETHEREUM_MAINNET.Selector, ETHEREUM_MAINNET.Selector, 7, payloadHash)
+	require.Error(t, err)
+	_, err = ComputeMessageID(ETHEREUM_MAINNET.Selector, 1, 7, payloadHash)
+	require.Error(t, err)
+}
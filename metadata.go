@@ -0,0 +1,237 @@
+package chain_selectors
+
+import (
+	_ "embed"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+//go:embed metadata.yml
+var metadataYml []byte
+
+// NativeCurrency describes the token used to pay for gas on a chain.
+type NativeCurrency struct {
+	Name     string `yaml:"name" json:"name"`
+	Symbol   string `yaml:"symbol" json:"symbol"`
+	Decimals uint8  `yaml:"decimals" json:"decimals"`
+}
+
+// ChainMetadata holds descriptive, non-identifying information about a chain.
+type ChainMetadata struct {
+	DisplayName    string         `yaml:"display_name,omitempty"`
+	NativeCurrency NativeCurrency `yaml:"native_currency"`
+	Explorers      []string       `yaml:"explorers,omitempty"`
+	RPCs           []string       `yaml:"rpcs,omitempty"`
+	// FinalityDepth is the number of blocks after which a block is final, zero if unknown.
+	FinalityDepth uint64 `yaml:"finality_depth,omitempty"`
+	// CoinType is the SLIP-44 coin type keys of the chain are derived with, zero if unknown.
+	CoinType uint32 `yaml:"coin_type,omitempty"`
+	// LogoURI locates the logo of the chain, an https URL or a data URI of a small image.
+	LogoURI string `yaml:"logo_uri,omitempty"`
+	// BrandColor is the color associated with the chain, formatted "#RRGGBB".
+	BrandColor string `yaml:"brand_color,omitempty"`
+	// Website is the official website of the chain.
+	Website string `yaml:"website,omitempty"`
+	// Docs is the developer documentation of the chain.
+	Docs string `yaml:"docs,omitempty"`
+	// Faucets hand out test funds, only testnets and devnets have some.
+	Faucets []string `yaml:"faucets,omitempty"`
+}
+
+var metadataBySelector = onceValue(func() map[uint64]ChainMetadata { return parseMetadataYml(metadataYml) })
+
+func parseMetadataYml(ymlFile []byte) map[uint64]ChainMetadata {
+	type ymlData struct {
+		Metadata map[uint64]ChainMetadata `yaml:"metadata"`
+	}
+
+	var data ymlData
+	err := yaml.Unmarshal(ymlFile, &data)
+	if err != nil {
+		panic(err)
+	}
+
+	for selector, metadata := range data.Metadata {
+		if err := validateMetadata(metadata); err != nil {
+			panic(fmt.Errorf("metadata of selector %d: %w", selector, err))
+		}
+	}
+	return data.Metadata
+}
+
+// validateMetadata checks the fields of the metadata that have a format.
+func validateMetadata(metadata ChainMetadata) error {
+	if err := validateLogoURI(metadata.LogoURI); err != nil {
+		return err
+	}
+	if err := validateBrandColor(metadata.BrandColor); err != nil {
+		return err
+	}
+	if err := validateURL(metadata.Website); err != nil {
+		return fmt.Errorf("website: %w", err)
+	}
+	if err := validateURL(metadata.Docs); err != nil {
+		return fmt.Errorf("docs: %w", err)
+	}
+	for _, faucet := range metadata.Faucets {
+		if err := validateURL(faucet); err != nil || faucet == "" {
+			return fmt.Errorf("faucet %q must be an absolute https URL", faucet)
+		}
+	}
+	return nil
+}
+
+// validateURL accepts an empty URL and absolute https URLs.
+func validateURL(uri string) error {
+	if uri == "" {
+		return nil
+	}
+	parsed, err := url.Parse(uri)
+	if err != nil {
+		return fmt.Errorf("invalid url: %w", err)
+	}
+	if parsed.Scheme != "https" || parsed.Host == "" {
+		return fmt.Errorf("url %q must be an absolute https URL", uri)
+	}
+	return nil
+}
+
+// validateBrandColor accepts an empty color and "#RRGGBB" hex colors.
+func validateBrandColor(color string) error {
+	if color == "" {
+		return nil
+	}
+	if len(color) != len("#RRGGBB") || color[0] != '#' {
+		return fmt.Errorf("brand color %q must be formatted #RRGGBB", color)
+	}
+	if _, err := strconv.ParseUint(color[1:], 16, 32); err != nil {
+		return fmt.Errorf("brand color %q must be formatted #RRGGBB", color)
+	}
+	return nil
+}
+
+// validateLogoURI accepts an empty URI, https URLs and data URIs of images.
+func validateLogoURI(uri string) error {
+	if uri == "" {
+		return nil
+	}
+	parsed, err := url.Parse(uri)
+	if err != nil {
+		return fmt.Errorf("invalid logo uri: %w", err)
+	}
+	if validateURL(uri) == nil || (parsed.Scheme == "data" && strings.HasPrefix(parsed.Opaque, "image/")) {
+		return nil
+	}
+	return fmt.Errorf("logo uri %q must be an https URL or a data URI of an image", uri)
+}
+
+// GetChainMetadata returns the metadata of the chain identified by the selector, including the
+// metadata registered for custom chains, see RegisterCustomChains and LoadDevnetManifest.
+func GetChainMetadata(selector uint64) (ChainMetadata, error) {
+	metadata, exist := metadataBySelector()[selector]
+	if !exist && isCustomSelector(selector) {
+		if chainID, err := extractChainIdFromCustomSelector(selector); err == nil {
+			metadata, exist = customChains.chainMetadata(chainID)
+		}
+	}
+	if !exist {
+		return ChainMetadata{}, lookupError(InputSelector, "", strconv.FormatUint(selector, 10), "metadata not found")
+	}
+	return metadata.clone(), nil
+}
+
+// DisplayName returns the name of the chain identified by the selector to show to users, e.g.
+// "Ethereum Mainnet" for ethereum-mainnet. Chains without a display name, in metadata.yml or in
+// the metadata registered with RegisterCustomChains, are shown under their canonical name rather
+// than one derived from it, so what users see can always be mapped back to the chain.
+func DisplayName(selector uint64) (string, error) {
+	if metadata, err := GetChainMetadata(selector); err == nil && metadata.DisplayName != "" {
+		return metadata.DisplayName, nil
+	}
+	details, err := GetChainDetailsBySelector(selector)
+	if err != nil {
+		return "", err
+	}
+	if details.ChainName == "" {
+		return strconv.FormatUint(selector, 10), nil
+	}
+	return details.ChainName, nil
+}
+
+// LogoURI returns the location of the logo of the chain identified by the selector, see
+// ChainMetadata.LogoURI, so dashboards render every chain with the same branding. It is empty
+// for known chains without a logo.
+func LogoURI(selector uint64) (string, error) {
+	if metadata, err := GetChainMetadata(selector); err == nil {
+		return metadata.LogoURI, nil
+	}
+	if _, err := GetChainDetailsBySelector(selector); err != nil {
+		return "", err
+	}
+	return "", nil
+}
+
+// BrandColor returns the "#RRGGBB" color of the chain identified by the selector, see
+// ChainMetadata.BrandColor, so UIs and dashboards grouping by chain color them alike. It is
+// empty for known chains without a brand color.
+func BrandColor(selector uint64) (string, error) {
+	if metadata, err := GetChainMetadata(selector); err == nil {
+		return metadata.BrandColor, nil
+	}
+	if _, err := GetChainDetailsBySelector(selector); err != nil {
+		return "", err
+	}
+	return "", nil
+}
+
+// WebsiteURL returns the official website of the chain identified by the selector, see
+// ChainMetadata.Website. It is empty for known chains without one.
+func WebsiteURL(selector uint64) (string, error) {
+	if metadata, err := GetChainMetadata(selector); err == nil {
+		return metadata.Website, nil
+	}
+	if _, err := GetChainDetailsBySelector(selector); err != nil {
+		return "", err
+	}
+	return "", nil
+}
+
+// DocsURL returns the developer documentation of the chain identified by the selector, see
+// ChainMetadata.Docs. It is empty for known chains without one.
+func DocsURL(selector uint64) (string, error) {
+	if metadata, err := GetChainMetadata(selector); err == nil {
+		return metadata.Docs, nil
+	}
+	if _, err := GetChainDetailsBySelector(selector); err != nil {
+		return "", err
+	}
+	return "", nil
+}
+
+// FaucetURLs returns the faucets handing out test funds on the chain identified by the
+// selector, see ChainMetadata.Faucets, so tooling can tell developers where to get some. It is
+// empty for mainnets and for test chains without a known faucet.
+func FaucetURLs(selector uint64) ([]string, error) {
+	environment, err := GetSelectorEnvironment(selector)
+	if err != nil {
+		return nil, err
+	}
+	if environment == EnvironmentMainnet {
+		return nil, nil
+	}
+	if metadata, err := GetChainMetadata(selector); err == nil {
+		return metadata.Faucets, nil
+	}
+	return nil, nil
+}
+
+func (m ChainMetadata) clone() ChainMetadata {
+	m.Explorers = append([]string(nil), m.Explorers...)
+	m.RPCs = append([]string(nil), m.RPCs...)
+	m.Faucets = append([]string(nil), m.Faucets...)
+	return m
+}
@@ -0,0 +1,145 @@
+package chain_selectors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetadataSelectorsAreKnownChains(t *testing.T) {
+	for selector := range metadataBySelector() {
+		_, err := getChainInfo(selector)
+		assert.NoError(t, err, "metadata.yml references unknown selector %d", selector)
+	}
+}
+
+func Test_GetChainMetadata(t *testing.T) {
+	metadata, err := GetChainMetadata(ETHEREUM_MAINNET.Selector)
+	require.NoError(t, err)
+	assert.Equal(t, "Ethereum Mainnet", metadata.DisplayName)
+	assert.Equal(t, NativeCurrency{Name: "Ether", Symbol: "ETH", Decimals: 18}, metadata.NativeCurrency)
+	assert.Equal(t, []string{"https://etherscan.io"}, metadata.Explorers)
+
+	metadata.Explorers[0] = "modified"
+	again, err := GetChainMetadata(ETHEREUM_MAINNET.Selector)
+	require.NoError(t, err)
+	assert.Equal(t, "https://etherscan.io", again.Explorers[0])
+
+	_, err = GetChainMetadata(ZORA_TESTNET.Selector)
+	require.Error(t, err)
+}
+
+func TestDisplayName(t *testing.T) {
+	tests := []struct {
+		name     string
+		selector uint64
+		expected string
+	}{
+		{name: "evm", selector: ETHEREUM_MAINNET.Selector, expected: "Ethereum Mainnet"},
+		{name: "solana", selector: SOLANA_MAINNET.Selector, expected: "Solana"},
+		{name: "canonical name without display name", selector: ZORA_TESTNET.Selector, expected: ZORA_TESTNET.Name},
+		{name: "custom chain", selector: generateCustomChainSelector(9388202), expected: generateCustomChainName(9388202)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			displayName, err := DisplayName(tt.selector)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, displayName)
+		})
+	}
+
+	_, err := DisplayName(1)
+	require.ErrorIs(t, err, ErrChainNotFound)
+}
+
+func TestValidateLogoURI(t *testing.T) {
+	for _, uri := range []string{"", "https://example.com/ethereum.svg", "data:image/svg+xml;base64,PHN2Zz48L3N2Zz4="} {
+		assert.NoError(t, validateLogoURI(uri), uri)
+	}
+	for _, uri := range []string{"http://example.com/ethereum.svg", "https://", "data:text/html,<script></script>", "ethereum.svg", "%zz"} {
+		assert.Error(t, validateLogoURI(uri), uri)
+	}
+}
+
+func TestLogoURI(t *testing.T) {
+	logo, err := LogoURI(ZORA_TESTNET.Selector)
+	require.NoError(t, err)
+	assert.Empty(t, logo, "known chains without a logo")
+
+	_, err = LogoURI(1)
+	require.ErrorIs(t, err, ErrChainNotFound)
+
+	chainID := uint64(9388203)
+	_, err = RegisterCustomChains([]CustomChainSpec{{
+		ChainID:  chainID,
+		Name:     "logo-devnet",
+		Metadata: &ChainMetadata{LogoURI: "https://example.com/logo-devnet.svg"},
+	}})
+	require.NoError(t, err)
+	logo, err = LogoURI(generateCustomChainSelector(chainID))
+	require.NoError(t, err)
+	assert.Equal(t, "https://example.com/logo-devnet.svg", logo)
+}
+
+func TestBrandColor(t *testing.T) {
+	color, err := BrandColor(ETHEREUM_MAINNET.Selector)
+	require.NoError(t, err)
+	assert.Equal(t, "#627EEA", color)
+
+	color, err = BrandColor(ZORA_TESTNET.Selector)
+	require.NoError(t, err)
+	assert.Empty(t, color, "known chains without a brand color")
+
+	_, err = BrandColor(1)
+	require.ErrorIs(t, err, ErrChainNotFound)
+
+	for _, color := range []string{"#fff", "627EEA", "#627EEG", "#627EEA0"} {
+		assert.Error(t, validateBrandColor(color), color)
+	}
+	_, err = RegisterCustomChains([]CustomChainSpec{{ChainID: 9388204, Name: "color-devnet", Metadata: &ChainMetadata{BrandColor: "red"}}})
+	require.Error(t, err)
+}
+
+func TestWebsiteAndDocsURL(t *testing.T) {
+	website, err := WebsiteURL(ETHEREUM_MAINNET_ARBITRUM_1.Selector)
+	require.NoError(t, err)
+	assert.Equal(t, "https://arbitrum.io", website)
+
+	docs, err := DocsURL(ETHEREUM_MAINNET_ARBITRUM_1.Selector)
+	require.NoError(t, err)
+	assert.Equal(t, "https://docs.arbitrum.io", docs)
+
+	website, err = WebsiteURL(ZORA_TESTNET.Selector)
+	require.NoError(t, err)
+	assert.Empty(t, website, "known chains without a website")
+
+	_, err = DocsURL(1)
+	require.ErrorIs(t, err, ErrChainNotFound)
+
+	assert.Error(t, validateMetadata(ChainMetadata{Website: "http://example.com"}))
+	assert.Error(t, validateMetadata(ChainMetadata{Docs: "docs.example.com"}))
+}
+
+func TestFaucetURLs(t *testing.T) {
+	faucets, err := FaucetURLs(ETHEREUM_TESTNET_SEPOLIA.Selector)
+	require.NoError(t, err)
+	assert.Contains(t, faucets, "https://faucets.chain.link/sepolia")
+
+	faucets, err = FaucetURLs(ETHEREUM_MAINNET.Selector)
+	require.NoError(t, err)
+	assert.Empty(t, faucets)
+
+	_, err = FaucetURLs(1)
+	require.ErrorIs(t, err, ErrChainNotFound)
+
+	for selector, metadata := range metadataBySelector() {
+		if len(metadata.Faucets) == 0 {
+			continue
+		}
+		environment, err := GetSelectorEnvironment(selector)
+		require.NoError(t, err)
+		assert.NotEqual(t, EnvironmentMainnet, environment, "mainnet %d lists faucets", selector)
+	}
+	assert.Error(t, validateMetadata(ChainMetadata{Faucets: []string{""}}))
+}
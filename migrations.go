@@ -0,0 +1,73 @@
+package chain_selectors
+
+import (
+	_ "embed"
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+//go:embed migrations.yml
+var migrationsYml []byte
+
+// SelectorMigration records the replacement of a retired selector.
+type SelectorMigration struct {
+	Old    uint64 `yaml:"old"`
+	New    uint64 `yaml:"new"`
+	Reason string `yaml:"reason"`
+}
+
+var selectorMigrations = onceValue(func() map[uint64]uint64 { return parseMigrationsYml(migrationsYml) })
+
+func parseMigrationsYml(ymlFile []byte) map[uint64]uint64 {
+	type ymlData struct {
+		Migrations []SelectorMigration `yaml:"migrations"`
+	}
+
+	var data ymlData
+	err := yaml.Unmarshal(ymlFile, &data)
+	if err != nil {
+		panic(err)
+	}
+
+	output := make(map[uint64]uint64, len(data.Migrations))
+	for _, migration := range data.Migrations {
+		if migration.Old == migration.New {
+			panic(fmt.Errorf("selector %d can't be migrated to itself", migration.Old))
+		}
+		if _, exist := output[migration.Old]; exist {
+			panic(fmt.Errorf("selector %d is migrated more than once", migration.Old))
+		}
+		output[migration.Old] = migration.New
+	}
+
+	for old := range output {
+		if _, err := followMigrations(output, old); err != nil {
+			panic(err)
+		}
+	}
+	return output
+}
+
+func followMigrations(migrations map[uint64]uint64, selector uint64) (uint64, error) {
+	visited := map[uint64]struct{}{selector: {}}
+	for {
+		next, exist := migrations[selector]
+		if !exist {
+			return selector, nil
+		}
+		if _, seen := visited[next]; seen {
+			return 0, fmt.Errorf("selector migrations form a cycle through %d", next)
+		}
+		visited[next] = struct{}{}
+		selector = next
+	}
+}
+
+// MigrateSelector translates a retired selector into the one currently identifying the chain.
+// Migrations are followed transitively. Selectors that were never retired are returned as is
+// with migrated set to false.
+func MigrateSelector(old uint64) (uint64, bool) {
+	current, _ := followMigrations(selectorMigrations(), old)
+	return current, current != old
+}
@@ -0,0 +1,58 @@
+package chain_selectors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrationsPointToKnownChains(t *testing.T) {
+	for old := range selectorMigrations() {
+		_, err := getChainInfo(old)
+		assert.Error(t, err, "retired selector %d is still used by a chain", old)
+
+		current, migrated := MigrateSelector(old)
+		require.True(t, migrated)
+		_, err = getChainInfo(current)
+		assert.NoError(t, err, "selector %d migrates to unknown selector %d", old, current)
+	}
+}
+
+func Test_MigrateSelector(t *testing.T) {
+	current, migrated := MigrateSelector(ETHEREUM_MAINNET.Selector)
+	assert.False(t, migrated)
+	assert.Equal(t, ETHEREUM_MAINNET.Selector, current)
+}
+
+func Test_FollowMigrations(t *testing.T) {
+	migrations := parseMigrationsYml([]byte(`
+migrations:
+  - old: 1
+    new: 2
+    reason: renamed
+  - old: 2
+    new: 3
+    reason: renamed again
+`))
+
+	current, err := followMigrations(migrations, 1)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), current)
+
+	current, err = followMigrations(migrations, 3)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), current)
+}
+
+func Test_ParseMigrationsYmlRejectsInvalidTables(t *testing.T) {
+	require.Panics(t, func() {
+		parseMigrationsYml([]byte("migrations:\n  - {old: 1, new: 1}\n"))
+	})
+	require.Panics(t, func() {
+		parseMigrationsYml([]byte("migrations:\n  - {old: 1, new: 2}\n  - {old: 1, new: 3}\n"))
+	})
+	require.Panics(t, func() {
+		parseMigrationsYml([]byte("migrations:\n  - {old: 1, new: 2}\n  - {old: 2, new: 1}\n"))
+	})
+}
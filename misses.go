@@ -0,0 +1,223 @@
+package chain_selectors
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// maxSuggestions is the number of candidates suggested for a miss.
+const maxSuggestions = 3
+
+// Suggestion is a known chain close to the input of a failed lookup.
+type Suggestion struct {
+	Family   string
+	ChainID  string
+	Name     string
+	Selector uint64
+}
+
+// String formats the suggestion as "name (chain id)".
+func (s Suggestion) String() string {
+	if s.Name == "" {
+		return fmt.Sprintf("%s (%d)", s.ChainID, s.Selector)
+	}
+	return fmt.Sprintf("%s (%s)", s.Name, s.ChainID)
+}
+
+// Miss records a failed lookup and the known chains closest to its input.
+type Miss struct {
+	InputKind InputKind
+	// Input is the selector, chain id or name the lookup was given, formatted in decimal for numbers.
+	Input string
+	// Family is the family the lookup was restricted to, empty if it was not.
+	Family      string
+	Suggestions []Suggestion
+	At          time.Time
+}
+
+// missLog keeps the most recent misses, it is disabled while its size is zero.
+type missLog struct {
+	mu      sync.Mutex
+	size    int
+	entries []Miss
+}
+
+var misses = &missLog{}
+
+// SetMissLogSize enables recording failed lookups, keeping the given number of most recent
+// ones available through LastMisses. While enabled, the errors of the failed lookups suggest
+// the closest known chains, e.g. "evm chain name sepolia: not found, did you mean
+// ethereum-testnet-sepolia (11155111)?". Zero, the default, disables recording and suggestions.
+func SetMissLogSize(size int) {
+	if size < 0 {
+		size = 0
+	}
+	misses.mu.Lock()
+	defer misses.mu.Unlock()
+	misses.size = size
+	if len(misses.entries) > size {
+		misses.entries = append([]Miss(nil), misses.entries[len(misses.entries)-size:]...)
+	}
+}
+
+// LastMisses returns the recorded failed lookups, oldest first.
+func LastMisses() []Miss {
+	misses.mu.Lock()
+	defer misses.mu.Unlock()
+	return append([]Miss(nil), misses.entries...)
+}
+
+func (l *missLog) enabled() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.size > 0
+}
+
+func (l *missLog) add(miss Miss) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.size == 0 {
+		return
+	}
+	l.entries = append(l.entries, miss)
+	if len(l.entries) > l.size {
+		l.entries = append(l.entries[:0], l.entries[len(l.entries)-l.size:]...)
+	}
+}
+
+// recordMiss records the failed lookup if the miss log is enabled, adding the closest known
+// chains to the error. It returns the error unchanged while the miss log is disabled.
+func recordMiss(e *LookupError) error {
+	if !misses.enabled() {
+		return e
+	}
+	e.Suggestions = suggest(e.InputKind, e.Family, e.Input)
+	misses.add(Miss{InputKind: e.InputKind, Input: e.Input, Family: e.Family, Suggestions: e.Suggestions, At: time.Now()})
+	return e
+}
+
+// suggest returns the known chains closest to the input, restricted to the family if given.
+func suggest(kind InputKind, family, input string) []Suggestion {
+	type candidate struct {
+		Suggestion
+		distance     int
+		fullDistance int
+	}
+
+	infoBySelector := lookupIndex().infoBySelector
+	if family != "" {
+		infoBySelector = familyIndex(family).infoBySelector
+	}
+	var candidates []candidate
+	for selector, info := range infoBySelector {
+		s := Suggestion{Family: info.Family, ChainID: info.ChainID, Name: info.ChainDetails.ChainName, Selector: selector}
+
+		var distance, fullDistance, maxDistance int
+		switch kind {
+		case InputSelector:
+			distance = editDistance(input, strconv.FormatUint(selector, 10))
+			fullDistance, maxDistance = distance, 2
+		case InputChainID:
+			distance = editDistance(input, info.ChainID)
+			fullDistance, maxDistance = distance, 1
+		case InputName:
+			if s.Name == "" {
+				continue
+			}
+			distance, fullDistance = nameDistance(input, s.Name)
+			for _, alias := range aliasesByName()[s.Name] {
+				if d := editDistance(input, alias); d < distance || (d == distance && d < fullDistance) {
+					distance, fullDistance = d, d
+				}
+			}
+			maxDistance = len(input) / 3
+		}
+		if distance <= maxDistance {
+			candidates = append(candidates, candidate{Suggestion: s, distance: distance, fullDistance: fullDistance})
+		}
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if a.distance != b.distance {
+			return a.distance < b.distance
+		}
+		if a.fullDistance != b.fullDistance {
+			return a.fullDistance < b.fullDistance
+		}
+		return a.Selector < b.Selector
+	})
+	if len(candidates) > maxSuggestions {
+		candidates = candidates[:maxSuggestions]
+	}
+
+	var suggestions []Suggestion
+	for _, c := range candidates {
+		suggestions = append(suggestions, c.Suggestion)
+	}
+	return suggestions
+}
+
+var (
+	aliasesByNameOnce sync.Once
+	aliasesByNameData map[string][]string
+)
+
+// aliasesByName returns the aliases of every chain name that has some.
+func aliasesByName() map[string][]string {
+	aliasesByNameOnce.Do(func() {
+		aliasesByNameData = make(map[string][]string, len(nameByAlias()))
+		for alias, name := range nameByAlias() {
+			aliasesByNameData[name] = append(aliasesByNameData[name], alias)
+		}
+	})
+	return aliasesByNameData
+}
+
+// nameDistance returns the edit distance between the input and the closest run of consecutive
+// dash separated segments of the name, so "sepolia" matches "ethereum-testnet-sepolia", and the
+// edit distance to the whole name to rank candidates matching equally well.
+func nameDistance(input, name string) (closest, full int) {
+	full = editDistance(input, name)
+	closest = full
+	segments := strings.Split(name, "-")
+	for start := range segments {
+		for end := start + 1; end <= len(segments); end++ {
+			if d := editDistance(input, strings.Join(segments[start:end], "-")); d < closest {
+				closest = d
+			}
+		}
+	}
+	return closest, full
+}
+
+// editDistance is the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = previous[j-1] + cost
+			if deletion := previous[j] + 1; deletion < current[j] {
+				current[j] = deletion
+			}
+			if insertion := current[j-1] + 1; insertion < current[j] {
+				current[j] = insertion
+			}
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
@@ -0,0 +1,62 @@
+package chain_selectors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMissLogSuggestions(t *testing.T) {
+	SetMissLogSize(2)
+	t.Cleanup(func() { SetMissLogSize(0) })
+
+	_, err := ChainIdFromName("sepolia")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "did you mean ethereum-testnet-sepolia (11155111)")
+
+	_, err = ChainIdFromName("etherum-mainnet")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "did you mean ethereum-mainnet (1)")
+
+	_, err = GetChainDetailsByChainIDAndFamily("5eykt4UsFv8P8NJdTREpY1vzqKqZKvdpKuc147dw2N9e", FamilySolana)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "did you mean solana-mainnet ("+SOLANA_MAINNET.ChainID+")")
+
+	missed := LastMisses()
+	require.Len(t, missed, 2, "only the most recent misses are kept")
+	assert.Equal(t, InputName, missed[0].InputKind)
+	assert.Equal(t, "etherum-mainnet", missed[0].Input)
+	assert.Equal(t, InputChainID, missed[1].InputKind)
+	assert.Equal(t, FamilySolana, missed[1].Family)
+	require.Len(t, missed[1].Suggestions, 1)
+	assert.Equal(t, SOLANA_MAINNET.Selector, missed[1].Suggestions[0].Selector)
+
+	_, err = GetChainDetailsBySelector(ETHEREUM_MAINNET.Selector + 1)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "ethereum-mainnet (1)")
+	assert.Equal(t, InputSelector, LastMisses()[1].InputKind)
+}
+
+func TestMissLogDisabledByDefault(t *testing.T) {
+	_, err := ChainIdFromName("sepolia")
+	require.EqualError(t, err, "evm chain name sepolia: not found")
+	assert.Empty(t, LastMisses())
+}
+
+func TestEditDistance(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected int
+	}{
+		{"", "", 0},
+		{"abc", "", 3},
+		{"", "abc", 3},
+		{"kitten", "sitting", 3},
+		{"11155111", "11155112", 1},
+		{"etherum", "ethereum", 1},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.expected, editDistance(test.a, test.b), "%q %q", test.a, test.b)
+	}
+}
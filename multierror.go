@@ -0,0 +1,82 @@
+package chain_selectors
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// ItemError is the failure of a single item of a batch operation.
+type ItemError struct {
+	// Index is the position of the item in the batch.
+	Index int
+	// Item describes the item, e.g. "custom chain 5500000100", it may be empty.
+	Item string
+	Err  error
+}
+
+func (e *ItemError) Error() string {
+	if e.Item == "" {
+		return fmt.Sprintf("index %d: %v", e.Index, e.Err)
+	}
+	return fmt.Sprintf("%s (index %d): %v", e.Item, e.Index, e.Err)
+}
+
+func (e *ItemError) Unwrap() error {
+	return e.Err
+}
+
+// MultiError aggregates the failures of the items of a batch lookup, a dataset load or a
+// validation, ordered by index. Its message is the one of errors.Join and errors.Is and
+// errors.As inspect every failure, callers acting on partial failures can use Indexes and
+// ForIndex.
+type MultiError struct {
+	Errors []*ItemError
+}
+
+func (m *MultiError) Error() string {
+	return errors.Join(m.Unwrap()...).Error()
+}
+
+func (m *MultiError) Unwrap() []error {
+	errs := make([]error, len(m.Errors))
+	for i, err := range m.Errors {
+		errs[i] = err
+	}
+	return errs
+}
+
+// Indexes returns the sorted indexes of the failed items.
+func (m *MultiError) Indexes() []int {
+	var indexes []int
+	for _, err := range m.Errors {
+		if len(indexes) == 0 || indexes[len(indexes)-1] != err.Index {
+			indexes = append(indexes, err.Index)
+		}
+	}
+	return indexes
+}
+
+// ForIndex returns why the item at the index failed, nil if it did not.
+func (m *MultiError) ForIndex(index int) error {
+	var errs []error
+	for _, err := range m.Errors {
+		if err.Index == index {
+			errs = append(errs, err.Err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (m *MultiError) add(index int, item string, err error) {
+	m.Errors = append(m.Errors, &ItemError{Index: index, Item: item, Err: err})
+}
+
+// errOrNil returns the MultiError if any item failed, so callers never return a typed nil.
+func (m *MultiError) errOrNil() error {
+	if len(m.Errors) == 0 {
+		return nil
+	}
+	sort.SliceStable(m.Errors, func(i, j int) bool { return m.Errors[i].Index < m.Errors[j].Index })
+	return m
+}
@@ -0,0 +1,71 @@
+package chain_selectors
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetChainDetailsBySelectorsPartialFailure(t *testing.T) {
+	details, err := GetChainDetailsBySelectors([]uint64{ETHEREUM_MAINNET.Selector, 1, SOLANA_MAINNET.Selector, 2})
+
+	var multi *MultiError
+	require.ErrorAs(t, err, &multi)
+	assert.Equal(t, []int{1, 3}, multi.Indexes())
+	assert.ErrorIs(t, multi.ForIndex(1), ErrChainNotFound)
+	assert.NoError(t, multi.ForIndex(0))
+	assert.ErrorIs(t, err, ErrChainNotFound)
+
+	var lookupErr *LookupError
+	require.ErrorAs(t, err, &lookupErr)
+	assert.Equal(t, "1", lookupErr.Input)
+
+	require.Len(t, details, 4)
+	assert.Equal(t, ETHEREUM_MAINNET.Name, details[0].ChainName)
+	assert.Equal(t, ChainDetails{}, details[1])
+	assert.Equal(t, SOLANA_MAINNET.Name, details[2].ChainName)
+
+	_, err = GetChainDetailsBySelectors([]uint64{ETHEREUM_MAINNET.Selector})
+	assert.NoError(t, err)
+}
+
+func TestRegistryLoadYAMLReportsEveryFailedChain(t *testing.T) {
+	registry := NewRegistry()
+	err := registry.LoadYAML([]byte(`
+selectors:
+  77001:
+    selector: 0
+    name: no-selector
+  77002:
+    selector: 21
+    name: valid
+  77003:
+    selector: 21
+    name: duplicate-selector
+`))
+
+	var multi *MultiError
+	require.ErrorAs(t, err, &multi)
+	assert.Equal(t, []int{0, 2}, multi.Indexes())
+	assert.EqualError(t, err, "chain 77001 (index 0): has no selector\nchain 77003 (index 2): selector 21 is already used by chain 77002")
+	assert.Empty(t, registry.loadState().evmByChainID, "a failed load must leave the registry unchanged")
+}
+
+func TestMultiErrorForIndexJoinsErrorsOfAnItem(t *testing.T) {
+	first, second := errors.New("first"), errors.New("second")
+	var multi MultiError
+	multi.add(2, "item", first)
+	multi.add(0, "", second)
+	multi.add(2, "item", second)
+
+	err := multi.errOrNil()
+	require.Error(t, err)
+	assert.Equal(t, []int{0, 2}, multi.Indexes())
+	assert.ErrorIs(t, multi.ForIndex(2), first)
+	assert.ErrorIs(t, multi.ForIndex(2), second)
+	assert.EqualError(t, err, "index 0: second\nitem (index 2): first\nitem (index 2): second")
+
+	assert.NoError(t, (&MultiError{}).errOrNil())
+}
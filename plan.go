@@ -0,0 +1,209 @@
+package chain_selectors
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Mutation is a change to the chains known to a Registry, planned with Registry.Plan.
+type Mutation struct {
+	ymlFile []byte
+	specs   []CustomChainSpec
+}
+
+// LoadYAMLMutation is the mutation applied by Registry.LoadYAML with the file.
+func LoadYAMLMutation(ymlFile []byte) Mutation {
+	return Mutation{ymlFile: ymlFile}
+}
+
+// RegisterCustomChainsMutation is the mutation applied by RegisterCustomChains with the specs.
+func RegisterCustomChainsMutation(specs ...CustomChainSpec) Mutation {
+	return Mutation{specs: specs}
+}
+
+// PlanAction is what applying a mutation would do to a chain.
+type PlanAction string
+
+const (
+	// PlanAdd adds a chain not loaded or registered before.
+	PlanAdd PlanAction = "add"
+	// PlanReplace changes the selector, name or details of a loaded or registered chain.
+	PlanReplace PlanAction = "replace"
+	// PlanConflict marks a chain the mutation cannot apply, failing the whole mutation.
+	PlanConflict PlanAction = "conflict"
+)
+
+// PlannedChange is the effect of a mutation on a single EVM chain.
+type PlannedChange struct {
+	// Mutation is the index of the mutation in the planned ones.
+	Mutation int
+	Action   PlanAction
+	ChainID  uint64
+	// Before is nil for added chains and chains not known before a conflict.
+	Before *Chain
+	// After is the chain the mutation asked for.
+	After Chain
+	// Err is why the chain conflicts, nil unless Action is PlanConflict.
+	Err error
+}
+
+// String formats the change as a line of a diff, e.g. "+ 77001 devnet-a (11)".
+func (c PlannedChange) String() string {
+	switch c.Action {
+	case PlanAdd:
+		return fmt.Sprintf("+ %d %s (%d)", c.ChainID, c.After.Name, c.After.Selector)
+	case PlanReplace:
+		return fmt.Sprintf("~ %d %s (%d) -> %s (%d)", c.ChainID, c.Before.Name, c.Before.Selector, c.After.Name, c.After.Selector)
+	default:
+		return fmt.Sprintf("! %d %s (%d): %v", c.ChainID, c.After.Name, c.After.Selector, c.Err)
+	}
+}
+
+// Plan is the effect of applying mutations, in the order they would be applied and, within a
+// loaded file, in chain id order.
+type Plan struct {
+	Changes []PlannedChange
+}
+
+// Conflicts returns the changes that cannot be applied.
+func (p Plan) Conflicts() []PlannedChange {
+	var conflicts []PlannedChange
+	for _, c := range p.Changes {
+		if c.Action == PlanConflict {
+			conflicts = append(conflicts, c)
+		}
+	}
+	return conflicts
+}
+
+// Empty reports whether applying the mutations would change nothing.
+func (p Plan) Empty() bool {
+	return len(p.Changes) == 0
+}
+
+// String formats the plan as a diff, one change per line.
+func (p Plan) String() string {
+	lines := make([]string, len(p.Changes))
+	for i, c := range p.Changes {
+		lines[i] = c.String()
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Plan computes what applying the mutations in order would change, without applying them, so
+// the effect of a deployment can be reviewed first. Like LoadYAML and RegisterCustomChains,
+// a mutation applies atomically: a mutation with a conflict applies none of its chains and the
+// following mutations are planned as if it was not applied. Mutations leaving a chain
+// unchanged plan nothing for it.
+//
+// Custom chain registrations are planned against the chains registered in the process, which
+// may change before they are applied. Plan fails only if a file cannot be decoded.
+func (r *Registry) Plan(mutations ...Mutation) (Plan, error) {
+	var plan Plan
+	state := r.loadState()
+	registered := *customChains.names.Load()
+
+	for i, mutation := range mutations {
+		if mutation.specs != nil {
+			var changes []PlannedChange
+			changes, registered = planCustomChains(i, registered, mutation.specs)
+			plan.Changes = append(plan.Changes, changes...)
+			continue
+		}
+
+		chains, err := decodeSelectorsYml(mutation.ymlFile)
+		if err != nil {
+			return Plan{}, fmt.Errorf("mutation %d: failed to decode selectors: %w", i, err)
+		}
+		next, err := state.withEVMChains(chains, Provenance{Source: ProvenanceOverride})
+		if err != nil {
+			plan.Changes = append(plan.Changes, planConflicts(i, state, chains, err)...)
+			continue
+		}
+		changes := diffStates(state, next, ProvenanceOverride)
+		var planned []PlannedChange
+		for _, ch := range changes.Added {
+			planned = append(planned, PlannedChange{Mutation: i, Action: PlanAdd, ChainID: ch.EvmChainID, After: ch})
+		}
+		for _, ch := range changes.Updated {
+			before := state.evmByChainID[ch.EvmChainID]
+			planned = append(planned, PlannedChange{Mutation: i, Action: PlanReplace, ChainID: ch.EvmChainID, Before: &before, After: ch})
+		}
+		sort.Slice(planned, func(a, b int) bool { return planned[a].ChainID < planned[b].ChainID })
+		plan.Changes = append(plan.Changes, planned...)
+		state = next
+	}
+	return plan, nil
+}
+
+// planConflicts lists the chains of a file the state rejected with err, the *MultiError of
+// withEVMChains indexing them in chain id order.
+func planConflicts(mutation int, state *registryState, chains map[uint64]ChainDetails, err error) []PlannedChange {
+	chainIDs := make([]uint64, 0, len(chains))
+	for chainID := range chains {
+		chainIDs = append(chainIDs, chainID)
+	}
+	sort.Slice(chainIDs, func(i, j int) bool { return chainIDs[i] < chainIDs[j] })
+
+	var multi *MultiError
+	if !errors.As(err, &multi) {
+		return []PlannedChange{{Mutation: mutation, Action: PlanConflict, Err: err}}
+	}
+	var conflicts []PlannedChange
+	for _, itemErr := range multi.Errors {
+		chainID := chainIDs[itemErr.Index]
+		details := chains[chainID]
+		conflict := PlannedChange{
+			Mutation: mutation,
+			Action:   PlanConflict,
+			ChainID:  chainID,
+			After:    Chain{EvmChainID: chainID, Selector: details.ChainSelector, Name: details.ChainName},
+			Err:      itemErr.Err,
+		}
+		if before, exists := state.evmByChainID[chainID]; exists {
+			conflict.Before = &before
+		}
+		conflicts = append(conflicts, conflict)
+	}
+	return conflicts
+}
+
+// planCustomChains plans the registration of the specs on top of the registered custom chains
+// and returns the registered chains after it.
+func planCustomChains(mutation int, registered map[uint64]string, specs []CustomChainSpec) ([]PlannedChange, map[uint64]string) {
+	customChain := func(chainID uint64, name string) Chain {
+		return Chain{EvmChainID: chainID, Selector: generateCustomChainSelector(chainID), Name: name}
+	}
+
+	if err := validateCustomChainSpecs(registered, specs); err != nil {
+		var multi *MultiError
+		errors.As(err, &multi)
+		var conflicts []PlannedChange
+		for _, itemErr := range multi.Errors {
+			spec := specs[itemErr.Index]
+			conflict := PlannedChange{Mutation: mutation, Action: PlanConflict, ChainID: spec.ChainID, After: customChain(spec.ChainID, spec.Name), Err: itemErr.Err}
+			if name, exists := registered[spec.ChainID]; exists {
+				before := customChain(spec.ChainID, name)
+				conflict.Before = &before
+			}
+			conflicts = append(conflicts, conflict)
+		}
+		return conflicts, registered
+	}
+
+	// specs may only register chains again under the same name, so a valid mutation only adds
+	var changes []PlannedChange
+	next := make(map[uint64]string, len(registered)+len(specs))
+	for chainID, name := range registered {
+		next[chainID] = name
+	}
+	for _, spec := range specs {
+		if _, exists := registered[spec.ChainID]; !exists {
+			changes = append(changes, PlannedChange{Mutation: mutation, Action: PlanAdd, ChainID: spec.ChainID, After: customChain(spec.ChainID, spec.Name)})
+		}
+		next[spec.ChainID] = spec.Name
+	}
+	return changes, next
+}
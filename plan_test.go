@@ -0,0 +1,55 @@
+package chain_selectors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistryPlan(t *testing.T) {
+	registry := NewRegistry()
+	require.NoError(t, registry.LoadYAML([]byte("selectors:\n  77001:\n    selector: 11\n    name: devnet-a\n")))
+	RegisterCustomChain(5500000401, "planned-existing")
+
+	plan, err := registry.Plan(
+		LoadYAMLMutation([]byte("selectors:\n  77001:\n    selector: 12\n    name: devnet-b\n  77002:\n    selector: 21\n    name: devnet-c\n")),
+		LoadYAMLMutation([]byte("selectors:\n  77003:\n    selector: 21\n    name: devnet-d\n  77004:\n    selector: 41\n    name: devnet-e\n")),
+		RegisterCustomChainsMutation(
+			CustomChainSpec{ChainID: 5500000401, Name: "planned-existing"},
+			CustomChainSpec{ChainID: 5500000402, Name: "planned-new"},
+		),
+		RegisterCustomChainsMutation(CustomChainSpec{ChainID: 5500000401, Name: "planned-renamed"}),
+	)
+	require.NoError(t, err)
+
+	require.Len(t, plan.Changes, 5)
+	assert.Equal(t, PlannedChange{Mutation: 0, Action: PlanReplace, ChainID: 77001,
+		Before: &Chain{EvmChainID: 77001, Selector: 11, Name: "devnet-a", VarName: "DEVNET_A"},
+		After:  Chain{EvmChainID: 77001, Selector: 12, Name: "devnet-b", VarName: "DEVNET_B"}}, plan.Changes[0])
+	assert.Equal(t, PlanAdd, plan.Changes[1].Action)
+	assert.Equal(t, uint64(77002), plan.Changes[1].ChainID)
+
+	// the second file conflicts with the first, so none of its chains would be loaded
+	assert.Equal(t, PlanConflict, plan.Changes[2].Action)
+	assert.Equal(t, uint64(77003), plan.Changes[2].ChainID)
+	assert.ErrorContains(t, plan.Changes[2].Err, "selector 21 is already used by chain 77002")
+
+	assert.Equal(t, PlannedChange{Mutation: 2, Action: PlanAdd, ChainID: 5500000402,
+		After: Chain{EvmChainID: 5500000402, Selector: generateCustomChainSelector(5500000402), Name: "planned-new"}}, plan.Changes[3])
+	assert.Equal(t, PlanConflict, plan.Changes[4].Action)
+	require.NotNil(t, plan.Changes[4].Before)
+	assert.Equal(t, "planned-existing", plan.Changes[4].Before.Name)
+	assert.Len(t, plan.Conflicts(), 2)
+
+	assert.Equal(t, "~ 77001 devnet-a (11) -> devnet-b (12)", plan.Changes[0].String())
+	assert.Equal(t, "+ 77002 devnet-c (21)", plan.Changes[1].String())
+
+	_, exists := registry.ChainBySelector(21)
+	assert.False(t, exists, "planning applies nothing")
+	_, registered := customChains.name(5500000402)
+	assert.False(t, registered)
+
+	_, err = registry.Plan(LoadYAMLMutation([]byte("selectors: [")))
+	require.Error(t, err)
+}
@@ -0,0 +1,198 @@
+package chain_selectors
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// PrivateSelectorStart is the first selector of the band reserved for the private chains of
+	// a consortium, see ParsePrivateChains. The band lies outside the 0xE prefixed custom range,
+	// whose selectors encode chain ids, so no generated custom selector falls in it, and
+	// ValidateOfficialSelector keeps official selectors out of it.
+	PrivateSelectorStart = uint64(0xF9A0000000000000)
+	// PrivateSelectorCount is the number of selectors in the private band.
+	PrivateSelectorCount = uint64(1 << 24)
+)
+
+// PrivateChain is a permissioned EVM chain of a consortium, which must never appear in the
+// public dataset. Owner and Contact identify who operates the chain.
+type PrivateChain struct {
+	ChainID  uint64
+	Selector uint64
+	Name     string
+	Owner    string
+	Contact  string
+}
+
+// ParsePrivateChains decodes and validates a registrar file of private chains, sorted by chain
+// id in the returned slice:
+//
+//	private:
+//	  <chain id>:
+//	    selector: <selector in the private band>
+//	    name: <name>
+//	    owner: <team or member operating the chain>
+//	    contact: <how to reach the owner>
+//
+// Every field is required. A chain fails validation if its selector is outside the private band
+// or its chain id, selector or name is already used, by the public dataset or another private
+// chain. The returned *MultiError indexes the failed chains in chain id order.
+func ParsePrivateChains(ymlFile []byte) ([]PrivateChain, error) {
+	var data privateRegistrarYml
+	// duplicated chain ids are rejected by the decoder
+	if err := yaml.Unmarshal(ymlFile, &data); err != nil {
+		return nil, fmt.Errorf("failed to decode private chains: %w", err)
+	}
+
+	chains := make([]PrivateChain, 0, len(data.Private))
+	for chainID, entry := range data.Private {
+		chains = append(chains, PrivateChain{ChainID: chainID, Selector: entry.Selector, Name: entry.Name, Owner: entry.Owner, Contact: entry.Contact})
+	}
+	sort.Slice(chains, func(i, j int) bool { return chains[i].ChainID < chains[j].ChainID })
+
+	var errs MultiError
+	set := newPrivateChainSet()
+	for i, ch := range chains {
+		if err := set.add(ch); err != nil {
+			errs.add(i, fmt.Sprintf("private chain %d", ch.ChainID), err)
+		}
+	}
+	if err := errs.errOrNil(); err != nil {
+		return nil, err
+	}
+	return chains, nil
+}
+
+// EncodePrivateChains encodes the private chains as a registrar file, see ParsePrivateChains.
+// It does not validate them.
+func EncodePrivateChains(chains []PrivateChain) ([]byte, error) {
+	data := privateRegistrarYml{Private: make(map[uint64]privateRegistrarEntry, len(chains))}
+	for _, ch := range chains {
+		if _, exists := data.Private[ch.ChainID]; exists {
+			return nil, fmt.Errorf("private chain %d is listed twice", ch.ChainID)
+		}
+		data.Private[ch.ChainID] = privateRegistrarEntry{Selector: ch.Selector, Name: ch.Name, Owner: ch.Owner, Contact: ch.Contact}
+	}
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(data); err != nil {
+		return nil, err
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+type privateRegistrarYml struct {
+	Private map[uint64]privateRegistrarEntry `yaml:"private"`
+}
+
+type privateRegistrarEntry struct {
+	Selector uint64 `yaml:"selector"`
+	Name     string `yaml:"name"`
+	Owner    string `yaml:"owner"`
+	Contact  string `yaml:"contact"`
+}
+
+// privateChainSet validates private chains one at a time, against the public dataset and the
+// chains added before.
+type privateChainSet struct {
+	chainIDs  map[uint64]bool
+	selectors map[uint64]uint64
+	names     map[string]uint64
+}
+
+func newPrivateChainSet() *privateChainSet {
+	return &privateChainSet{chainIDs: make(map[uint64]bool), selectors: make(map[uint64]uint64), names: make(map[string]uint64)}
+}
+
+// add validates the chain and adds it to the set if it is valid.
+func (s *privateChainSet) add(ch PrivateChain) error {
+	if err := validatePrivateChain(ch); err != nil {
+		return err
+	}
+	if s.chainIDs[ch.ChainID] {
+		return fmt.Errorf("chain id %d is already used by another private chain", ch.ChainID)
+	}
+	if other, exists := s.selectors[ch.Selector]; exists {
+		return fmt.Errorf("selector %d is already used by private chain %d", ch.Selector, other)
+	}
+	if other, exists := s.names[ch.Name]; exists {
+		return fmt.Errorf("name %s is already used by private chain %d", ch.Name, other)
+	}
+	s.record(ch)
+	return nil
+}
+
+// record adds the chain to the set without validating it, for chains validated already.
+func (s *privateChainSet) record(ch PrivateChain) {
+	s.chainIDs[ch.ChainID] = true
+	s.selectors[ch.Selector] = ch.ChainID
+	s.names[ch.Name] = ch.ChainID
+}
+
+// validatePrivateChain checks a private chain against the private band and the public dataset.
+func validatePrivateChain(ch PrivateChain) error {
+	if ch.Selector == 0 || ch.Name == "" || ch.Owner == "" || ch.Contact == "" {
+		return errors.New("must have a selector, a name, an owner and a contact")
+	}
+	if r := RangeFor(ch.Selector); r.Kind != RangeKindPrivate {
+		return fmt.Errorf("selector %d lies in the %s range, not in the private band [%d, %d]", ch.Selector, r.Name, PrivateSelectorStart, PrivateSelectorStart+PrivateSelectorCount-1)
+	}
+	if _, exists := chainDetailsBySelector()[ch.Selector]; exists {
+		return fmt.Errorf("selector %d is public", ch.Selector)
+	}
+	if public, exists := evmChainIdToChainSelector()[ch.ChainID]; exists {
+		return fmt.Errorf("chain id %d is public, as %s", ch.ChainID, public.ChainName)
+	}
+	if _, exists := evmForksByChainId()[ch.ChainID]; exists {
+		return fmt.Errorf("chain id %d is public, as a fork", ch.ChainID)
+	}
+	if public, exists := evmChainsByName()[ch.Name]; exists {
+		return fmt.Errorf("name %s is public, for chain %d", ch.Name, public.EvmChainID)
+	}
+	return nil
+}
+
+// WithPrivateChains makes the registry resolve the private chains, as returned by
+// ParsePrivateChains, like chains loaded into it, attributed to ProvenancePrivate. They are
+// resolved whether or not the embedded chains are. NewRegistry panics if the chains are
+// invalid, which ParsePrivateChains rules out.
+func WithPrivateChains(chains []PrivateChain) RegistryOption {
+	return func(r *Registry) {
+		r.private = make(map[uint64]PrivateChain, len(chains))
+		for _, ch := range chains {
+			r.private[ch.Selector] = ch
+		}
+	}
+}
+
+// privateState returns the state holding the private chains of the registry.
+func (r *Registry) privateState() *registryState {
+	if len(r.private) == 0 {
+		return emptyRegistryState
+	}
+	chains := make(map[uint64]ChainDetails, len(r.private))
+	for _, ch := range r.private {
+		chains[ch.ChainID] = ChainDetails{ChainSelector: ch.Selector, ChainName: ch.Name, Owner: ch.Owner}
+	}
+	state, err := emptyRegistryState.withEVMChains(chains, Provenance{Source: ProvenancePrivate, LoadedAt: time.Now()})
+	if err != nil {
+		panic(fmt.Errorf("invalid private chains: %w", err))
+	}
+	return state
+}
+
+// PrivateChain returns the private chain of the registry identified by the selector.
+func (r *Registry) PrivateChain(selector uint64) (PrivateChain, bool) {
+	ch, exists := r.private[selector]
+	return ch, exists
+}
@@ -0,0 +1,183 @@
+package chain_selectors
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// CSVMapping names the columns of a spreadsheet exported as CSV holding private chains, see
+// ImportCSV. Header cells match regardless of case and surrounding spaces. Selector may be left
+// empty when the spreadsheet has no selector column.
+type CSVMapping struct {
+	ChainID  string
+	Selector string
+	Name     string
+	Owner    string
+	Contact  string
+}
+
+// DefaultCSVMapping maps the columns named after the fields of the registrar file.
+var DefaultCSVMapping = CSVMapping{ChainID: "chain_id", Selector: "selector", Name: "name", Owner: "owner", Contact: "contact"}
+
+// ImportCSV reads private chains from a CSV file whose first row is a header, mapped to the
+// fields of PrivateChain by mapping, to onboard the chains of a partner in bulk. Rows without a
+// selector get the lowest free selectors of the private band, in row order. The chains are
+// validated like the ones of ParsePrivateChains, against each other and the existing private
+// chains, e.g. the ones of the registrar file the import is for.
+//
+// The chains are returned in row order, ready for WithPrivateChains or, appended to the
+// existing ones, EncodePrivateChains. If any row is invalid none is returned and the
+// *MultiError reports every invalid row, indexed from 0 for the first row after the header and
+// labelled with its line in the file.
+func ImportCSV(r io.Reader, mapping CSVMapping, existing ...PrivateChain) ([]PrivateChain, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read csv header: %w", err)
+	}
+	columns, err := mapping.columns(header)
+	if err != nil {
+		return nil, err
+	}
+
+	var errs MultiError
+	var chains []PrivateChain
+	var labels []string
+	var autoSelector []bool
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read csv: %w", err)
+		}
+		line, _ := reader.FieldPos(0)
+		label := fmt.Sprintf("line %d", line)
+		ch, err := columns.parse(record)
+		if err != nil {
+			errs.add(len(chains), label, err)
+		}
+		chains = append(chains, ch)
+		labels = append(labels, label)
+		autoSelector = append(autoSelector, ch.Selector == 0)
+	}
+
+	// selectors are assigned once every explicit one is known, so none is taken twice
+	used := make(map[uint64]bool, len(existing)+len(chains))
+	for _, ch := range existing {
+		used[ch.Selector] = true
+	}
+	for _, ch := range chains {
+		used[ch.Selector] = true
+	}
+	next := PrivateSelectorStart
+	for i := range chains {
+		if !autoSelector[i] {
+			continue
+		}
+		for used[next] && next < PrivateSelectorStart+PrivateSelectorCount {
+			next++
+		}
+		if next == PrivateSelectorStart+PrivateSelectorCount {
+			errs.add(i, labels[i], errors.New("the private band is full"))
+			continue
+		}
+		chains[i].Selector = next
+		used[next] = true
+	}
+
+	set := newPrivateChainSet()
+	for _, ch := range existing {
+		set.record(ch)
+	}
+	failed := make(map[int]bool, len(errs.Errors))
+	for _, itemErr := range errs.Errors {
+		failed[itemErr.Index] = true
+	}
+	for i, ch := range chains {
+		if failed[i] {
+			continue
+		}
+		if err := set.add(ch); err != nil {
+			errs.add(i, labels[i], err)
+		}
+	}
+	// parse and validation errors are reported in row order
+	sort.SliceStable(errs.Errors, func(i, j int) bool { return errs.Errors[i].Index < errs.Errors[j].Index })
+	if err := errs.errOrNil(); err != nil {
+		return nil, err
+	}
+	return chains, nil
+}
+
+// csvColumns holds the index of the column of every field, -1 for the selector if there is none.
+type csvColumns struct {
+	chainID, selector, name, owner, contact int
+}
+
+func (m CSVMapping) columns(header []string) (csvColumns, error) {
+	find := func(field, column string, required bool) (int, error) {
+		if column == "" {
+			if required {
+				return 0, fmt.Errorf("csv mapping has no column for the %s", field)
+			}
+			return -1, nil
+		}
+		for i, cell := range header {
+			if strings.EqualFold(strings.TrimSpace(cell), strings.TrimSpace(column)) {
+				return i, nil
+			}
+		}
+		return 0, fmt.Errorf("csv header has no %q column for the %s", column, field)
+	}
+
+	var c csvColumns
+	var err error
+	if c.chainID, err = find("chain id", m.ChainID, true); err != nil {
+		return c, err
+	}
+	if c.selector, err = find("selector", m.Selector, false); err != nil {
+		return c, err
+	}
+	if c.name, err = find("name", m.Name, true); err != nil {
+		return c, err
+	}
+	if c.owner, err = find("owner", m.Owner, true); err != nil {
+		return c, err
+	}
+	if c.contact, err = find("contact", m.Contact, true); err != nil {
+		return c, err
+	}
+	return c, nil
+}
+
+// parse reads the chain of a row, its selector is 0 if the row has none.
+func (c csvColumns) parse(record []string) (PrivateChain, error) {
+	cell := func(column int) string {
+		if column < 0 || column >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[column])
+	}
+
+	ch := PrivateChain{Name: cell(c.name), Owner: cell(c.owner), Contact: cell(c.contact)}
+	chainID, err := strconv.ParseUint(cell(c.chainID), 10, 64)
+	if err != nil {
+		return ch, fmt.Errorf("invalid chain id %q", cell(c.chainID))
+	}
+	ch.ChainID = chainID
+	if selector := cell(c.selector); selector != "" {
+		if ch.Selector, err = strconv.ParseUint(selector, 10, 64); err != nil {
+			return ch, fmt.Errorf("invalid selector %q", selector)
+		}
+	}
+	return ch, nil
+}
@@ -0,0 +1,68 @@
+package chain_selectors
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ImportCSV(t *testing.T) {
+	existing := []PrivateChain{{ChainID: 880001, Selector: PrivateSelectorStart, Name: "consortium-clearing", Owner: "ops", Contact: "ops@consortium.example"}}
+	csvFile := "Network ID,Network,Operator,Email\n" +
+		"880002,partner-settlement,Partner Ops,ops@partner.example\n" +
+		"880003, partner-custody ,Partner Ops,ops@partner.example\n"
+	mapping := CSVMapping{ChainID: "network id", Name: "Network", Owner: "operator", Contact: "email"}
+
+	chains, err := ImportCSV(strings.NewReader(csvFile), mapping, existing...)
+	require.NoError(t, err)
+	assert.Equal(t, []PrivateChain{
+		{ChainID: 880002, Selector: PrivateSelectorStart + 1, Name: "partner-settlement", Owner: "Partner Ops", Contact: "ops@partner.example"},
+		{ChainID: 880003, Selector: PrivateSelectorStart + 2, Name: "partner-custody", Owner: "Partner Ops", Contact: "ops@partner.example"},
+	}, chains)
+
+	// the imported chains round trip through the registrar file
+	yml, err := EncodePrivateChains(append(existing, chains...))
+	require.NoError(t, err)
+	parsed, err := ParsePrivateChains(yml)
+	require.NoError(t, err)
+	assert.Equal(t, append(existing, chains...), parsed)
+}
+
+func Test_ImportCSVRowErrors(t *testing.T) {
+	csvFile := "chain_id,selector,name,owner,contact\n" +
+		"880002,,partner-settlement,ops,ops@partner.example\n" +
+		"not-a-number,,partner-custody,ops,ops@partner.example\n" +
+		"1,,partner-mainnet,ops,ops@partner.example\n" +
+		"880004,,partner-settlement,ops,ops@partner.example\n" +
+		"880005,42,partner-vault,ops,ops@partner.example\n" +
+		"880006,,partner-archive,,\n"
+
+	chains, err := ImportCSV(strings.NewReader(csvFile), DefaultCSVMapping)
+	assert.Nil(t, chains)
+	var multi *MultiError
+	require.True(t, errors.As(err, &multi))
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, multi.Indexes())
+	assert.Equal(t, "line 3", multi.Errors[0].Item)
+	assert.ErrorContains(t, multi.ForIndex(1), `invalid chain id "not-a-number"`)
+	assert.ErrorContains(t, multi.ForIndex(2), "chain id 1 is public")
+	assert.ErrorContains(t, multi.ForIndex(3), "name partner-settlement is already used by private chain 880002")
+	assert.ErrorContains(t, multi.ForIndex(4), "not in the private band")
+	assert.ErrorContains(t, multi.ForIndex(5), "must have a selector, a name, an owner and a contact")
+}
+
+func Test_ImportCSVMapping(t *testing.T) {
+	_, err := ImportCSV(strings.NewReader("chain_id,name,owner\n"), DefaultCSVMapping)
+	assert.EqualError(t, err, `csv header has no "selector" column for the selector`)
+
+	_, err = ImportCSV(strings.NewReader("chain_id,name,owner\n"), CSVMapping{ChainID: "chain_id", Name: "name", Owner: "owner"})
+	assert.EqualError(t, err, "csv mapping has no column for the contact")
+}
+
+func Test_EncodePrivateChains(t *testing.T) {
+	yml, err := EncodePrivateChains([]PrivateChain{{ChainID: 880001, Selector: PrivateSelectorStart, Name: "consortium-clearing", Owner: "consortium-ops", Contact: "ops@consortium.example"}})
+	require.NoError(t, err)
+	assert.Equal(t, string(privateChainsYml(privateChainEntry(880001, PrivateSelectorStart, "consortium-clearing"))), string(yml))
+}
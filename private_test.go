@@ -0,0 +1,116 @@
+package chain_selectors
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func privateChainsYml(entries ...string) []byte {
+	yml := "private:\n"
+	for _, entry := range entries {
+		yml += entry
+	}
+	return []byte(yml)
+}
+
+func privateChainEntry(chainID, selector uint64, name string) string {
+	return fmt.Sprintf("  %d:\n    selector: %d\n    name: %s\n    owner: consortium-ops\n    contact: ops@consortium.example\n", chainID, selector, name)
+}
+
+func Test_ParsePrivateChains(t *testing.T) {
+	chains, err := ParsePrivateChains(privateChainsYml(
+		privateChainEntry(880002, PrivateSelectorStart+1, "consortium-settlement"),
+		privateChainEntry(880001, PrivateSelectorStart, "consortium-clearing"),
+	))
+	require.NoError(t, err)
+	assert.Equal(t, []PrivateChain{
+		{ChainID: 880001, Selector: PrivateSelectorStart, Name: "consortium-clearing", Owner: "consortium-ops", Contact: "ops@consortium.example"},
+		{ChainID: 880002, Selector: PrivateSelectorStart + 1, Name: "consortium-settlement", Owner: "consortium-ops", Contact: "ops@consortium.example"},
+	}, chains)
+	assert.Equal(t, RangeKindPrivate, RangeFor(PrivateSelectorStart).Kind)
+}
+
+func Test_ParsePrivateChainsRejectsInvalidChains(t *testing.T) {
+	_, err := ParsePrivateChains(privateChainsYml(
+		privateChainEntry(880001, PrivateSelectorStart, "consortium-clearing"),
+		privateChainEntry(880002, PrivateSelectorStart, "consortium-settlement"),
+		privateChainEntry(880003, ETHEREUM_MAINNET.Selector, "consortium-a"),
+		privateChainEntry(ETHEREUM_MAINNET.EvmChainID, PrivateSelectorStart+3, "consortium-b"),
+		privateChainEntry(880005, PrivateSelectorStart+5, ETHEREUM_MAINNET.Name),
+		privateChainEntry(880006, PrivateSelectorStart+6, "consortium-clearing"),
+		"  880007:\n    selector: "+fmt.Sprint(PrivateSelectorStart+7)+"\n    name: consortium-c\n",
+	))
+	var multi *MultiError
+	require.True(t, errors.As(err, &multi))
+	var indexes []int
+	for _, itemErr := range multi.Errors {
+		indexes = append(indexes, itemErr.Index)
+	}
+	// 1 is ethereum-mainnet, sorted first by chain id
+	assert.Equal(t, []int{0, 2, 3, 4, 5, 6}, indexes)
+	assert.ErrorContains(t, err, "is public")
+	assert.ErrorContains(t, err, "not in the private band")
+	assert.ErrorContains(t, err, "already used by private chain 880001")
+	assert.ErrorContains(t, err, "must have a selector, a name, an owner and a contact")
+}
+
+func Test_RegistryWithPrivateChains(t *testing.T) {
+	chains, err := ParsePrivateChains(privateChainsYml(privateChainEntry(880001, PrivateSelectorStart, "consortium-clearing")))
+	require.NoError(t, err)
+
+	for _, registry := range []*Registry{
+		NewRegistry(WithPrivateChains(chains)),
+		NewRegistry(WithPrivateChains(chains), WithEmbeddedChains(false)),
+	} {
+		selector, err := registry.SelectorFromChainID(880001)
+		require.NoError(t, err)
+		assert.Equal(t, PrivateSelectorStart, selector)
+
+		ch, exists := registry.ChainByName("consortium-clearing")
+		require.True(t, exists)
+		assert.Equal(t, uint64(880001), ch.EvmChainID)
+
+		details, provenance, err := registry.GetChainDetailsWithProvenance(PrivateSelectorStart)
+		require.NoError(t, err)
+		assert.Equal(t, "consortium-ops", details.Owner)
+		assert.Equal(t, ProvenancePrivate, provenance.Source)
+
+		private, exists := registry.PrivateChain(PrivateSelectorStart)
+		require.True(t, exists)
+		assert.Equal(t, "ops@consortium.example", private.Contact)
+	}
+
+	// other registries do not resolve the selector
+	public := NewRegistry()
+	_, exists := public.ChainBySelector(PrivateSelectorStart)
+	assert.False(t, exists)
+	_, exists = public.PrivateChain(PrivateSelectorStart)
+	assert.False(t, exists)
+}
+
+func Test_PrivateBandDoesNotCollideWithCustomChains(t *testing.T) {
+	chains, err := ParsePrivateChains(privateChainsYml(privateChainEntry(12345, PrivateSelectorStart+1, "consortium-clearing")))
+	require.NoError(t, err)
+	registry := NewRegistry(WithPrivateChains(chains))
+
+	chainID, err := registry.GetChainIDFromSelector(PrivateSelectorStart + 1)
+	require.NoError(t, err)
+	assert.Equal(t, "12345", chainID)
+	_, err = GetChainIDFromSelector(PrivateSelectorStart + 1)
+	assert.Error(t, err)
+
+	// no chain id, however large, generates a selector of the band
+	for _, chainID := range []uint64{PrivateSelectorStart + 1, PrivateSelectorStart & customSelectorMask, 7_900_000_001, customSelectorMask, customSelectorMask + 1, 1<<64 - 1} {
+		selector := generateCustomChainSelector(chainID)
+		assert.NotEqual(t, RangeKindPrivate, RangeFor(selector).Kind, "chain id %d", chainID)
+		if ch, exists := registry.ChainByEvmChainID(chainID); exists {
+			assert.NotEqual(t, RangeKindPrivate, RangeFor(ch.Selector).Kind, "chain id %d", chainID)
+		}
+	}
+	assert.NoError(t, ValidateOfficialSelector(PrivateSelectorStart-1))
+	assert.Error(t, ValidateOfficialSelector(PrivateSelectorStart+1))
+}
@@ -0,0 +1,95 @@
+package chain_selectors
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+)
+
+// ProvenanceSource names where a resolved chain mapping came from.
+type ProvenanceSource string
+
+const (
+	// ProvenanceEmbedded marks mappings from the datasets embedded in the package.
+	ProvenanceEmbedded ProvenanceSource = "embedded"
+	// ProvenanceOverride marks mappings loaded into a Registry with LoadYAML.
+	ProvenanceOverride ProvenanceSource = "override"
+	// ProvenanceCustom marks mappings generated for custom chains.
+	ProvenanceCustom ProvenanceSource = "custom"
+	// ProvenanceRemote marks mappings fetched from a remote registry.
+	ProvenanceRemote ProvenanceSource = "remote"
+	// ProvenancePrivate marks the private chains of a Registry, see WithPrivateChains.
+	ProvenancePrivate ProvenanceSource = "private"
+)
+
+// Provenance explains a resolution: which source the mapping came from, the version of the
+// dataset holding it and when that dataset was loaded. Generated custom chains have neither a
+// dataset version nor a load time.
+type Provenance struct {
+	Source         ProvenanceSource
+	DatasetVersion string
+	LoadedAt       time.Time
+}
+
+var (
+	embeddedDatasetVersion = onceValue(func() string {
+		return datasetVersion(
+			selectorsYml, testSelectorsYml, forksSelectorsYml,
+			solanaSelectorsYml, testSelectorsSolanaYml,
+			aptosSelectorsYml, suiSelectorsYml, tronSelectorsYml, tonSelectorsYml,
+		)
+	})
+	embeddedLoadedAt = time.Now()
+)
+
+// datasetVersion identifies a dataset by the truncated SHA-256 of its files.
+func datasetVersion(files ...[]byte) string {
+	h := sha256.New()
+	for _, file := range files {
+		h.Write(file)
+	}
+	return hex.EncodeToString(h.Sum(nil)[:8])
+}
+
+// EmbeddedDatasetVersion identifies the selector datasets embedded in this build of the package.
+func EmbeddedDatasetVersion() string {
+	return embeddedDatasetVersion()
+}
+
+func embeddedProvenance() Provenance {
+	return Provenance{Source: ProvenanceEmbedded, DatasetVersion: embeddedDatasetVersion(), LoadedAt: embeddedLoadedAt}
+}
+
+// GetChainDetailsWithProvenance is GetChainDetailsBySelector also explaining where the details came from.
+func (r *Registry) GetChainDetailsWithProvenance(selector uint64) (ChainDetails, Provenance, error) {
+	state := r.loadState()
+	if ch, exists := state.evmBySelector[selector]; exists {
+		return state.evmDetails[ch.EvmChainID], state.provenance[ch.EvmChainID], nil
+	}
+
+	details, err := r.GetChainDetailsBySelector(selector)
+	if err != nil {
+		return ChainDetails{}, Provenance{}, err
+	}
+	if _, embedded := chainDetailsBySelector()[selector]; !embedded && isCustomSelector(selector) {
+		return details, Provenance{Source: ProvenanceCustom}, nil
+	}
+	return details, embeddedProvenance(), nil
+}
+
+// SelectorFromChainIDWithProvenance is SelectorFromChainID also explaining where the selector came from.
+func (r *Registry) SelectorFromChainIDWithProvenance(chainID uint64) (uint64, Provenance, error) {
+	state := r.loadState()
+	if ch, exists := state.evmByChainID[chainID]; exists {
+		return ch.Selector, state.provenance[chainID], nil
+	}
+
+	selector, err := r.SelectorFromChainID(chainID)
+	if err != nil {
+		return 0, Provenance{}, err
+	}
+	if !isInOfficialSelectors(chainID) {
+		return selector, Provenance{Source: ProvenanceCustom}, nil
+	}
+	return selector, embeddedProvenance(), nil
+}
@@ -0,0 +1,50 @@
+package chain_selectors
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProvenance(t *testing.T) {
+	yml := []byte("selectors:\n  99001:\n    selector: 9900100000000000001\n    name: provenance-devnet\n")
+	registry := NewRegistry()
+	before := time.Now()
+	require.NoError(t, registry.LoadYAML(yml))
+
+	details, provenance, err := registry.GetChainDetailsWithProvenance(9900100000000000001)
+	require.NoError(t, err)
+	assert.Equal(t, "provenance-devnet", details.ChainName)
+	assert.Equal(t, ProvenanceOverride, provenance.Source)
+	assert.Equal(t, datasetVersion(yml), provenance.DatasetVersion)
+	assert.False(t, provenance.LoadedAt.Before(before))
+
+	_, provenance, err = registry.GetChainDetailsWithProvenance(ETHEREUM_MAINNET.Selector)
+	require.NoError(t, err)
+	assert.Equal(t, ProvenanceEmbedded, provenance.Source)
+	assert.Equal(t, EmbeddedDatasetVersion(), provenance.DatasetVersion)
+	assert.Len(t, provenance.DatasetVersion, 16)
+
+	_, provenance, err = registry.GetChainDetailsWithProvenance(generateCustomChainSelector(9388201))
+	require.NoError(t, err)
+	assert.Equal(t, Provenance{Source: ProvenanceCustom}, provenance)
+
+	_, _, err = registry.GetChainDetailsWithProvenance(120398123)
+	assert.Error(t, err)
+
+	tests := []struct {
+		chainID  uint64
+		expected ProvenanceSource
+	}{
+		{chainID: 99001, expected: ProvenanceOverride},
+		{chainID: 1, expected: ProvenanceEmbedded},
+		{chainID: 9388201, expected: ProvenanceCustom},
+	}
+	for _, test := range tests {
+		_, provenance, err := registry.SelectorFromChainIDWithProvenance(test.chainID)
+		require.NoError(t, err)
+		assert.Equal(t, test.expected, provenance.Source, "chain %d", test.chainID)
+	}
+}
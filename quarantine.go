@@ -0,0 +1,67 @@
+package chain_selectors
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+//go:embed quarantine.yml
+var quarantineYml []byte
+
+// ErrChainQuarantined is matched, with errors.Is, by the errors of lookups refusing to generate
+// a selector for a quarantined chain id, see IsQuarantined.
+var ErrChainQuarantined = errors.New("chain id quarantined")
+
+// QuarantineEntry explains why a chain id is quarantined.
+type QuarantineEntry struct {
+	Reason   string `yaml:"reason"`
+	Evidence string `yaml:"evidence,omitempty"`
+}
+
+var quarantinedChainIDs = onceValue(func() map[uint64]QuarantineEntry { return parseQuarantineYml(quarantineYml) })
+
+func parseQuarantineYml(ymlFile []byte) map[uint64]QuarantineEntry {
+	type ymlData struct {
+		Quarantine map[uint64]QuarantineEntry `yaml:"quarantine"`
+	}
+
+	var data ymlData
+	err := yaml.Unmarshal(ymlFile, &data)
+	if err != nil {
+		panic(err)
+	}
+
+	for chainID, entry := range data.Quarantine {
+		if entry.Reason == "" {
+			panic(fmt.Errorf("quarantined chain id %d has no reason", chainID))
+		}
+	}
+	return data.Quarantine
+}
+
+// IsQuarantined reports whether the EVM chain id is known to be used by scam or spoofed
+// networks. Custom chain selectors are not generated automatically for quarantined chain ids,
+// e.g. by GetCustomChainSelector, unless the chain was registered explicitly with
+// RegisterCustomChain or RegisterCustomChains.
+func IsQuarantined(chainID uint64) bool {
+	_, quarantined := quarantinedChainIDs()[chainID]
+	return quarantined
+}
+
+// QuarantineReason returns why the chain id is quarantined.
+func QuarantineReason(chainID uint64) (QuarantineEntry, bool) {
+	entry, quarantined := quarantinedChainIDs()[chainID]
+	return entry, quarantined
+}
+
+// quarantineBlocks reports whether a selector must not be generated automatically for the chain id.
+func quarantineBlocks(chainID uint64) bool {
+	if !IsQuarantined(chainID) {
+		return false
+	}
+	_, registered := customChains.name(chainID)
+	return !registered
+}
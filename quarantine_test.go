@@ -0,0 +1,60 @@
+package chain_selectors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuarantinedChainIDsAreNotOfficial(t *testing.T) {
+	for chainID := range quarantinedChainIDs() {
+		assert.False(t, isInOfficialSelectors(chainID), "chain id %d is quarantined and official", chainID)
+	}
+}
+
+func TestEmbeddedQuarantineYml(t *testing.T) {
+	var entries map[uint64]QuarantineEntry
+	require.NotPanics(t, func() { entries = parseQuarantineYml(quarantineYml) })
+	assert.NotNil(t, entries, "quarantine.yml must keep its quarantine key")
+	for chainID, entry := range entries {
+		assert.NotEmpty(t, entry.Evidence, "quarantined chain id %d links no evidence", chainID)
+	}
+	assert.Equal(t, len(entries), len(quarantinedChainIDs()))
+}
+
+func TestQuarantine(t *testing.T) {
+	const spoofed, registered = 9388206, 9388207
+	quarantined := quarantinedChainIDs
+	parsed := parseQuarantineYml([]byte(`
+quarantine:
+  9388206:
+    reason: "impersonates ethereum-mainnet"
+  9388207:
+    reason: "impersonates ethereum-mainnet"
+`))
+	quarantinedChainIDs = func() map[uint64]QuarantineEntry { return parsed }
+	t.Cleanup(func() { quarantinedChainIDs = quarantined })
+
+	assert.True(t, IsQuarantined(spoofed))
+	assert.False(t, IsQuarantined(ETHEREUM_MAINNET.EvmChainID))
+	entry, _ := QuarantineReason(spoofed)
+	assert.Equal(t, "impersonates ethereum-mainnet", entry.Reason)
+
+	_, err := GetCustomChainSelector(spoofed)
+	require.ErrorIs(t, err, ErrChainQuarantined)
+	_, err = selectorFromChainId(spoofed)
+	require.ErrorIs(t, err, ErrChainQuarantined)
+	_, err = GetChainDetailsByChainIDAndFamily("9388206", FamilyEVM)
+	require.ErrorIs(t, err, ErrChainQuarantined)
+	_, exists := ChainByEvmChainID(spoofed)
+	assert.False(t, exists)
+	assert.Empty(t, ListAllChains(spoofed, spoofed))
+
+	selector := RegisterCustomChain(registered, "registered-spoof")
+	generated, err := GetCustomChainSelector(registered)
+	require.NoError(t, err, "explicitly registered chains override the quarantine")
+	assert.Equal(t, selector, generated)
+
+	assert.Panics(t, func() { parseQuarantineYml([]byte("quarantine:\n  9388208: {}\n")) })
+}
@@ -0,0 +1,89 @@
+//go:build go1.23
+
+package chain_selectors
+
+import (
+	"iter"
+)
+
+// ChainQuery selects EVM chains declaratively. Its methods return a refined copy, so a query can
+// be shared and extended without affecting other users of it:
+//
+//	for ch := range Query().Testnet(false).Tag("zk").Iter() {
+//		...
+//	}
+type ChainQuery struct {
+	family  string
+	testnet *bool
+	tags    []string
+}
+
+// Query returns a query selecting every chain.
+func Query() ChainQuery {
+	return ChainQuery{}
+}
+
+// Family restricts the query to the chains of the family. Chain describes EVM chains only, so
+// any other family selects nothing.
+func (q ChainQuery) Family(family string) ChainQuery {
+	q.family = family
+	return q
+}
+
+// Testnet restricts the query to testnets and devnets if testnet is true, and to the other
+// chains if it is false, see GetSelectorEnvironment.
+func (q ChainQuery) Testnet(testnet bool) ChainQuery {
+	q.testnet = &testnet
+	return q
+}
+
+// Tag restricts the query to the chains tagged with the tag. Every tag given must be present.
+func (q ChainQuery) Tag(tag string) ChainQuery {
+	q.tags = append(q.tags[:len(q.tags):len(q.tags)], tag)
+	return q
+}
+
+// Iter returns the chains matching the query, ordered by selector.
+func (q ChainQuery) Iter() iter.Seq[Chain] {
+	return func(yield func(Chain) bool) {
+		if q.family != "" && q.family != FamilyEVM {
+			return
+		}
+		for _, selector := range familyIndex(FamilyEVM).selectors {
+			ch, exists := evmChainsBySelector()[selector]
+			if !exists || !q.matches(ch) {
+				continue
+			}
+			if !yield(ch) {
+				return
+			}
+		}
+	}
+}
+
+func (q ChainQuery) matches(ch Chain) bool {
+	if q.testnet != nil {
+		environment, err := GetSelectorEnvironment(ch.Selector)
+		if err != nil {
+			return false
+		}
+		if (environment == EnvironmentTestnet || environment == EnvironmentDevnet) != *q.testnet {
+			return false
+		}
+	}
+	for _, tag := range q.tags {
+		if !hasTag(ch.Selector, tag) {
+			return false
+		}
+	}
+	return true
+}
+
+func hasTag(selector uint64, tag string) bool {
+	for _, t := range tagsBySelector()[selector] {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,74 @@
+//go:build go1.23
+
+package chain_selectors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuery(t *testing.T) {
+	var expected []uint64
+	for _, selector := range ChainsByTag("zk") {
+		ch, exists := ChainBySelector(selector)
+		if !exists {
+			continue
+		}
+		environment, err := GetSelectorEnvironment(ch.Selector)
+		require.NoError(t, err)
+		if environment == EnvironmentMainnet {
+			expected = append(expected, selector)
+		}
+	}
+	require.NotEmpty(t, expected)
+
+	var selected []uint64
+	for ch := range Query().Family(FamilyEVM).Testnet(false).Tag("zk").Iter() {
+		selected = append(selected, ch.Selector)
+	}
+	assert.Equal(t, expected, selected)
+
+	for ch := range Query().Testnet(true).Iter() {
+		environment, err := GetSelectorEnvironment(ch.Selector)
+		require.NoError(t, err)
+		assert.NotEqual(t, EnvironmentMainnet, environment, ch.Name)
+	}
+
+	for ch := range Query().Tag("zk").Tag("deprecated-rpc").Iter() {
+		assert.Subset(t, TagsOf(ch.Selector), []string{"zk", "deprecated-rpc"}, ch.Name)
+	}
+
+	count := 0
+	for range Query().Iter() {
+		count++
+	}
+	assert.Equal(t, len(ALL), count)
+
+	for range Query().Family(FamilySolana).Iter() {
+		t.Fatal("non EVM families select no Chain")
+	}
+}
+
+func TestQueryIsImmutable(t *testing.T) {
+	base := Query().Tag("rollup")
+	zk := base.Tag("zk")
+	optimistic := base.Tag("optimistic")
+
+	for ch := range zk.Iter() {
+		assert.NotContains(t, TagsOf(ch.Selector), "optimistic", ch.Name)
+	}
+	for ch := range optimistic.Iter() {
+		assert.NotContains(t, TagsOf(ch.Selector), "zk", ch.Name)
+	}
+
+	taken := 0
+	for range base.Iter() {
+		taken++
+		if taken == 2 {
+			break
+		}
+	}
+	assert.Equal(t, 2, taken)
+}
@@ -0,0 +1,189 @@
+package chain_selectors
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+// RangeKind classifies the part of the selector space a selector belongs to.
+type RangeKind string
+
+const (
+	RangeKindInvalid   RangeKind = "invalid"
+	RangeKindOfficial  RangeKind = "official"
+	RangeKindCustom    RangeKind = "custom"
+	RangeKindEphemeral RangeKind = "ephemeral"
+	RangeKindSynthetic RangeKind = "synthetic"
+	RangeKindPrivate   RangeKind = "private"
+)
+
+// SelectorRange is an inclusive range of chain selectors.
+type SelectorRange struct {
+	Name  string
+	Kind  RangeKind
+	Start uint64
+	End   uint64
+}
+
+// Contains reports whether the selector lies within the range.
+func (r SelectorRange) Contains(selector uint64) bool {
+	return selector >= r.Start && selector <= r.End
+}
+
+const (
+	customSelectorPrefix = uint64(0xE000000000000000)
+	customSelectorMask   = uint64(0x0FFFFFFFFFFFFFFF)
+)
+
+// RangeTable partitions the selector space, most specific range first. Official selectors of
+// every family, main and test chains alike, are derived from hashes and spread over the whole
+// space, except for the 0xE prefixed range which is reserved for selectors generated for
+// custom chains. The ephemeral range holds the selectors of the chains leased with
+// LeaseEphemeralChain and the synthetic range the ones of GenerateSyntheticChains. The private
+// band, carved out of the official space, holds the selectors of the private chains of
+// ParsePrivateChains. The sentinels SelectorNone and SelectorWildcard are invalid. RangeTable
+// must be treated as read-only.
+var RangeTable = []SelectorRange{
+	{Name: "zero", Kind: RangeKindInvalid, Start: SelectorNone, End: SelectorNone},
+	{Name: "wildcard", Kind: RangeKindInvalid, Start: SelectorWildcard, End: SelectorWildcard},
+	{
+		Name:  "ephemeral",
+		Kind:  RangeKindEphemeral,
+		Start: customSelectorPrefix | EphemeralChainIDStart,
+		End:   customSelectorPrefix | (EphemeralChainIDStart + EphemeralChainIDCount - 1),
+	},
+	{
+		Name:  "synthetic",
+		Kind:  RangeKindSynthetic,
+		Start: customSelectorPrefix | SyntheticChainIDStart,
+		End:   customSelectorPrefix | (SyntheticChainIDStart + SyntheticChainIDCount - 1),
+	},
+	{
+		Name:  "private",
+		Kind:  RangeKindPrivate,
+		Start: PrivateSelectorStart,
+		End:   PrivateSelectorStart + PrivateSelectorCount - 1,
+	},
+	{Name: "custom", Kind: RangeKindCustom, Start: customSelectorPrefix, End: customSelectorPrefix | customSelectorMask},
+	officialRange,
+}
+
+// FamilyRanges lists, per family, the ranges of RangeTable its official selectors may be
+// assigned from. The families below predate the partitioning: their selectors are derived from
+// hashes and interleave over the whole official range, so they share it and
+// ValidateFamilySelector keeps them from colliding instead. A new family must be listed, ideally
+// with a range of its own carved out of the official one, before the generators accept its
+// selectors. FamilyRanges must be treated as read-only.
+var FamilyRanges = map[string][]SelectorRange{
+	FamilyEVM:    {officialRange},
+	FamilySolana: {officialRange},
+	FamilyAptos:  {officialRange},
+	FamilySui:    {officialRange},
+	FamilyTron:   {officialRange},
+	FamilyTon:    {officialRange},
+}
+
+var officialRange = SelectorRange{Name: "official", Kind: RangeKindOfficial, Start: 1, End: math.MaxUint64}
+
+// grandfatheredCustomRangeSelectors are official selectors assigned before the custom range was
+// reserved. They keep resolving to their official chain, no new official selector may be added
+// to the custom range.
+var grandfatheredCustomRangeSelectors = map[uint64]struct{}{
+	16235373811196386733: {}, // abstract-testnet
+	16244020411108056671: {}, // zora-testnet
+	16281711391670634445: {}, // polygon-testnet-amoy
+	16449698933146693970: {},
+	16468599424800719238: {}, // ethereum-mainnet-taiko-1
+	16487132492576884721: {}, // cronos-zkevm-testnet-sepolia
+	16591966440843528322: {},
+	16702426279731183946: {},
+	17164792800244661392: {}, // mint-mainnet
+	17198166215261833993: {}, // ethereum-mainnet-zircuit-1
+	17251043223284625647: {},
+	16423721717087811551: {}, // solana-devnet
+	16574839267584930184: {},
+	16448340667252469081: {}, // ton-mainnet
+}
+
+// RangeFor returns the most specific range of RangeTable containing the selector.
+func RangeFor(selector uint64) SelectorRange {
+	for _, r := range RangeTable {
+		if r.Contains(selector) {
+			return r
+		}
+	}
+	// unreachable, the official range covers every non-zero selector
+	return RangeTable[0]
+}
+
+// ValidateOfficialSelector checks that the selector may be assigned to an official chain of
+// any family. The generators call it for every selector so a new chain cannot silently take
+// a selector reserved for custom chains.
+func ValidateOfficialSelector(selector uint64) error {
+	r := RangeFor(selector)
+	if r.Kind == RangeKindOfficial {
+		return nil
+	}
+	if _, grandfathered := grandfatheredCustomRangeSelectors[selector]; grandfathered {
+		return nil
+	}
+	return fmt.Errorf("selector %d lies in the %s range [%d, %d] and cannot be assigned to an official chain", selector, r.Name, r.Start, r.End)
+}
+
+// ValidateFamilySelector checks that the selector may be assigned to an official chain of the
+// family: it must be valid for any family, lie in one of the family's FamilyRanges and not be
+// assigned to a chain of another family. The generators call it for every selector.
+func ValidateFamilySelector(family string, selector uint64) error {
+	ranges, exists := FamilyRanges[family]
+	if !exists {
+		return fmt.Errorf("no selector range is defined for family %s", family)
+	}
+	if err := ValidateOfficialSelector(selector); err != nil {
+		return err
+	}
+	if _, grandfathered := grandfatheredCustomRangeSelectors[selector]; !grandfathered && !rangesContain(ranges, selector) {
+		return fmt.Errorf("selector %d lies outside the selector ranges of family %s", selector, family)
+	}
+	for _, other := range allFamilies {
+		if _, assigned := familyIndex(other).infoBySelector[selector]; assigned && other != family {
+			return fmt.Errorf("selector %d is assigned to a chain of family %s", selector, other)
+		}
+	}
+	return nil
+}
+
+func rangesContain(ranges []SelectorRange, selector uint64) bool {
+	for _, r := range ranges {
+		if r.Contains(selector) {
+			return true
+		}
+	}
+	return false
+}
+
+// ProposeSelector derives the selector of a new official EVM chain from its chain id and, for
+// chains reusing the chain id of another chain, its genesis hash. The hash is taken again until
+// the selector lies in the official range, is not assigned to a known chain and is not held by a
+// reservation, so the proposal is stable until the datasets or the reservations change.
+func ProposeSelector(chainID uint64, genesisHash string) uint64 {
+	seed := fmt.Sprintf("chain-selector-%d", chainID)
+	if genesisHash != "" {
+		seed += "-" + normalizeGenesisHash(genesisHash)
+	}
+	for attempt := 0; ; attempt++ {
+		hash := sha256.Sum256([]byte(fmt.Sprintf("%s-%d", seed, attempt)))
+		selector := binary.BigEndian.Uint64(hash[:8])
+		if RangeFor(selector).Kind == RangeKindOfficial && !customSelectorCollides(selector) && ValidateReservation(selector, "") == nil {
+			return selector
+		}
+	}
+}
+
+// customSelectorCollides reports whether a selector generated for a custom chain is already
+// assigned to an official chain, see customChainSelector.
+func customSelectorCollides(selector uint64) bool {
+	_, exists := chainDetailsBySelector()[selector]
+	return exists
+}
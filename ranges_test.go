@@ -0,0 +1,114 @@
+package chain_selectors
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRangeFor(t *testing.T) {
+	tests := []struct {
+		name     string
+		selector uint64
+		expected RangeKind
+	}{
+		{name: "zero", selector: 0, expected: RangeKindInvalid},
+		{name: "official evm", selector: ETHEREUM_MAINNET.Selector, expected: RangeKindOfficial},
+		{name: "official solana", selector: SOLANA_MAINNET.Selector, expected: RangeKindOfficial},
+		{name: "custom", selector: generateCustomChainSelector(9388201), expected: RangeKindCustom},
+		{name: "hashed custom", selector: generateCustomChainSelector(0xFFFFFFFFFFFFFF00), expected: RangeKindCustom},
+		{name: "ephemeral", selector: generateCustomChainSelector(EphemeralChainIDStart), expected: RangeKindEphemeral},
+		{name: "synthetic", selector: generateCustomChainSelector(SyntheticChainIDStart + SyntheticChainIDCount - 1), expected: RangeKindSynthetic},
+		{name: "private", selector: PrivateSelectorStart + PrivateSelectorCount - 1, expected: RangeKindPrivate},
+		{name: "wildcard", selector: SelectorWildcard, expected: RangeKindInvalid},
+		{name: "below wildcard", selector: SelectorWildcard - 1, expected: RangeKindOfficial},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, RangeFor(test.selector).Kind)
+		})
+	}
+}
+
+func TestRangeTableOrder(t *testing.T) {
+	for i, r := range RangeTable {
+		require.LessOrEqual(t, r.Start, r.End, r.Name)
+		// A range listed after another must not be more specific, or it would never match.
+		for _, earlier := range RangeTable[:i] {
+			assert.False(t, r.Start >= earlier.Start && r.End <= earlier.End, "%s is shadowed by %s", r.Name, earlier.Name)
+		}
+	}
+}
+
+func TestOfficialSelectorsRespectRangeTable(t *testing.T) {
+	grandfathered := 0
+	for _, family := range allFamilies {
+		for _, selector := range knownSelectors(family) {
+			require.NoError(t, ValidateOfficialSelector(selector), "%s selector %d", family, selector)
+			if RangeFor(selector).Kind != RangeKindOfficial {
+				grandfathered++
+			}
+		}
+	}
+	assert.Equal(t, len(grandfatheredCustomRangeSelectors), grandfathered, "grandfathered selectors that are no longer in use should be removed")
+
+	assert.Error(t, ValidateOfficialSelector(0))
+	assert.Error(t, ValidateOfficialSelector(generateCustomChainSelector(9388201)))
+}
+
+func TestCustomSelectorCollisionsAreRejected(t *testing.T) {
+	// The chain id whose direct encoding equals the official selector of zora-testnet.
+	chainID := uint64(16244020411108056671) & customSelectorMask
+	require.Equal(t, uint64(16244020411108056671), generateCustomChainSelector(chainID))
+
+	_, err := GetCustomChainSelector(chainID)
+	assert.Error(t, err)
+
+	_, exists := ChainByEvmChainID(chainID)
+	assert.False(t, exists)
+
+	_, err = RegisterCustomChains([]CustomChainSpec{{ChainID: chainID, Name: "colliding-devnet"}})
+	assert.Error(t, err)
+
+	assert.Zero(t, RegisterCustomChain(chainID, "colliding-devnet"))
+	_, registered := customChains.name(chainID)
+	assert.False(t, registered)
+
+	_, err = GetChainDetailsByChainIDAndFamily(strconv.FormatUint(chainID, 10), FamilyEVM)
+	assert.Error(t, err)
+
+	_, err = NameFromChainId(chainID)
+	assert.Error(t, err)
+
+	for _, details := range ListAllChains(chainID-1, chainID+1) {
+		assert.NotEqual(t, uint64(16244020411108056671), details.ChainSelector)
+	}
+}
+
+func TestValidateFamilySelector(t *testing.T) {
+	for _, family := range allFamilies {
+		for _, selector := range knownSelectors(family) {
+			assert.NoError(t, ValidateFamilySelector(family, selector), "%s selector %d", family, selector)
+		}
+	}
+
+	assert.ErrorContains(t, ValidateFamilySelector(FamilySolana, ETHEREUM_MAINNET.Selector), "family evm")
+	assert.ErrorContains(t, ValidateFamilySelector("starknet", ProposeSelector(987654321, "")), "no selector range")
+	assert.Error(t, ValidateFamilySelector(FamilyEVM, generateCustomChainSelector(9388201)))
+}
+
+func TestProposeSelector(t *testing.T) {
+	selector := ProposeSelector(987654321, "")
+	assert.Equal(t, selector, ProposeSelector(987654321, ""))
+	assert.NoError(t, ValidateOfficialSelector(selector))
+	assert.Equal(t, RangeKindOfficial, RangeFor(selector).Kind)
+	assert.False(t, customSelectorCollides(selector))
+
+	genesis := "0xd4e56740f876aef8c010b86a40d5f56745a118d0906a34e69aec8c0db1cb8fa3"
+	assert.NotEqual(t, selector, ProposeSelector(987654321, genesis))
+	assert.Equal(t, ProposeSelector(987654321, genesis), ProposeSelector(987654321, strings.ToUpper(genesis[2:])))
+}
@@ -0,0 +1,207 @@
+package chain_selectors
+
+import (
+	"strconv"
+	"sync"
+	"sync/atomic"
+)
+
+// Registry resolves chains like the package level lookup functions, restricted by the
+// options it was created with and extended by the chains loaded with LoadYAML. The zero value
+// is not usable, use NewRegistry instead.
+type Registry struct {
+	testChains  bool
+	embedded    bool
+	remote      RemoteResolver
+	matchPolicy MatchPolicy
+	audit       AuditSink
+	// private holds the private chains by selector, see WithPrivateChains
+	private map[uint64]PrivateChain
+	// environment names the overlay shadowing chain metadata, see WithEnvironmentOverlay
+	environment string
+	overlay     map[uint64]ChainMetadata
+
+	// mu serializes writers and guards listeners, readers only load state.
+	mu        sync.Mutex
+	state     atomic.Pointer[registryState]
+	listeners []changeListener
+	nextID    int
+	// lastSync is the provenance of the last dataset loaded by a RemoteSync
+	lastSync Provenance
+	// history holds the last published states, oldest first, see ComputeDelta
+	history []*stateVersion
+}
+
+// RegistryOption configures a Registry created with NewRegistry.
+type RegistryOption func(*Registry)
+
+// WithTestChains controls whether the chains defined in the test selector files and the custom
+// chains, which IsTestChain reports as test chains, can be resolved. Test chains are enabled by
+// default, production services should disable them so they never route to simulated networks
+// by accident.
+func WithTestChains(enabled bool) RegistryOption {
+	return func(r *Registry) {
+		r.testChains = enabled
+	}
+}
+
+// WithEmbeddedChains controls whether the registry resolves the chains known to the package,
+// the embedded datasets and generated custom chains, in addition to the chains loaded with
+// LoadYAML. It is enabled by default, disable it for registries holding only an overlay, see
+// FederatedRegistry.
+func WithEmbeddedChains(enabled bool) RegistryOption {
+	return func(r *Registry) {
+		r.embedded = enabled
+	}
+}
+
+// WithRemoteResolver lets ResolveSelector fall back to a remote source for chains the registry
+// does not know. Wrap the resolver with NewCachingResolver to keep remote lookups off the hot path.
+func WithRemoteResolver(resolver RemoteResolver) RegistryOption {
+	return func(r *Registry) {
+		r.remote = resolver
+	}
+}
+
+// NewRegistry creates a Registry configured with the given options.
+func NewRegistry(opts ...RegistryOption) *Registry {
+	r := &Registry{
+		testChains: true,
+		embedded:   true,
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	initial := r.privateState()
+	r.state.Store(initial)
+	r.history = []*stateVersion{{state: initial}}
+	return r
+}
+
+// excludesSelector reports whether the registry hides the package level chain identified by the selector.
+func (r *Registry) excludesSelector(selector uint64) bool {
+	if !r.embedded {
+		return true
+	}
+	if r.testChains {
+		return false
+	}
+	return IsTestChain(selector)
+}
+
+// excludesChainID reports whether the registry hides the package level chain identified by its family specific chain id.
+func (r *Registry) excludesChainID(chainID string, family string) bool {
+	if !r.embedded {
+		return true
+	}
+	if r.testChains {
+		return false
+	}
+	switch family {
+	case FamilyEVM:
+		evmChainId, err := strconv.ParseUint(chainID, 10, 64)
+		if err != nil {
+			return false
+		}
+		_, test := evmTestSelectorsMap()[evmChainId]
+		return test || isCustomChain(evmChainId)
+	case FamilySolana:
+		_, test := solanaTestSelectorsMap()[chainID]
+		return test
+	default:
+		return false
+	}
+}
+
+func (r *Registry) GetSelectorFamily(selector uint64) (string, error) {
+	if _, exists := r.loadState().evmBySelector[selector]; exists {
+		return FamilyEVM, nil
+	}
+	if r.excludesSelector(selector) {
+		return "", selectorNotFoundError("", selector)
+	}
+	return GetSelectorFamily(selector)
+}
+
+func (r *Registry) GetChainIDFromSelector(selector uint64) (string, error) {
+	if ch, exists := r.loadState().evmBySelector[selector]; exists {
+		return strconv.FormatUint(ch.EvmChainID, 10), nil
+	}
+	if r.excludesSelector(selector) {
+		return "", selectorNotFoundError("", selector)
+	}
+	return GetChainIDFromSelector(selector)
+}
+
+func (r *Registry) GetChainDetailsBySelector(selector uint64) (ChainDetails, error) {
+	state := r.loadState()
+	if ch, exists := state.evmBySelector[selector]; exists {
+		return state.evmDetails[ch.EvmChainID], nil
+	}
+	if r.excludesSelector(selector) {
+		return ChainDetails{}, selectorNotFoundError("", selector)
+	}
+	return GetChainDetailsBySelector(selector)
+}
+
+func (r *Registry) GetChainDetailsByChainIDAndFamily(chainID string, family string) (ChainDetails, error) {
+	if family == FamilyEVM {
+		if evmChainID, err := strconv.ParseUint(chainID, 10, 64); err == nil {
+			if details, exists := r.loadState().evmDetails[evmChainID]; exists {
+				return details, nil
+			}
+		}
+	}
+	if r.excludesChainID(chainID, family) {
+		return ChainDetails{}, notFoundError(InputChainID, family, chainID)
+	}
+	return GetChainDetailsByChainIDAndFamily(chainID, family)
+}
+
+// SelectorFromChainID returns the selector of the EVM chain.
+func (r *Registry) SelectorFromChainID(chainID uint64) (uint64, error) {
+	if ch, exists := r.loadState().evmByChainID[chainID]; exists {
+		return ch.Selector, nil
+	}
+	if r.excludesChainID(strconv.FormatUint(chainID, 10), FamilyEVM) {
+		return 0, chainIDNotFoundError(FamilyEVM, chainID)
+	}
+	return selectorFromChainId(chainID)
+}
+
+func (r *Registry) ChainBySelector(selector uint64) (Chain, bool) {
+	if ch, exists := r.loadState().evmBySelector[selector]; exists {
+		return ch, true
+	}
+	if r.excludesSelector(selector) {
+		return Chain{}, false
+	}
+	return ChainBySelector(selector)
+}
+
+func (r *Registry) ChainByEvmChainID(evmChainID uint64) (Chain, bool) {
+	if ch, exists := r.loadState().evmByChainID[evmChainID]; exists {
+		return ch, true
+	}
+	if r.excludesChainID(strconv.FormatUint(evmChainID, 10), FamilyEVM) {
+		return Chain{}, false
+	}
+	return ChainByEvmChainID(evmChainID)
+}
+
+// ChainByName resolves the name like the package level ChainByName, matching it with the policy
+// set with WithMatchPolicy.
+func (r *Registry) ChainByName(name string) (Chain, bool) {
+	return r.ChainByNameMatching(name, r.matchPolicy)
+}
+
+func (r *Registry) chainByExactName(name string) (Chain, bool) {
+	if ch, exists := r.loadState().evmByName[name]; exists {
+		return ch, true
+	}
+	ch, exists := ChainByName(name)
+	if !exists || r.excludesSelector(ch.Selector) {
+		return Chain{}, false
+	}
+	return ch, true
+}
@@ -0,0 +1,46 @@
+package chain_selectors
+
+import (
+	"sort"
+	"strconv"
+)
+
+// RegistryChain is a chain a Registry resolves, of any family.
+type RegistryChain struct {
+	Selector uint64
+	Family   string
+	// ChainID is the family specific chain id, see GetChainIDFromSelector.
+	ChainID     string
+	Name        string
+	Environment string
+}
+
+// Chains returns the chains the registry resolves, sorted by selector: the embedded chains it
+// does not exclude merged with the chains loaded into it. Custom chains generated on the fly
+// and remote chains are not listed.
+func (r *Registry) Chains() []RegistryChain {
+	return r.chainsOf(r.loadState())
+}
+
+// chainsOf returns the chains the registry resolves with the state loaded.
+func (r *Registry) chainsOf(state *registryState) []RegistryChain {
+	chains := make(map[uint64]RegistryChain)
+	if r.embedded {
+		for selector, info := range lookupIndex().infoBySelector {
+			if !r.excludesSelector(selector) {
+				environment, _ := GetSelectorEnvironment(selector)
+				chains[selector] = RegistryChain{Selector: selector, Family: info.Family, ChainID: info.ChainID, Name: info.ChainDetails.ChainName, Environment: environment}
+			}
+		}
+	}
+	for selector, ch := range state.evmBySelector {
+		chains[selector] = RegistryChain{Selector: selector, Family: FamilyEVM, ChainID: strconv.FormatUint(ch.EvmChainID, 10), Name: ch.Name, Environment: environmentFromName(ch.Name)}
+	}
+
+	sorted := make([]RegistryChain, 0, len(chains))
+	for _, ch := range chains {
+		sorted = append(sorted, ch)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Selector < sorted[j].Selector })
+	return sorted
+}
@@ -0,0 +1,217 @@
+package chain_selectors
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// registryState is an immutable snapshot of the chains loaded into a Registry on top of the
+// embedded datasets. A published state is never modified, LoadYAML publishes a new one instead,
+// so lookups can read it without locking.
+type registryState struct {
+	evmByChainID  map[uint64]Chain
+	evmBySelector map[uint64]Chain
+	evmByName     map[string]Chain
+	evmDetails    map[uint64]ChainDetails
+	provenance    map[uint64]Provenance
+}
+
+var emptyRegistryState = &registryState{
+	evmByChainID:  map[uint64]Chain{},
+	evmBySelector: map[uint64]Chain{},
+	evmByName:     map[string]Chain{},
+	evmDetails:    map[uint64]ChainDetails{},
+	provenance:    map[uint64]Provenance{},
+}
+
+// withEVMChains returns a copy of the state with the given chains, keyed by chain id, added
+// or replaced and attributed to the provenance. Loading a chain fails if its chain id is
+// embedded with a different selector or if its selector or name already identifies another
+// chain, the returned *MultiError then indexes every failed chain by its position in chain id
+// order.
+func (s *registryState) withEVMChains(chains map[uint64]ChainDetails, provenance Provenance) (*registryState, error) {
+	next := &registryState{
+		evmByChainID:  make(map[uint64]Chain, len(s.evmByChainID)+len(chains)),
+		evmBySelector: make(map[uint64]Chain, len(s.evmBySelector)+len(chains)),
+		evmByName:     make(map[string]Chain, len(s.evmByName)+len(chains)),
+		evmDetails:    make(map[uint64]ChainDetails, len(s.evmDetails)+len(chains)),
+		provenance:    make(map[uint64]Provenance, len(s.provenance)+len(chains)),
+	}
+	for chainID, ch := range s.evmByChainID {
+		next.evmByChainID[chainID] = ch
+	}
+	for sel, ch := range s.evmBySelector {
+		next.evmBySelector[sel] = ch
+	}
+	for name, ch := range s.evmByName {
+		next.evmByName[name] = ch
+	}
+	for chainID, details := range s.evmDetails {
+		next.evmDetails[chainID] = details
+	}
+	for chainID, p := range s.provenance {
+		next.provenance[chainID] = p
+	}
+
+	chainIDs := make([]uint64, 0, len(chains))
+	for chainID := range chains {
+		chainIDs = append(chainIDs, chainID)
+	}
+	sort.Slice(chainIDs, func(i, j int) bool { return chainIDs[i] < chainIDs[j] })
+
+	var errs MultiError
+	for i, chainID := range chainIDs {
+		details := chains[chainID]
+		if err := next.checkEVMChain(chainID, details); err != nil {
+			errs.add(i, fmt.Sprintf("chain %d", chainID), err)
+			continue
+		}
+
+		if previous, exists := next.evmByChainID[chainID]; exists {
+			delete(next.evmBySelector, previous.Selector)
+			delete(next.evmByName, previous.Name)
+		}
+		ch := Chain{
+			EvmChainID: chainID,
+			Selector:   details.ChainSelector,
+			Name:       details.ChainName,
+			VarName:    strings.ToUpper(strings.ReplaceAll(details.ChainName, "-", "_")),
+		}
+		next.evmByChainID[chainID] = ch
+		next.evmDetails[chainID] = details
+		next.provenance[chainID] = provenance
+		next.evmBySelector[ch.Selector] = ch
+		if ch.Name != "" {
+			next.evmByName[ch.Name] = ch
+		}
+	}
+	if err := errs.errOrNil(); err != nil {
+		return nil, err
+	}
+	return next, nil
+}
+
+// withoutEVMChains returns a copy of the state without the loaded chains of the given chain ids.
+// Chain ids not loaded into the state are ignored.
+func (s *registryState) withoutEVMChains(chainIDs []uint64) *registryState {
+	removed := make(map[uint64]bool, len(chainIDs))
+	for _, chainID := range chainIDs {
+		removed[chainID] = true
+	}
+	next := &registryState{
+		evmByChainID:  make(map[uint64]Chain, len(s.evmByChainID)),
+		evmBySelector: make(map[uint64]Chain, len(s.evmBySelector)),
+		evmByName:     make(map[string]Chain, len(s.evmByName)),
+		evmDetails:    make(map[uint64]ChainDetails, len(s.evmDetails)),
+		provenance:    make(map[uint64]Provenance, len(s.provenance)),
+	}
+	for chainID, ch := range s.evmByChainID {
+		if removed[chainID] {
+			continue
+		}
+		next.evmByChainID[chainID] = ch
+		next.evmBySelector[ch.Selector] = ch
+		if ch.Name != "" {
+			next.evmByName[ch.Name] = ch
+		}
+		next.evmDetails[chainID] = s.evmDetails[chainID]
+		next.provenance[chainID] = s.provenance[chainID]
+	}
+	return next
+}
+
+// checkEVMChain returns why the chain cannot be loaded into the state, if it cannot.
+func (s *registryState) checkEVMChain(chainID uint64, details ChainDetails) error {
+	if details.ChainSelector == SelectorNone {
+		return errors.New("has no selector")
+	}
+	if err := ValidateChainSelector(details.ChainSelector); err != nil {
+		return err
+	}
+	if embedded, exists := evmChainIdToChainSelector()[chainID]; exists && embedded.ChainSelector != details.ChainSelector {
+		return fmt.Errorf("is already known with selector %d, cannot load selector %d", embedded.ChainSelector, details.ChainSelector)
+	}
+	if owner, exists := s.evmBySelector[details.ChainSelector]; exists && owner.EvmChainID != chainID {
+		return fmt.Errorf("selector %d is already used by chain %d", details.ChainSelector, owner.EvmChainID)
+	}
+	if _, exists := chainDetailsBySelector()[details.ChainSelector]; exists {
+		if embedded, isEvm := evmChainsBySelector()[details.ChainSelector]; !isEvm || embedded.EvmChainID != chainID {
+			return fmt.Errorf("selector %d is already used by another chain", details.ChainSelector)
+		}
+	}
+	if details.ChainName == "" {
+		return nil
+	}
+	if owner, exists := s.evmByName[details.ChainName]; exists && owner.EvmChainID != chainID {
+		return fmt.Errorf("name %s is already used by chain %d", details.ChainName, owner.EvmChainID)
+	}
+	for selector, embedded := range chainDetailsBySelector() {
+		if embedded.ChainName == details.ChainName && selector != details.ChainSelector {
+			return fmt.Errorf("name %s is already used by the chain of selector %d", details.ChainName, selector)
+		}
+	}
+	return nil
+}
+
+// LoadYAML loads additional EVM chains in the selectors.yml format into the registry. Chains
+// already embedded in the package may be loaded again with their own selector, for instance
+// to rename them, but never with a different one, and no chain may take the name of another
+// embedded or loaded chain. The file is applied atomically: on error the registry is left
+// unchanged.
+//
+// LoadYAML is safe to call while other goroutines use the registry, lookups observe either
+// the chains before or after the load and never block.
+func (r *Registry) LoadYAML(ymlFile []byte) error {
+	return r.loadYAML(ymlFile, ProvenanceOverride)
+}
+
+// loadYAML loads the chains of the file, attributing them to the source.
+func (r *Registry) loadYAML(ymlFile []byte, source ProvenanceSource) error {
+	chains, err := decodeSelectorsYml(ymlFile)
+	if err != nil {
+		return fmt.Errorf("failed to decode selectors: %w", err)
+	}
+	return r.loadChains(chains, source, datasetVersion(ymlFile))
+}
+
+// loadChains loads the decoded chains of a dataset, attributing them to the source.
+func (r *Registry) loadChains(chains map[uint64]ChainDetails, source ProvenanceSource, version string) error {
+	r.mu.Lock()
+	previous := r.loadState()
+	provenance := Provenance{Source: source, DatasetVersion: version, LoadedAt: time.Now()}
+	next, err := previous.withEVMChains(chains, provenance)
+	if err != nil {
+		r.mu.Unlock()
+		return err
+	}
+	r.publish(next)
+	if source == ProvenanceRemote {
+		r.lastSync = provenance
+	}
+	listeners := r.listeners
+	auditErr := r.auditLoad(previous, next, source, provenance)
+	r.mu.Unlock()
+
+	r.notify(listeners, diffStates(previous, next, source))
+	return auditErr
+}
+
+// publish makes the state visible to lookups and records it in the history, r.mu must be held.
+func (r *Registry) publish(next *registryState) {
+	r.state.Store(next)
+	r.history = append(r.history, &stateVersion{state: next})
+	if len(r.history) > deltaHistorySize {
+		r.history = append([]*stateVersion(nil), r.history[len(r.history)-deltaHistorySize:]...)
+	}
+}
+
+// loadState returns the chains currently loaded into the registry.
+func (r *Registry) loadState() *registryState {
+	if s := r.state.Load(); s != nil {
+		return s
+	}
+	return emptyRegistryState
+}
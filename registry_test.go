@@ -0,0 +1,221 @@
+package chain_selectors
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RegistryDefaultsIncludeTestChains(t *testing.T) {
+	registry := NewRegistry()
+
+	selector, err := registry.SelectorFromChainID(TEST_90000001.EvmChainID)
+	require.NoError(t, err)
+	assert.Equal(t, TEST_90000001.Selector, selector)
+
+	ch, exists := registry.ChainBySelector(TEST_90000001.Selector)
+	require.True(t, exists)
+	assert.Equal(t, TEST_90000001, ch)
+}
+
+func Test_RegistryWithoutTestChains(t *testing.T) {
+	registry := NewRegistry(WithTestChains(false))
+
+	for _, chainID := range TestChainIds() {
+		details := evmTestSelectorsMap()[chainID]
+
+		_, err := registry.SelectorFromChainID(chainID)
+		assert.Error(t, err, "test chain %d should not resolve", chainID)
+
+		_, err = registry.GetChainDetailsByChainIDAndFamily(strconv.FormatUint(chainID, 10), FamilyEVM)
+		assert.Error(t, err)
+
+		_, err = registry.GetSelectorFamily(details.ChainSelector)
+		assert.Error(t, err)
+
+		_, err = registry.GetChainIDFromSelector(details.ChainSelector)
+		assert.Error(t, err)
+
+		_, err = registry.GetChainDetailsBySelector(details.ChainSelector)
+		assert.Error(t, err)
+
+		_, exists := registry.ChainBySelector(details.ChainSelector)
+		assert.False(t, exists)
+
+		_, exists = registry.ChainByEvmChainID(chainID)
+		assert.False(t, exists)
+	}
+
+	for genesisHash, details := range solanaTestSelectorsMap() {
+		_, err := registry.GetChainDetailsByChainIDAndFamily(genesisHash, FamilySolana)
+		assert.Error(t, err)
+
+		_, err = registry.GetSelectorFamily(details.ChainSelector)
+		assert.Error(t, err)
+	}
+
+	_, exists := registry.ChainByName("geth-devnet-2")
+	assert.False(t, exists)
+
+	// custom chains are test chains
+	const customChainID = 9388501
+	customSelector := generateCustomChainSelector(customChainID)
+	require.True(t, IsTestChain(customSelector))
+	_, err := registry.SelectorFromChainID(customChainID)
+	assert.Error(t, err)
+	_, err = registry.GetChainDetailsByChainIDAndFamily(strconv.FormatUint(customChainID, 10), FamilyEVM)
+	assert.Error(t, err)
+	_, exists = registry.ChainByEvmChainID(customChainID)
+	assert.False(t, exists)
+	_, exists = registry.ChainBySelector(customSelector)
+	assert.False(t, exists)
+	_, exists = registry.ChainByName(generateCustomChainName(customChainID))
+	assert.False(t, exists)
+	_, err = registry.GetChainIDFromSelector(customSelector)
+	assert.Error(t, err)
+}
+
+func Test_RegistryWithoutTestChainsResolvesOfficialChains(t *testing.T) {
+	registry := NewRegistry(WithTestChains(false))
+
+	selector, err := registry.SelectorFromChainID(1)
+	require.NoError(t, err)
+	assert.Equal(t, ETHEREUM_MAINNET.Selector, selector)
+
+	family, err := registry.GetSelectorFamily(SOLANA_MAINNET.Selector)
+	require.NoError(t, err)
+	assert.Equal(t, FamilySolana, family)
+
+	// official selectors assigned in the custom range before it was reserved
+	assert.False(t, IsTestChain(ETHEREUM_MAINNET_TAIKO_1.Selector))
+	ch, exists := registry.ChainBySelector(ETHEREUM_MAINNET_TAIKO_1.Selector)
+	require.True(t, exists)
+	assert.Equal(t, ETHEREUM_MAINNET_TAIKO_1, ch)
+
+	ch, exists = registry.ChainByName("sepolia")
+	require.True(t, exists)
+	assert.Equal(t, ETHEREUM_TESTNET_SEPOLIA, ch)
+}
+
+func Test_RegistryLoadYAML(t *testing.T) {
+	registry := NewRegistry()
+
+	err := registry.LoadYAML([]byte(`
+selectors:
+  77001:
+    selector: 7700100000000000001
+    name: "private-devnet-1"
+  1:
+    selector: 5009297550715157269
+    name: "ethereum-mainnet-renamed"
+`))
+	require.NoError(t, err)
+
+	ch, exists := registry.ChainByEvmChainID(77001)
+	require.True(t, exists)
+	assert.Equal(t, Chain{EvmChainID: 77001, Selector: 7700100000000000001, Name: "private-devnet-1", VarName: "PRIVATE_DEVNET_1"}, ch)
+
+	selector, err := registry.SelectorFromChainID(77001)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(7700100000000000001), selector)
+
+	family, err := registry.GetSelectorFamily(7700100000000000001)
+	require.NoError(t, err)
+	assert.Equal(t, FamilyEVM, family)
+
+	chainID, err := registry.GetChainIDFromSelector(7700100000000000001)
+	require.NoError(t, err)
+	assert.Equal(t, "77001", chainID)
+
+	details, err := registry.GetChainDetailsByChainIDAndFamily("1", FamilyEVM)
+	require.NoError(t, err)
+	assert.Equal(t, "ethereum-mainnet-renamed", details.ChainName)
+
+	_, exists = registry.ChainByName("private-devnet-1")
+	assert.True(t, exists)
+
+	// The package level lookups are not affected by a registry.
+	_, exists = ChainByName("private-devnet-1")
+	assert.False(t, exists)
+	name, err := NameFromChainId(1)
+	require.NoError(t, err)
+	assert.Equal(t, "ethereum-mainnet", name)
+}
+
+func Test_RegistryLoadYAMLConflicts(t *testing.T) {
+	tests := []struct {
+		name string
+		yml  string
+	}{
+		{
+			name: "embedded chain with another selector",
+			yml:  "selectors:\n  1:\n    selector: 42\n    name: fake-mainnet\n",
+		},
+		{
+			name: "selector of an embedded chain",
+			yml:  "selectors:\n  77002:\n    selector: 5009297550715157269\n    name: fake-mainnet\n",
+		},
+		{
+			name: "selector of a non evm chain",
+			yml:  "selectors:\n  77002:\n    selector: " + strconv.FormatUint(SOLANA_MAINNET.Selector, 10) + "\n    name: fake-solana\n",
+		},
+		{
+			name: "selector used twice",
+			yml:  "selectors:\n  77002:\n    selector: 77\n  77003:\n    selector: 77\n",
+		},
+		{
+			name: "name of an embedded chain",
+			yml:  "selectors:\n  99999:\n    selector: 1234567\n    name: ethereum-mainnet\n",
+		},
+		{
+			name: "name of a non evm chain",
+			yml:  "selectors:\n  99999:\n    selector: 1234567\n    name: " + SOLANA_MAINNET.Name + "\n",
+		},
+		{
+			name: "name of a loaded chain",
+			yml:  "selectors:\n  77002:\n    selector: 78\n    name: loaded-devnet\n",
+		},
+		{
+			name: "name used twice",
+			yml:  "selectors:\n  77002:\n    selector: 78\n    name: twin-devnet\n  77003:\n    selector: 79\n    name: twin-devnet\n",
+		},
+		{
+			name: "missing selector",
+			yml:  "selectors:\n  77002:\n    name: no-selector\n",
+		},
+		{
+			name: "invalid yaml",
+			yml:  "selectors: [",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			registry := NewRegistry()
+			require.NoError(t, registry.LoadYAML([]byte("selectors:\n  77001:\n    selector: 7700100000000000001\n    name: loaded-devnet\n")))
+
+			require.Error(t, registry.LoadYAML([]byte(test.yml)))
+			assert.Len(t, registry.loadState().evmByChainID, 1, "a failed load must leave the registry unchanged")
+		})
+	}
+}
+
+func Test_RegistryLoadYAMLReplacesLoadedChain(t *testing.T) {
+	registry := NewRegistry()
+	require.NoError(t, registry.LoadYAML([]byte("selectors:\n  77001:\n    selector: 11\n    name: devnet-a\n")))
+	require.NoError(t, registry.LoadYAML([]byte("selectors:\n  77001:\n    selector: 12\n    name: devnet-b\n")))
+
+	_, exists := registry.ChainBySelector(11)
+	assert.False(t, exists)
+	_, exists = registry.ChainByName("devnet-a")
+	assert.False(t, exists)
+
+	ch, exists := registry.ChainByName("devnet-b")
+	require.True(t, exists)
+	assert.Equal(t, uint64(12), ch.Selector)
+
+	// the chain may keep its own name
+	require.NoError(t, registry.LoadYAML([]byte("selectors:\n  77001:\n    selector: 12\n    name: devnet-b\n")))
+}
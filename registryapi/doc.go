@@ -0,0 +1,32 @@
+// Package registryapi exposes the chains of the selectors package through a single family
+// agnostic Registry instead of the mix of EVM only functions, custom chain variants and globals
+// of the package, every lookup returns the same Chain type and fails with a *LookupError.
+//
+// The selectors package remains the implementation: it owns the embedded datasets, the custom
+// chains and the remote sources, and registryapi only adapts its Registry. Both APIs therefore
+// resolve the same chains and can be used side by side while migrating. The package is not
+// named v2 as, without a go.mod of its own, a v2 directory would read as the second major
+// version of the module under the semantic import versioning of Go modules.
+//
+// # Design note
+//
+// The package was first planned the other way around, with the implementation here and the
+// selectors package delegating to it. That direction was inverted on purpose. The selectors
+// package exports the generated chain variables, such as ETHEREUM_MAINNET and ALL, and the types
+// they are declared with. go generate and go:embed write and read them in that package, and the
+// upstream compatibility checks pin their behaviour. Moving the implementation here would turn
+// every one of those types into an alias of a type of this package. Reflection, %T and error
+// messages would then report the types under new names, while lookups, datasets and behaviour
+// stayed the same.
+// Keeping the implementation where the data lives makes this package the stable surface: new
+// code uses it while the deprecated functions of the selectors package are phased out, see the
+// chainsel_strict_api build tag. Once they are gone, moving the implementation here is a
+// mechanical change that this API does not expose.
+//
+//	chain_selectors                           registryapi
+//	GetChainDetailsBySelector(sel)            Default().BySelector(sel)
+//	SelectorFromChainId(id)                   Default().ByChainID(FamilyEVM, "id")
+//	GetChainDetailsByChainIDAndFamily(id, f)  Default().ByChainID(f, id)
+//	ChainByName(name)                         Default().ByName(name)
+//	NewRegistry(opts...)                      NewRegistry(opts...)
+package registryapi
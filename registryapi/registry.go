@@ -0,0 +1,217 @@
+package registryapi
+
+import (
+	"context"
+	"strconv"
+	"sync"
+
+	chainselectors "github.com/fravlaca/chain-selectors"
+)
+
+// Family identifies a chain family.
+type Family string
+
+const (
+	FamilyEVM    Family = chainselectors.FamilyEVM
+	FamilySolana Family = chainselectors.FamilySolana
+	FamilyAptos  Family = chainselectors.FamilyAptos
+	FamilySui    Family = chainselectors.FamilySui
+	FamilyTron   Family = chainselectors.FamilyTron
+	FamilyTon    Family = chainselectors.FamilyTon
+)
+
+// Chain is a chain of any family.
+type Chain struct {
+	Family Family
+	// ChainID is the family specific chain id, in decimal for numeric ids.
+	ChainID  string
+	Selector uint64
+	Name     string
+}
+
+type (
+	ChainDetails     = chainselectors.ChainDetails
+	ChangeSet        = chainselectors.ChangeSet
+	InputKind        = chainselectors.InputKind
+	LookupError      = chainselectors.LookupError
+	MatchPolicy      = chainselectors.MatchPolicy
+	MultiError       = chainselectors.MultiError
+	Provenance       = chainselectors.Provenance
+	ProvenanceSource = chainselectors.ProvenanceSource
+	RemoteResolver   = chainselectors.RemoteResolver
+)
+
+// The policies name based lookups match names with, see WithMatchPolicy.
+const (
+	MatchExact           = chainselectors.MatchExact
+	MatchCaseInsensitive = chainselectors.MatchCaseInsensitive
+	MatchFuzzy           = chainselectors.MatchFuzzy
+)
+
+// ErrChainNotFound is matched by the errors of lookups of chains that do not exist.
+var ErrChainNotFound = chainselectors.ErrChainNotFound
+
+// Option configures a Registry created with NewRegistry.
+type Option = chainselectors.RegistryOption
+
+// WithTestChains controls whether the chains defined in the test selector files can be
+// resolved, they are enabled by default.
+func WithTestChains(enabled bool) Option {
+	return chainselectors.WithTestChains(enabled)
+}
+
+// WithEmbeddedChains controls whether the registry resolves the chains known to the package in
+// addition to the chains loaded with LoadYAML, they are enabled by default.
+func WithEmbeddedChains(enabled bool) Option {
+	return chainselectors.WithEmbeddedChains(enabled)
+}
+
+// WithRemoteResolver lets Resolve fall back to a remote source for chains the registry does not know.
+func WithRemoteResolver(resolver RemoteResolver) Option {
+	return chainselectors.WithRemoteResolver(resolver)
+}
+
+// WithMatchPolicy sets how ByName matches names, MatchExact by default.
+func WithMatchPolicy(policy MatchPolicy) Option {
+	return chainselectors.WithMatchPolicy(policy)
+}
+
+// Registry resolves chains of every family. It is safe for concurrent use.
+type Registry struct {
+	registry *chainselectors.Registry
+}
+
+// NewRegistry creates a Registry configured with the given options.
+func NewRegistry(opts ...Option) *Registry {
+	return &Registry{registry: chainselectors.NewRegistry(opts...)}
+}
+
+var (
+	defaultRegistryOnce sync.Once
+	defaultRegistry     *Registry
+)
+
+// Default returns the registry with the default options shared by the process.
+func Default() *Registry {
+	defaultRegistryOnce.Do(func() {
+		defaultRegistry = NewRegistry()
+	})
+	return defaultRegistry
+}
+
+// BySelector resolves the chain identified by the selector.
+func (r *Registry) BySelector(selector uint64) (Chain, error) {
+	family, err := r.registry.GetSelectorFamily(selector)
+	if err != nil {
+		return Chain{}, err
+	}
+	chainID, err := r.registry.GetChainIDFromSelector(selector)
+	if err != nil {
+		return Chain{}, err
+	}
+	details, err := r.registry.GetChainDetailsBySelector(selector)
+	if err != nil {
+		return Chain{}, err
+	}
+	return Chain{Family: Family(family), ChainID: chainID, Selector: selector, Name: details.ChainName}, nil
+}
+
+// ByChainID resolves the chain identified by its family specific chain id.
+func (r *Registry) ByChainID(family Family, chainID string) (Chain, error) {
+	details, err := r.registry.GetChainDetailsByChainIDAndFamily(chainID, string(family))
+	if err != nil {
+		return Chain{}, err
+	}
+	return Chain{Family: family, ChainID: chainID, Selector: details.ChainSelector, Name: details.ChainName}, nil
+}
+
+// ByName resolves the chain with the given canonical name, alias or generated custom chain name,
+// matching it with the policy set with WithMatchPolicy.
+func (r *Registry) ByName(name string) (Chain, error) {
+	return r.ByNameMatching(name, r.registry.MatchPolicy())
+}
+
+// ByNameMatching is ByName matching the name with the given policy.
+func (r *Registry) ByNameMatching(name string, policy MatchPolicy) (Chain, error) {
+	if ch, err := r.byExactName(name); err == nil || policy == MatchExact {
+		return ch, err
+	}
+	candidates := r.registry.ChainNames()
+	for nonEVMName := range nonEVMSelectorsByName() {
+		candidates = append(candidates, nonEVMName)
+	}
+	if matched, exists := policy.Match(name, candidates); exists {
+		return r.byExactName(matched)
+	}
+	return Chain{}, &LookupError{Input: name, InputKind: chainselectors.InputName, Reason: "not found"}
+}
+
+func (r *Registry) byExactName(name string) (Chain, error) {
+	if ch, exists := r.registry.ChainByNameMatching(name, MatchExact); exists {
+		return Chain{Family: FamilyEVM, ChainID: strconv.FormatUint(ch.EvmChainID, 10), Selector: ch.Selector, Name: ch.Name}, nil
+	}
+	if selector, exists := nonEVMSelectorsByName()[name]; exists {
+		if ch, err := r.BySelector(selector); err == nil {
+			return ch, nil
+		}
+	}
+	return Chain{}, &LookupError{Input: name, InputKind: chainselectors.InputName, Reason: "not found"}
+}
+
+// Details returns the details of the chain identified by the selector and where they came from.
+func (r *Registry) Details(selector uint64) (ChainDetails, Provenance, error) {
+	return r.registry.GetChainDetailsWithProvenance(selector)
+}
+
+// Resolve is BySelector falling back to the remote resolver configured with WithRemoteResolver.
+func (r *Registry) Resolve(ctx context.Context, selector uint64) (Chain, Provenance, error) {
+	if ch, err := r.BySelector(selector); err == nil {
+		_, provenance, err := r.registry.GetChainDetailsWithProvenance(selector)
+		return ch, provenance, err
+	}
+	details, provenance, err := r.registry.ResolveSelector(ctx, selector)
+	if err != nil {
+		return Chain{}, Provenance{}, err
+	}
+	// remote sources only serve EVM chains, keyed by selector
+	return Chain{Family: FamilyEVM, Selector: selector, Name: details.ChainName}, provenance, nil
+}
+
+// LoadYAML loads additional EVM chains in the selectors.yml format into the registry.
+func (r *Registry) LoadYAML(ymlFile []byte) error {
+	return r.registry.LoadYAML(ymlFile)
+}
+
+// OnChange registers fn to be called with the chains changed by every load into the registry.
+// The returned function unregisters fn.
+func (r *Registry) OnChange(fn func(ChangeSet)) (cancel func()) {
+	return r.registry.OnChange(fn)
+}
+
+var (
+	nonEVMNamesOnce sync.Once
+	nonEVMNames     map[string]uint64
+)
+
+// nonEVMSelectorsByName indexes the selectors of the chains of every family but EVM by name.
+func nonEVMSelectorsByName() map[string]uint64 {
+	nonEVMNamesOnce.Do(func() {
+		nonEVMNames = make(map[string]uint64)
+		for _, ch := range chainselectors.SolanaALL {
+			nonEVMNames[ch.Name] = ch.Selector
+		}
+		for _, ch := range chainselectors.AptosALL {
+			nonEVMNames[ch.Name] = ch.Selector
+		}
+		for _, ch := range chainselectors.SuiALL {
+			nonEVMNames[ch.Name] = ch.Selector
+		}
+		for _, ch := range chainselectors.TronALL {
+			nonEVMNames[ch.Name] = ch.Selector
+		}
+		for _, ch := range chainselectors.TonALL {
+			nonEVMNames[ch.Name] = ch.Selector
+		}
+	})
+	return nonEVMNames
+}
@@ -0,0 +1,98 @@
+package registryapi
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	chainselectors "github.com/fravlaca/chain-selectors"
+)
+
+func TestRegistryResolvesEveryFamily(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected Chain
+	}{
+		{
+			name:     "evm",
+			expected: Chain{Family: FamilyEVM, ChainID: "1", Selector: chainselectors.ETHEREUM_MAINNET.Selector, Name: chainselectors.ETHEREUM_MAINNET.Name},
+		},
+		{
+			name:     "solana",
+			expected: Chain{Family: FamilySolana, ChainID: chainselectors.SOLANA_MAINNET.ChainID, Selector: chainselectors.SOLANA_MAINNET.Selector, Name: chainselectors.SOLANA_MAINNET.Name},
+		},
+		{
+			name:     "ton",
+			expected: Chain{Family: FamilyTon, ChainID: "-239", Selector: chainselectors.TON_MAINNET.Selector, Name: chainselectors.TON_MAINNET.Name},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ch, err := Default().BySelector(test.expected.Selector)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, ch)
+
+			ch, err = Default().ByChainID(test.expected.Family, test.expected.ChainID)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, ch)
+
+			ch, err = Default().ByName(test.expected.Name)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, ch)
+		})
+	}
+}
+
+func TestRegistryErrors(t *testing.T) {
+	_, err := Default().BySelector(1)
+	var lookupErr *LookupError
+	require.ErrorAs(t, err, &lookupErr)
+	assert.True(t, errors.Is(err, ErrChainNotFound))
+
+	_, err = Default().ByName("no-such-chain")
+	require.ErrorAs(t, err, &lookupErr)
+	assert.Equal(t, "no-such-chain", lookupErr.Input)
+	assert.ErrorIs(t, err, ErrChainNotFound)
+
+	_, err = NewRegistry(WithTestChains(false)).ByName("22222222222222222222222222222222222222222222")
+	assert.ErrorIs(t, err, ErrChainNotFound, "test chains of other families are hidden too")
+}
+
+func TestRegistryLoadedChains(t *testing.T) {
+	registry := NewRegistry(WithRemoteResolver(chainselectors.RemoteResolverFunc(func(_ context.Context, selector uint64) (ChainDetails, error) {
+		return ChainDetails{ChainSelector: selector, ChainName: "remote-chain"}, nil
+	})))
+	require.NoError(t, registry.LoadYAML([]byte("selectors:\n  77201:\n    selector: 7720100000000000001\n    name: loaded-devnet\n")))
+
+	ch, provenance, err := registry.Resolve(context.Background(), 7720100000000000001)
+	require.NoError(t, err)
+	assert.Equal(t, Chain{Family: FamilyEVM, ChainID: "77201", Selector: 7720100000000000001, Name: "loaded-devnet"}, ch)
+	assert.Equal(t, chainselectors.ProvenanceOverride, provenance.Source)
+
+	ch, provenance, err = registry.Resolve(context.Background(), 42)
+	require.NoError(t, err)
+	assert.Equal(t, "remote-chain", ch.Name)
+	assert.Equal(t, chainselectors.ProvenanceRemote, provenance.Source)
+}
+
+func TestRegistryByNameMatching(t *testing.T) {
+	fuzzy := NewRegistry(WithMatchPolicy(MatchFuzzy))
+
+	ch, err := fuzzy.ByName("Solana-Mainnet")
+	require.NoError(t, err)
+	assert.Equal(t, chainselectors.SOLANA_MAINNET.Selector, ch.Selector)
+
+	ch, err = fuzzy.ByName("ethereum-mainet")
+	require.NoError(t, err)
+	assert.Equal(t, chainselectors.ETHEREUM_MAINNET.Selector, ch.Selector)
+
+	_, err = Default().ByName("Solana-Mainnet")
+	assert.ErrorIs(t, err, ErrChainNotFound)
+	ch, err = Default().ByNameMatching("Solana-Mainnet", MatchCaseInsensitive)
+	require.NoError(t, err)
+	assert.Equal(t, FamilySolana, ch.Family)
+}
@@ -0,0 +1,178 @@
+package chain_selectors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ErrChainNotFound is returned, possibly wrapped, by a RemoteResolver that reached its source
+// and learned the chain does not exist, as opposed to failing to reach it.
+var ErrChainNotFound = errors.New("chain not found")
+
+// RemoteResolver resolves chains from a source outside the process, such as a registry service.
+type RemoteResolver interface {
+	ResolveSelector(ctx context.Context, selector uint64) (ChainDetails, error)
+}
+
+// RemoteResolverFunc adapts a function to the RemoteResolver interface.
+type RemoteResolverFunc func(ctx context.Context, selector uint64) (ChainDetails, error)
+
+func (f RemoteResolverFunc) ResolveSelector(ctx context.Context, selector uint64) (ChainDetails, error) {
+	return f(ctx, selector)
+}
+
+const (
+	DefaultCacheTTL          = 5 * time.Minute
+	DefaultNegativeCacheTTL  = 30 * time.Second
+	DefaultStaleRevalidation = time.Hour
+)
+
+// CachingResolver is a read-through cache in front of a RemoteResolver. Resolved chains are
+// cached for the TTL and ErrChainNotFound results for the negative TTL. Once a chain expires
+// it is still served for the stale window while it is refreshed in the background, and an
+// expired chain is served for as long as the source fails with anything but ErrChainNotFound,
+// so an outage of the source degrades lookups instead of failing them. It is safe for
+// concurrent use.
+type CachingResolver struct {
+	next        RemoteResolver
+	ttl         time.Duration
+	negativeTTL time.Duration
+	staleTTL    time.Duration
+	now         func() time.Time
+
+	mu         sync.Mutex
+	entries    map[uint64]cacheEntry
+	refreshing map[uint64]struct{}
+}
+
+type cacheEntry struct {
+	details  ChainDetails
+	notFound error
+	expires  time.Time
+}
+
+// CachingResolverOption configures a CachingResolver.
+type CachingResolverOption func(*CachingResolver)
+
+// WithCacheTTL sets how long resolved chains are served without asking the source.
+func WithCacheTTL(ttl time.Duration) CachingResolverOption {
+	return func(c *CachingResolver) {
+		c.ttl = ttl
+	}
+}
+
+// WithNegativeCacheTTL sets how long chains the source does not know are remembered as such.
+func WithNegativeCacheTTL(ttl time.Duration) CachingResolverOption {
+	return func(c *CachingResolver) {
+		c.negativeTTL = ttl
+	}
+}
+
+// WithStaleWhileRevalidate sets how long after expiring a chain is still served while it is
+// refreshed in the background. Zero refreshes expired chains before answering.
+func WithStaleWhileRevalidate(window time.Duration) CachingResolverOption {
+	return func(c *CachingResolver) {
+		c.staleTTL = window
+	}
+}
+
+// NewCachingResolver wraps the resolver with a cache configured by the options.
+func NewCachingResolver(next RemoteResolver, opts ...CachingResolverOption) *CachingResolver {
+	c := &CachingResolver{
+		next:        next,
+		ttl:         DefaultCacheTTL,
+		negativeTTL: DefaultNegativeCacheTTL,
+		staleTTL:    DefaultStaleRevalidation,
+		now:         time.Now,
+		entries:     make(map[uint64]cacheEntry),
+		refreshing:  make(map[uint64]struct{}),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// ResolveSelector returns the chain from the cache or, on a miss, from the wrapped resolver.
+func (c *CachingResolver) ResolveSelector(ctx context.Context, selector uint64) (ChainDetails, error) {
+	now := c.now()
+
+	c.mu.Lock()
+	entry, cached := c.entries[selector]
+	if cached && now.Before(entry.expires) {
+		c.mu.Unlock()
+		return entry.details, entry.notFound
+	}
+	if cached && entry.notFound == nil && now.Before(entry.expires.Add(c.staleTTL)) {
+		if _, running := c.refreshing[selector]; !running {
+			c.refreshing[selector] = struct{}{}
+			go c.refresh(selector)
+		}
+		c.mu.Unlock()
+		return entry.details, nil
+	}
+	c.mu.Unlock()
+
+	details, err := c.fetch(ctx, selector)
+	if err != nil && !errors.Is(err, ErrChainNotFound) && cached && entry.notFound == nil {
+		return entry.details, nil
+	}
+	return details, err
+}
+
+// refresh updates an entry served stale, in the background.
+func (c *CachingResolver) refresh(selector uint64) {
+	defer func() {
+		c.mu.Lock()
+		delete(c.refreshing, selector)
+		c.mu.Unlock()
+	}()
+	_, _ = c.fetch(context.Background(), selector)
+}
+
+// fetch asks the wrapped resolver and caches definitive answers.
+func (c *CachingResolver) fetch(ctx context.Context, selector uint64) (ChainDetails, error) {
+	details, err := c.next.ResolveSelector(ctx, selector)
+	switch {
+	case err == nil:
+		c.store(selector, cacheEntry{details: details, expires: c.now().Add(c.ttl)})
+	case errors.Is(err, ErrChainNotFound):
+		c.store(selector, cacheEntry{notFound: err, expires: c.now().Add(c.negativeTTL)})
+	}
+	return details, err
+}
+
+func (c *CachingResolver) store(selector uint64, entry cacheEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[selector] = entry
+}
+
+// Invalidate drops the cached answer for the selector.
+func (c *CachingResolver) Invalidate(selector uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, selector)
+}
+
+// ResolveSelector resolves the chain like GetChainDetailsWithProvenance and, if the registry does
+// not know it, asks the remote resolver configured with WithRemoteResolver.
+func (r *Registry) ResolveSelector(ctx context.Context, selector uint64) (ChainDetails, Provenance, error) {
+	details, provenance, err := r.GetChainDetailsWithProvenance(selector)
+	if err == nil || r.remote == nil {
+		return details, provenance, err
+	}
+
+	details, remoteErr := r.remote.ResolveSelector(ctx, selector)
+	if errors.Is(remoteErr, ErrChainNotFound) {
+		return ChainDetails{}, Provenance{}, errors.Join(err, remoteErr)
+	}
+	if remoteErr != nil {
+		// err matches ErrChainNotFound, which the remote resolver could not confirm
+		return ChainDetails{}, Provenance{}, fmt.Errorf("%s, remote lookup failed: %w", err, remoteErr)
+	}
+	return details, Provenance{Source: ProvenanceRemote}, nil
+}
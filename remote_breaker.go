@@ -0,0 +1,185 @@
+package chain_selectors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is returned by a ResilientResolver that stopped calling its source after
+// repeated failures and has no known-good answer to fall back to.
+var ErrCircuitOpen = errors.New("remote resolver circuit open")
+
+const (
+	DefaultRemoteRetries    = 2
+	DefaultRemoteBackoff    = 50 * time.Millisecond
+	DefaultRemoteMaxBackoff = time.Second
+	DefaultBreakerFailures  = 5
+	DefaultBreakerCooldown  = 30 * time.Second
+)
+
+const remoteBackoffMultiplier = 2
+
+// ResilientResolver retries a RemoteResolver with exponential backoff and stops calling it for
+// a cooldown once it failed a number of consecutive lookups, the circuit breaker opening. Until
+// the source recovers, lookups are answered from the last known good answers: the chains the
+// source resolved before and the snapshot given with WithFallbackSnapshot. ErrChainNotFound
+// is a valid answer and never counts as a failure. It is safe for concurrent use.
+type ResilientResolver struct {
+	next       RemoteResolver
+	retries    int
+	backoff    time.Duration
+	maxBackoff time.Duration
+	failures   int
+	cooldown   time.Duration
+	now        func() time.Time
+
+	mu                  sync.Mutex
+	consecutiveFailures int
+	openUntil           time.Time
+	probing             bool
+	lastKnownGood       map[uint64]ChainDetails
+}
+
+// ResilientResolverOption configures a ResilientResolver.
+type ResilientResolverOption func(*ResilientResolver)
+
+// WithRetries sets how many times a failed lookup is retried, backing off exponentially from
+// backoff up to maxBackoff between attempts.
+func WithRetries(retries int, backoff, maxBackoff time.Duration) ResilientResolverOption {
+	return func(r *ResilientResolver) {
+		r.retries = retries
+		r.backoff = backoff
+		r.maxBackoff = maxBackoff
+	}
+}
+
+// WithCircuitBreaker opens the circuit after the given number of consecutive failed lookups
+// and keeps it open for the cooldown, after which a single lookup probes the source.
+func WithCircuitBreaker(failures int, cooldown time.Duration) ResilientResolverOption {
+	return func(r *ResilientResolver) {
+		r.failures = failures
+		r.cooldown = cooldown
+	}
+}
+
+// WithFallbackSnapshot seeds the known good answers, for instance with a dataset persisted by
+// a previous run, so lookups can be answered even if the source is down from the start.
+func WithFallbackSnapshot(snapshot map[uint64]ChainDetails) ResilientResolverOption {
+	return func(r *ResilientResolver) {
+		for selector, details := range snapshot {
+			r.lastKnownGood[selector] = details
+		}
+	}
+}
+
+// NewResilientResolver wraps the resolver with retries, a circuit breaker and a fallback.
+func NewResilientResolver(next RemoteResolver, opts ...ResilientResolverOption) *ResilientResolver {
+	r := &ResilientResolver{
+		next:          next,
+		retries:       DefaultRemoteRetries,
+		backoff:       DefaultRemoteBackoff,
+		maxBackoff:    DefaultRemoteMaxBackoff,
+		failures:      DefaultBreakerFailures,
+		cooldown:      DefaultBreakerCooldown,
+		now:           time.Now,
+		lastKnownGood: make(map[uint64]ChainDetails),
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// ResolveSelector resolves the chain from the source, or from the last known good answers
+// while the source is failing.
+func (r *ResilientResolver) ResolveSelector(ctx context.Context, selector uint64) (ChainDetails, error) {
+	allowed, probe := r.allow()
+	if !allowed {
+		return r.fallback(selector, ErrCircuitOpen)
+	}
+
+	details, err := r.resolveWithRetries(ctx, selector)
+	r.record(selector, details, err, probe)
+	if err != nil && !errors.Is(err, ErrChainNotFound) {
+		return r.fallback(selector, err)
+	}
+	return details, err
+}
+
+func (r *ResilientResolver) resolveWithRetries(ctx context.Context, selector uint64) (ChainDetails, error) {
+	backoff := r.backoff
+	for attempt := 0; ; attempt++ {
+		details, err := r.next.ResolveSelector(ctx, selector)
+		if err == nil || errors.Is(err, ErrChainNotFound) || attempt >= r.retries {
+			return details, err
+		}
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ChainDetails{}, fmt.Errorf("%w (last error: %v)", ctx.Err(), err)
+		case <-timer.C:
+		}
+		backoff *= remoteBackoffMultiplier
+		if backoff > r.maxBackoff {
+			backoff = r.maxBackoff
+		}
+	}
+}
+
+// allow reports whether the source may be called. Once the cooldown of an open circuit
+// elapsed, a single caller is let through to probe the source, probe tells it is that caller.
+func (r *ResilientResolver) allow() (allowed, probe bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.consecutiveFailures < r.failures {
+		return true, false
+	}
+	if r.now().Before(r.openUntil) || r.probing {
+		return false, false
+	}
+	r.probing = true
+	return true, true
+}
+
+// record updates the breaker and the known good answers with the outcome of a lookup. Only the
+// outcome of the probe ends the probe, lookups let through before the circuit opened may
+// complete while it runs.
+func (r *ResilientResolver) record(selector uint64, details ChainDetails, err error, probe bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if probe {
+		r.probing = false
+	}
+	if err != nil && !errors.Is(err, ErrChainNotFound) {
+		r.consecutiveFailures++
+		if r.consecutiveFailures >= r.failures {
+			r.openUntil = r.now().Add(r.cooldown)
+		}
+		return
+	}
+	r.consecutiveFailures = 0
+	if err == nil {
+		r.lastKnownGood[selector] = details
+	}
+}
+
+func (r *ResilientResolver) fallback(selector uint64, cause error) (ChainDetails, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if details, exists := r.lastKnownGood[selector]; exists {
+		return details, nil
+	}
+	return ChainDetails{}, cause
+}
+
+// CircuitOpen reports whether the resolver currently stops calling its source.
+func (r *ResilientResolver) CircuitOpen() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.consecutiveFailures >= r.failures && r.now().Before(r.openUntil)
+}
@@ -0,0 +1,120 @@
+package chain_selectors
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResilientResolverRetries(t *testing.T) {
+	attempts := 0
+	flaky := RemoteResolverFunc(func(_ context.Context, selector uint64) (ChainDetails, error) {
+		attempts++
+		if attempts < 3 {
+			return ChainDetails{}, errors.New("timeout")
+		}
+		return ChainDetails{ChainSelector: selector, ChainName: "flaky"}, nil
+	})
+
+	resolver := NewResilientResolver(flaky, WithRetries(2, time.Millisecond, 2*time.Millisecond))
+	details, err := resolver.ResolveSelector(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, "flaky", details.ChainName)
+	assert.Equal(t, 3, attempts)
+}
+
+func TestResilientResolverNotFoundIsNotRetried(t *testing.T) {
+	remote := &fakeRemote{chains: map[uint64]ChainDetails{}}
+	resolver := NewResilientResolver(remote, WithRetries(3, time.Millisecond, time.Millisecond), WithCircuitBreaker(1, time.Minute))
+
+	_, err := resolver.ResolveSelector(context.Background(), 1)
+	require.ErrorIs(t, err, ErrChainNotFound)
+	assert.Equal(t, 1, remote.callCount())
+	assert.False(t, resolver.CircuitOpen())
+}
+
+func TestResilientResolverCircuitBreaker(t *testing.T) {
+	remote := &fakeRemote{chains: map[uint64]ChainDetails{1: {ChainSelector: 1, ChainName: "remote-1"}}}
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	resolver := NewResilientResolver(remote,
+		WithRetries(0, 0, 0),
+		WithCircuitBreaker(2, time.Minute),
+		WithFallbackSnapshot(map[uint64]ChainDetails{2: {ChainSelector: 2, ChainName: "snapshot-2"}}),
+	)
+	resolver.now = clock.Now
+
+	_, err := resolver.ResolveSelector(context.Background(), 1)
+	require.NoError(t, err)
+
+	outage := errors.New("connection refused")
+	remote.set(1, ChainDetails{ChainSelector: 1, ChainName: "remote-1"}, outage)
+
+	// Failures are answered from the last known good answers while the circuit closes.
+	details, err := resolver.ResolveSelector(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, "remote-1", details.ChainName)
+	_, err = resolver.ResolveSelector(context.Background(), 3)
+	require.ErrorIs(t, err, outage)
+	assert.True(t, resolver.CircuitOpen())
+
+	calls := remote.callCount()
+	details, err = resolver.ResolveSelector(context.Background(), 2)
+	require.NoError(t, err)
+	assert.Equal(t, "snapshot-2", details.ChainName)
+	_, err = resolver.ResolveSelector(context.Background(), 3)
+	require.ErrorIs(t, err, ErrCircuitOpen)
+	assert.Equal(t, calls, remote.callCount(), "an open circuit does not call the source")
+
+	// After the cooldown a probe closes the circuit again once the source recovered.
+	remote.set(3, ChainDetails{ChainSelector: 3, ChainName: "remote-3"}, nil)
+	clock.Advance(2 * time.Minute)
+	details, err = resolver.ResolveSelector(context.Background(), 3)
+	require.NoError(t, err)
+	assert.Equal(t, "remote-3", details.ChainName)
+	assert.False(t, resolver.CircuitOpen())
+}
+
+func TestResilientResolverLateResultDoesNotEndProbe(t *testing.T) {
+	outage := errors.New("connection refused")
+	remote := &fakeRemote{chains: map[uint64]ChainDetails{}}
+	remote.set(1, ChainDetails{}, outage)
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	resolver := NewResilientResolver(remote, WithRetries(0, 0, 0), WithCircuitBreaker(1, time.Minute))
+	resolver.now = clock.Now
+
+	_, err := resolver.ResolveSelector(context.Background(), 1)
+	require.ErrorIs(t, err, outage)
+	require.True(t, resolver.CircuitOpen())
+
+	clock.Advance(2 * time.Minute)
+	allowed, probe := resolver.allow()
+	require.True(t, allowed)
+	require.True(t, probe)
+
+	// a lookup let through before the circuit opened completes while the probe runs
+	resolver.record(1, ChainDetails{}, outage, false)
+	clock.Advance(2 * time.Minute)
+	calls := remote.callCount()
+	_, err = resolver.ResolveSelector(context.Background(), 1)
+	require.ErrorIs(t, err, ErrCircuitOpen)
+	assert.Equal(t, calls, remote.callCount(), "a single probe runs at a time")
+
+	resolver.record(1, ChainDetails{ChainSelector: 1, ChainName: "remote-1"}, nil, true)
+	assert.False(t, resolver.CircuitOpen())
+}
+
+func TestResilientResolverHonorsContext(t *testing.T) {
+	failing := RemoteResolverFunc(func(context.Context, uint64) (ChainDetails, error) {
+		return ChainDetails{}, errors.New("timeout")
+	})
+	resolver := NewResilientResolver(failing, WithRetries(10, time.Hour, time.Hour))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := resolver.ResolveSelector(ctx, 1)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
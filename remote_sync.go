@@ -0,0 +1,183 @@
+package chain_selectors
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DatasetSource fetches a complete dataset of EVM chains in the selectors.yml format, for
+// instance from a registry service.
+type DatasetSource interface {
+	FetchDataset(ctx context.Context) ([]byte, error)
+}
+
+// DatasetSourceFunc adapts a function to the DatasetSource interface.
+type DatasetSourceFunc func(ctx context.Context) ([]byte, error)
+
+func (f DatasetSourceFunc) FetchDataset(ctx context.Context) ([]byte, error) {
+	return f(ctx)
+}
+
+// DeltaSource is a DatasetSource that can also fetch the delta from a version of the dataset,
+// identified by the fingerprint of a registry, to the latest one, see Registry.ComputeDelta.
+type DeltaSource interface {
+	DatasetSource
+	FetchDelta(ctx context.Context, fromFingerprint string) (Delta, error)
+}
+
+// RemoteSync loads the datasets fetched from a DatasetSource into a Registry, attributing the
+// chains to ProvenanceRemote. With WithSnapshotFile the last dataset loaded successfully is
+// persisted and can be loaded at startup, before the source is reachable.
+type RemoteSync struct {
+	registry     *Registry
+	source       DatasetSource
+	snapshotPath string
+}
+
+// RemoteSyncOption configures a RemoteSync.
+type RemoteSyncOption func(*RemoteSync)
+
+// WithSnapshotFile persists every successfully synced dataset to path, see LoadSnapshot.
+func WithSnapshotFile(path string) RemoteSyncOption {
+	return func(s *RemoteSync) {
+		s.snapshotPath = path
+	}
+}
+
+// NewRemoteSync creates a RemoteSync loading the datasets of the source into the registry.
+func NewRemoteSync(registry *Registry, source DatasetSource, opts ...RemoteSyncOption) *RemoteSync {
+	s := &RemoteSync{registry: registry, source: source}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Sync fetches the dataset, loads it into the registry and persists it to the snapshot file.
+// A dataset the registry rejects is neither loaded nor persisted.
+//
+// If the source is a DeltaSource, Sync first fetches the delta from the fingerprint of the
+// registry and applies it, falling back to the whole dataset if the delta cannot be fetched or
+// applied. The snapshot then holds the chains of the registry attributed to ProvenanceRemote.
+func (s *RemoteSync) Sync(ctx context.Context) error {
+	if source, ok := s.source.(DeltaSource); ok {
+		if delta, err := source.FetchDelta(ctx, s.registry.Fingerprint()); err == nil && s.registry.ApplyDelta(delta) == nil {
+			return s.persistRemoteChains()
+		}
+	}
+
+	dataset, err := s.source.FetchDataset(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fetch dataset: %w", err)
+	}
+	if err := s.registry.loadYAML(dataset, ProvenanceRemote); err != nil {
+		return fmt.Errorf("failed to load fetched dataset: %w", err)
+	}
+	if s.snapshotPath == "" {
+		return nil
+	}
+	if err := writeSnapshot(s.snapshotPath, dataset); err != nil {
+		return fmt.Errorf("dataset loaded but not persisted: %w", err)
+	}
+	return nil
+}
+
+// persistRemoteChains persists the chains of the registry attributed to ProvenanceRemote in
+// the selectors.yml format, if a snapshot file is configured.
+func (s *RemoteSync) persistRemoteChains() error {
+	if s.snapshotPath == "" {
+		return nil
+	}
+	state := s.registry.loadState()
+	chains := make(map[uint64]ChainDetails)
+	for chainID, provenance := range state.provenance {
+		if provenance.Source == ProvenanceRemote {
+			chains[chainID] = state.evmDetails[chainID]
+		}
+	}
+	dataset, err := yaml.Marshal(map[string]map[uint64]ChainDetails{"selectors": chains})
+	if err != nil {
+		return fmt.Errorf("delta applied but not persisted: %w", err)
+	}
+	if err := writeSnapshot(s.snapshotPath, dataset); err != nil {
+		return fmt.Errorf("delta applied but not persisted: %w", err)
+	}
+	return nil
+}
+
+// LoadSnapshot loads the dataset persisted by the last successful Sync into the registry. It
+// returns an error wrapping os.ErrNotExist if there is no snapshot yet, and fails without
+// loading anything if the snapshot does not match its checksum.
+func (s *RemoteSync) LoadSnapshot() error {
+	if s.snapshotPath == "" {
+		return errors.New("no snapshot file configured")
+	}
+	dataset, err := readSnapshot(s.snapshotPath)
+	if err != nil {
+		return err
+	}
+	if err := s.registry.loadYAML(dataset, ProvenanceRemote); err != nil {
+		return fmt.Errorf("failed to load snapshot %s: %w", s.snapshotPath, err)
+	}
+	return nil
+}
+
+// snapshotChecksumPrefix starts the first line of a snapshot, followed by the hex encoded
+// SHA-256 of the dataset stored after that line.
+const snapshotChecksumPrefix = "# sha256:"
+
+// writeSnapshot atomically replaces the snapshot at path: the dataset is written to a temporary
+// file in the same directory, synced and renamed over the previous snapshot.
+func writeSnapshot(path string, dataset []byte) error {
+	sum := sha256.Sum256(dataset)
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := fmt.Fprintf(tmp, "%s%s\n", snapshotChecksumPrefix, hex.EncodeToString(sum[:])); err != nil {
+		tmp.Close()
+		return err
+	}
+	if _, err := tmp.Write(dataset); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// readSnapshot returns the dataset stored in the snapshot at path after verifying its checksum.
+func readSnapshot(path string) ([]byte, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read snapshot: %w", err)
+	}
+
+	header, dataset, found := bytes.Cut(content, []byte("\n"))
+	checksum, hasPrefix := bytes.CutPrefix(header, []byte(snapshotChecksumPrefix))
+	if !found || !hasPrefix {
+		return nil, fmt.Errorf("snapshot %s has no checksum header", path)
+	}
+	sum := sha256.Sum256(dataset)
+	if hex.EncodeToString(sum[:]) != string(checksum) {
+		return nil, fmt.Errorf("snapshot %s does not match its checksum", path)
+	}
+	return dataset, nil
+}
@@ -0,0 +1,114 @@
+package chain_selectors
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const remoteDataset = "selectors:\n  99101:\n    selector: 9910100000000000001\n    name: remote-devnet\n"
+
+func TestRemoteSyncPersistsSnapshot(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "snapshot.yml")
+	source := DatasetSourceFunc(func(context.Context) ([]byte, error) {
+		return []byte(remoteDataset), nil
+	})
+
+	registry := NewRegistry()
+	require.NoError(t, NewRemoteSync(registry, source, WithSnapshotFile(path)).Sync(context.Background()))
+
+	_, provenance, err := registry.GetChainDetailsWithProvenance(9910100000000000001)
+	require.NoError(t, err)
+	assert.Equal(t, ProvenanceRemote, provenance.Source)
+
+	// A new process loads the snapshot before the source is reachable.
+	unreachable := DatasetSourceFunc(func(context.Context) ([]byte, error) {
+		return nil, errors.New("connection refused")
+	})
+	restarted := NewRegistry()
+	remoteSync := NewRemoteSync(restarted, unreachable, WithSnapshotFile(path))
+	require.NoError(t, remoteSync.LoadSnapshot())
+	require.Error(t, remoteSync.Sync(context.Background()))
+
+	details, restoredProvenance, err := restarted.GetChainDetailsWithProvenance(9910100000000000001)
+	require.NoError(t, err)
+	assert.Equal(t, "remote-devnet", details.ChainName)
+	assert.Equal(t, provenance.DatasetVersion, restoredProvenance.DatasetVersion)
+
+	entries, err := os.ReadDir(filepath.Dir(path))
+	require.NoError(t, err)
+	assert.Len(t, entries, 1, "temporary files are cleaned up")
+}
+
+func TestRemoteSyncRejectsCorruptSnapshot(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "snapshot.yml")
+	require.NoError(t, writeSnapshot(path, []byte(remoteDataset)))
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, append(content, "  # tampered\n"...), 0o644))
+
+	registry := NewRegistry()
+	require.Error(t, NewRemoteSync(registry, nil, WithSnapshotFile(path)).LoadSnapshot())
+	_, exists := registry.ChainBySelector(9910100000000000001)
+	assert.False(t, exists)
+
+	require.NoError(t, os.WriteFile(path, []byte(remoteDataset), 0o644))
+	require.Error(t, NewRemoteSync(registry, nil, WithSnapshotFile(path)).LoadSnapshot())
+
+	err = NewRemoteSync(registry, nil, WithSnapshotFile(filepath.Join(t.TempDir(), "missing.yml"))).LoadSnapshot()
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestRemoteSyncDoesNotPersistRejectedDataset(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "snapshot.yml")
+	source := DatasetSourceFunc(func(context.Context) ([]byte, error) {
+		return []byte("selectors:\n  1:\n    selector: 42\n"), nil
+	})
+
+	require.Error(t, NewRemoteSync(NewRegistry(), source, WithSnapshotFile(path)).Sync(context.Background()))
+	_, err := os.Stat(path)
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
+
+type deltaSource struct {
+	DatasetSourceFunc
+	primary *Registry
+}
+
+func (s deltaSource) FetchDelta(_ context.Context, fromFingerprint string) (Delta, error) {
+	return s.primary.ComputeDelta(fromFingerprint)
+}
+
+func TestRemoteSyncAppliesDeltas(t *testing.T) {
+	primary := NewRegistry()
+	require.NoError(t, primary.LoadYAML([]byte(remoteDataset)))
+	var fullFetches int
+	source := deltaSource{primary: primary, DatasetSourceFunc: func(context.Context) ([]byte, error) {
+		fullFetches++
+		return []byte(remoteDataset), nil
+	}}
+
+	path := filepath.Join(t.TempDir(), "snapshot.yml")
+	replica := NewRegistry()
+	require.NoError(t, NewRemoteSync(replica, source, WithSnapshotFile(path)).Sync(context.Background()))
+	assert.Equal(t, 0, fullFetches)
+	assert.Equal(t, primary.Fingerprint(), replica.Fingerprint())
+
+	restarted := NewRegistry()
+	require.NoError(t, NewRemoteSync(restarted, nil, WithSnapshotFile(path)).LoadSnapshot())
+	assert.Equal(t, primary.Fingerprint(), restarted.Fingerprint())
+
+	// a replica the primary cannot compute a delta for syncs the whole dataset
+	diverged := NewRegistry()
+	require.NoError(t, diverged.LoadYAML([]byte("selectors:\n  77001:\n    selector: 11\n    name: devnet-a\n")))
+	require.NoError(t, NewRemoteSync(diverged, source).Sync(context.Background()))
+	assert.Equal(t, 1, fullFetches)
+	_, exists := diverged.ChainBySelector(9910100000000000001)
+	assert.True(t, exists)
+}
@@ -0,0 +1,169 @@
+package chain_selectors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeRemote serves a mutable set of chains and counts the lookups it answers.
+type fakeRemote struct {
+	mu     sync.Mutex
+	chains map[uint64]ChainDetails
+	err    error
+	calls  int
+}
+
+func (f *fakeRemote) ResolveSelector(_ context.Context, selector uint64) (ChainDetails, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls++
+	if f.err != nil {
+		return ChainDetails{}, f.err
+	}
+	details, exists := f.chains[selector]
+	if !exists {
+		return ChainDetails{}, fmt.Errorf("selector %d: %w", selector, ErrChainNotFound)
+	}
+	return details, nil
+}
+
+func (f *fakeRemote) set(selector uint64, details ChainDetails, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.chains[selector] = details
+	f.err = err
+}
+
+func (f *fakeRemote) callCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.calls
+}
+
+// fakeClock is a manually advanced clock.
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func newTestCachingResolver(remote RemoteResolver, clock *fakeClock) *CachingResolver {
+	c := NewCachingResolver(remote,
+		WithCacheTTL(time.Minute),
+		WithNegativeCacheTTL(10*time.Second),
+		WithStaleWhileRevalidate(time.Hour),
+	)
+	c.now = clock.Now
+	return c
+}
+
+func TestCachingResolverCachesResults(t *testing.T) {
+	remote := &fakeRemote{chains: map[uint64]ChainDetails{1: {ChainSelector: 1, ChainName: "remote-1"}}}
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	cache := newTestCachingResolver(remote, clock)
+
+	for i := 0; i < 3; i++ {
+		details, err := cache.ResolveSelector(context.Background(), 1)
+		require.NoError(t, err)
+		assert.Equal(t, "remote-1", details.ChainName)
+	}
+	assert.Equal(t, 1, remote.callCount())
+
+	for i := 0; i < 3; i++ {
+		_, err := cache.ResolveSelector(context.Background(), 2)
+		require.ErrorIs(t, err, ErrChainNotFound)
+	}
+	assert.Equal(t, 2, remote.callCount(), "negative results are cached")
+
+	clock.Advance(11 * time.Second)
+	_, err := cache.ResolveSelector(context.Background(), 2)
+	require.ErrorIs(t, err, ErrChainNotFound)
+	assert.Equal(t, 3, remote.callCount(), "negative results expire")
+
+	cache.Invalidate(1)
+	_, err = cache.ResolveSelector(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, 4, remote.callCount())
+}
+
+func TestCachingResolverStaleWhileRevalidate(t *testing.T) {
+	remote := &fakeRemote{chains: map[uint64]ChainDetails{1: {ChainSelector: 1, ChainName: "remote-1"}}}
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	cache := newTestCachingResolver(remote, clock)
+
+	_, err := cache.ResolveSelector(context.Background(), 1)
+	require.NoError(t, err)
+
+	remote.set(1, ChainDetails{ChainSelector: 1, ChainName: "remote-1-renamed"}, nil)
+	clock.Advance(2 * time.Minute)
+
+	details, err := cache.ResolveSelector(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, "remote-1", details.ChainName, "expired entries are served while revalidating")
+
+	require.Eventually(t, func() bool {
+		details, err := cache.ResolveSelector(context.Background(), 1)
+		return err == nil && details.ChainName == "remote-1-renamed"
+	}, time.Second, time.Millisecond)
+	assert.Equal(t, 2, remote.callCount())
+}
+
+func TestCachingResolverServesStaleOnOutage(t *testing.T) {
+	remote := &fakeRemote{chains: map[uint64]ChainDetails{1: {ChainSelector: 1, ChainName: "remote-1"}}}
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	cache := newTestCachingResolver(remote, clock)
+
+	_, err := cache.ResolveSelector(context.Background(), 1)
+	require.NoError(t, err)
+
+	outage := errors.New("connection refused")
+	remote.set(1, ChainDetails{}, outage)
+	clock.Advance(24 * time.Hour)
+
+	details, err := cache.ResolveSelector(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, "remote-1", details.ChainName)
+
+	_, err = cache.ResolveSelector(context.Background(), 2)
+	require.ErrorIs(t, err, outage, "lookups never resolved before still fail")
+}
+
+func TestRegistryResolveSelectorFallsBackToRemote(t *testing.T) {
+	remote := &fakeRemote{chains: map[uint64]ChainDetails{42: {ChainSelector: 42, ChainName: "remote-chain"}}}
+	registry := NewRegistry(WithRemoteResolver(remote))
+
+	details, provenance, err := registry.ResolveSelector(context.Background(), ETHEREUM_MAINNET.Selector)
+	require.NoError(t, err)
+	assert.Equal(t, ProvenanceEmbedded, provenance.Source)
+	assert.Equal(t, ETHEREUM_MAINNET.Name, details.ChainName)
+	assert.Equal(t, 0, remote.callCount())
+
+	details, provenance, err = registry.ResolveSelector(context.Background(), 42)
+	require.NoError(t, err)
+	assert.Equal(t, ProvenanceRemote, provenance.Source)
+	assert.Equal(t, "remote-chain", details.ChainName)
+
+	_, _, err = registry.ResolveSelector(context.Background(), 43)
+	require.ErrorIs(t, err, ErrChainNotFound)
+
+	_, _, err = NewRegistry().ResolveSelector(context.Background(), 42)
+	require.Error(t, err)
+}
@@ -0,0 +1,95 @@
+package chain_selectors
+
+import (
+	_ "embed"
+	"fmt"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+//go:embed reservations.yml
+var reservationsYml []byte
+
+// Reservation holds a selector for a chain that has not been added yet.
+type Reservation struct {
+	Selector uint64
+	Owner    string
+	// Name is the name the chain will be added with.
+	Name string
+	// Expiry is the last day the reservation is honored.
+	Expiry time.Time
+}
+
+// ActiveAt reports whether the reservation is still honored at the given time.
+func (r Reservation) ActiveAt(t time.Time) bool {
+	return t.Before(r.Expiry.AddDate(0, 0, 1))
+}
+
+var reservationsBySelector = onceValue(func() map[uint64]Reservation { return parseReservationsYml(reservationsYml) })
+
+func parseReservationsYml(ymlFile []byte) map[uint64]Reservation {
+	type ymlReservation struct {
+		Owner  string `yaml:"owner"`
+		Name   string `yaml:"name"`
+		Expiry string `yaml:"expiry"`
+	}
+	type ymlData struct {
+		Reservations map[uint64]ymlReservation `yaml:"reservations"`
+	}
+
+	// duplicated selectors are rejected by the decoder
+	var data ymlData
+	err := yaml.Unmarshal(ymlFile, &data)
+	if err != nil {
+		panic(err)
+	}
+
+	output := make(map[uint64]Reservation, len(data.Reservations))
+	for selector, entry := range data.Reservations {
+		if entry.Owner == "" || entry.Name == "" {
+			panic(fmt.Errorf("reservation of selector %d must have an owner and a name", selector))
+		}
+		if err := ValidateOfficialSelector(selector); err != nil {
+			panic(fmt.Errorf("invalid reservation: %w", err))
+		}
+		expiry, err := time.Parse(lifecycleDateLayout, entry.Expiry)
+		if err != nil {
+			panic(fmt.Errorf("invalid expiry for reserved selector %d: %w", selector, err))
+		}
+		output[selector] = Reservation{
+			Selector: selector,
+			Owner:    entry.Owner,
+			Name:     entry.Name,
+			Expiry:   expiry,
+		}
+	}
+	return output
+}
+
+// GetReservation returns the reservation of the selector, whether or not it expired.
+func GetReservation(selector uint64) (Reservation, bool) {
+	reservation, exist := reservationsBySelector()[selector]
+	return reservation, exist
+}
+
+// IsReserved reports whether the selector is held by a reservation that has not expired.
+func IsReserved(selector uint64) bool {
+	reservation, exist := reservationsBySelector()[selector]
+	return exist && reservation.ActiveAt(time.Now())
+}
+
+// ValidateReservation checks that a chain may be generated with the selector: either the
+// selector is not reserved, its reservation expired, or the chain is the one it was reserved
+// for. The generators call it for every chain.
+func ValidateReservation(selector uint64, name string) error {
+	return validateReservation(reservationsBySelector(), selector, name, time.Now())
+}
+
+func validateReservation(reservations map[uint64]Reservation, selector uint64, name string, now time.Time) error {
+	reservation, exist := reservations[selector]
+	if !exist || !reservation.ActiveAt(now) || reservation.Name == name {
+		return nil
+	}
+	return fmt.Errorf("selector %d is reserved by %s for %s until %s", selector, reservation.Owner, reservation.Name, reservation.Expiry.Format(lifecycleDateLayout))
+}
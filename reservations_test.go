@@ -0,0 +1,89 @@
+package chain_selectors
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseReservationsYml(t *testing.T) {
+	reservations := parseReservationsYml([]byte(`
+reservations:
+  3379446385462418246:
+    owner: ccip-integrations
+    name: example-mainnet
+    expiry: 2026-12-31
+`))
+	require.Len(t, reservations, 1)
+
+	reservation := reservations[3379446385462418246]
+	assert.Equal(t, "ccip-integrations", reservation.Owner)
+	assert.True(t, reservation.ActiveAt(time.Date(2026, 12, 31, 23, 59, 0, 0, time.UTC)))
+	assert.False(t, reservation.ActiveAt(time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)))
+
+	before := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	assert.NoError(t, validateReservation(reservations, 3379446385462418246, "example-mainnet", before))
+	assert.Error(t, validateReservation(reservations, 3379446385462418246, "other-mainnet", before))
+	assert.NoError(t, validateReservation(reservations, 3379446385462418246, "other-mainnet", time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)))
+	assert.NoError(t, validateReservation(reservations, 42, "other-mainnet", before))
+}
+
+func TestParseReservationsYmlInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		yml  string
+	}{
+		{
+			name: "duplicate selector",
+			yml:  "reservations:\n  42:\n    owner: a\n    name: a-mainnet\n    expiry: 2026-12-31\n  42:\n    owner: b\n    name: b-mainnet\n    expiry: 2026-12-31\n",
+		},
+		{
+			name: "missing owner",
+			yml:  "reservations:\n  42:\n    name: a-mainnet\n    expiry: 2026-12-31\n",
+		},
+		{
+			name: "invalid expiry",
+			yml:  "reservations:\n  42:\n    owner: a\n    name: a-mainnet\n    expiry: soon\n",
+		},
+		{
+			name: "custom range",
+			yml:  "reservations:\n  16140901064495857664:\n    owner: a\n    name: a-mainnet\n    expiry: 2026-12-31\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Panics(t, func() { parseReservationsYml([]byte(test.yml)) })
+		})
+	}
+}
+
+func TestReservationsDoNotConflictWithKnownChains(t *testing.T) {
+	for selector, reservation := range reservationsBySelector() {
+		details, err := GetChainDetailsBySelector(selector)
+		if err != nil {
+			continue
+		}
+		assert.Equal(t, reservation.Name, details.ChainName, "reserved selector %d is used by another chain", selector)
+	}
+	assert.False(t, IsReserved(ETHEREUM_MAINNET.Selector))
+}
+
+func TestProposeSelectorSkipsReservations(t *testing.T) {
+	proposed := ProposeSelector(987654321, "")
+
+	reservations := reservationsBySelector
+	reservationsBySelector = func() map[uint64]Reservation {
+		return map[uint64]Reservation{
+			proposed: {Selector: proposed, Owner: "ccip-integrations", Name: "example-mainnet", Expiry: time.Now().AddDate(1, 0, 0)},
+		}
+	}
+	t.Cleanup(func() { reservationsBySelector = reservations })
+
+	selector := ProposeSelector(987654321, "")
+	assert.NotEqual(t, proposed, selector)
+	assert.False(t, IsReserved(selector))
+	assert.True(t, IsReserved(proposed))
+}
@@ -0,0 +1,94 @@
+package chain_selectors
+
+import (
+	_ "embed"
+	"fmt"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+)
+
+//go:embed connectivity.yml
+var connectivityYml []byte
+
+var neighborsBySelector = onceValue(func() map[uint64][]uint64 { return parseConnectivityYml(connectivityYml) })
+
+func parseConnectivityYml(ymlFile []byte) map[uint64][]uint64 {
+	type ymlData struct {
+		Connections [][2]uint64 `yaml:"connections"`
+	}
+
+	var data ymlData
+	err := yaml.Unmarshal(ymlFile, &data)
+	if err != nil {
+		panic(err)
+	}
+
+	neighbors := make(map[uint64][]uint64)
+	for _, connection := range data.Connections {
+		a, b := connection[0], connection[1]
+		if a == b {
+			panic(fmt.Errorf("chain %d is connected to itself", a))
+		}
+		neighbors[a] = append(neighbors[a], b)
+		neighbors[b] = append(neighbors[b], a)
+	}
+	for selector, adjacent := range neighbors {
+		sort.Slice(adjacent, func(i, j int) bool { return adjacent[i] < adjacent[j] })
+		for i := 1; i < len(adjacent); i++ {
+			if adjacent[i] == adjacent[i-1] {
+				panic(fmt.Errorf("chains %d and %d are connected twice", selector, adjacent[i]))
+			}
+		}
+	}
+	return neighbors
+}
+
+// Connected returns the sorted selectors of the chains directly bridgeable with the chain
+// identified by the selector, see connectivity.yml.
+func Connected(selector uint64) []uint64 {
+	return append([]uint64(nil), neighborsBySelector()[selector]...)
+}
+
+// Routes returns the paths from source to dest over the chains connected in connectivity.yml
+// taking at most maxHops hops, each path listing the selectors from source to dest. A chain is
+// visited at most once per path. The shortest paths come first, paths of the same length are
+// ordered by their selectors.
+func Routes(source, dest uint64, maxHops int) ([][]uint64, error) {
+	if _, err := NewLane(source, dest); err != nil {
+		return nil, err
+	}
+	if maxHops < 1 {
+		return nil, fmt.Errorf("max hops must be at least 1, got %d", maxHops)
+	}
+
+	var routes [][]uint64
+	path := []uint64{source}
+	visited := map[uint64]bool{source: true}
+	var walk func(from uint64)
+	walk = func(from uint64) {
+		for _, next := range neighborsBySelector()[from] {
+			if visited[next] {
+				continue
+			}
+			if next == dest {
+				routes = append(routes, append(append([]uint64(nil), path...), dest))
+				continue
+			}
+			if len(path) == maxHops {
+				continue
+			}
+			visited[next] = true
+			path = append(path, next)
+			walk(next)
+			path = path[:len(path)-1]
+			visited[next] = false
+		}
+	}
+	walk(source)
+
+	sort.SliceStable(routes, func(i, j int) bool {
+		return len(routes[i]) < len(routes[j])
+	})
+	return routes, nil
+}
@@ -0,0 +1,59 @@
+package chain_selectors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConnectivitySelectorsAreKnownChains(t *testing.T) {
+	for selector := range neighborsBySelector() {
+		_, err := getChainInfo(selector)
+		assert.NoError(t, err, "connectivity.yml references unknown selector %d", selector)
+	}
+}
+
+func TestRoutes(t *testing.T) {
+	var (
+		ethereum  = ETHEREUM_MAINNET.Selector
+		optimism  = ETHEREUM_MAINNET_OPTIMISM_1.Selector
+		arbitrum  = ETHEREUM_MAINNET_ARBITRUM_1.Selector
+		base      = ETHEREUM_MAINNET_BASE_1.Selector
+		avalanche = AVALANCHE_MAINNET.Selector
+	)
+
+	assert.Equal(t, []uint64{ethereum, base}, Connected(optimism))
+
+	routes, err := Routes(arbitrum, optimism, 1)
+	require.NoError(t, err)
+	assert.Empty(t, routes)
+
+	routes, err = Routes(arbitrum, optimism, 2)
+	require.NoError(t, err)
+	assert.Equal(t, [][]uint64{{arbitrum, ethereum, optimism}, {arbitrum, base, optimism}}, routes)
+
+	routes, err = Routes(arbitrum, optimism, 3)
+	require.NoError(t, err)
+	assert.Equal(t, [][]uint64{
+		{arbitrum, ethereum, optimism},
+		{arbitrum, base, optimism},
+		{arbitrum, ethereum, base, optimism},
+		{arbitrum, base, ethereum, optimism},
+	}, routes)
+
+	routes, err = Routes(ethereum, avalanche, 1)
+	require.NoError(t, err)
+	assert.Equal(t, [][]uint64{{ethereum, avalanche}}, routes)
+
+	routes, err = Routes(ethereum, ETHEREUM_TESTNET_SEPOLIA.Selector, 5)
+	require.NoError(t, err)
+	assert.Empty(t, routes, "mainnets and testnets are not connected")
+
+	_, err = Routes(ethereum, ethereum, 2)
+	require.Error(t, err)
+	_, err = Routes(ethereum, 1, 2)
+	require.Error(t, err)
+	_, err = Routes(ethereum, optimism, 0)
+	require.Error(t, err)
+}
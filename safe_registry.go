@@ -0,0 +1,126 @@
+package chain_selectors
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+)
+
+// PanicError is returned by a SafeRegistry in place of a panic it recovered.
+type PanicError struct {
+	Value interface{}
+	Stack []byte
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("recovered panic in chain selectors: %v", e.Value)
+}
+
+// SafeRegistry exposes the lookups of a Registry and guarantees they do not panic: a panic
+// raised by malformed data, a violated invariant, a remote resolver or an OnChange listener is
+// recovered and returned as a *PanicError. Lookups reporting a chain with a boolean also
+// return an error for that purpose. Panics raised while the package initialises, such as an
+// invalid embedded dataset, happen before any lookup and cannot be recovered.
+type SafeRegistry struct {
+	registry *Registry
+}
+
+// NewSafeRegistry wraps the registry, a nil registry wraps NewRegistry().
+func NewSafeRegistry(registry *Registry) *SafeRegistry {
+	if registry == nil {
+		registry = NewRegistry()
+	}
+	return &SafeRegistry{registry: registry}
+}
+
+// Registry returns the wrapped registry, its methods are not protected against panics.
+func (s *SafeRegistry) Registry() *Registry {
+	return s.registry
+}
+
+// recoverPanic turns a panic of the calling method into a *PanicError assigned to err, it must
+// be deferred directly.
+func recoverPanic(err *error) {
+	if v := recover(); v != nil {
+		*err = &PanicError{Value: v, Stack: debug.Stack()}
+	}
+}
+
+func (s *SafeRegistry) LoadYAML(ymlFile []byte) (err error) {
+	defer recoverPanic(&err)
+	return s.registry.LoadYAML(ymlFile)
+}
+
+func (s *SafeRegistry) GetSelectorFamily(selector uint64) (family string, err error) {
+	defer recoverPanic(&err)
+	return s.registry.GetSelectorFamily(selector)
+}
+
+func (s *SafeRegistry) GetChainIDFromSelector(selector uint64) (chainID string, err error) {
+	defer recoverPanic(&err)
+	return s.registry.GetChainIDFromSelector(selector)
+}
+
+func (s *SafeRegistry) GetChainDetailsBySelector(selector uint64) (details ChainDetails, err error) {
+	defer recoverPanic(&err)
+	return s.registry.GetChainDetailsBySelector(selector)
+}
+
+func (s *SafeRegistry) GetChainDetailsByChainIDAndFamily(chainID string, family string) (details ChainDetails, err error) {
+	defer recoverPanic(&err)
+	return s.registry.GetChainDetailsByChainIDAndFamily(chainID, family)
+}
+
+func (s *SafeRegistry) GetChainDetailsWithProvenance(selector uint64) (details ChainDetails, provenance Provenance, err error) {
+	defer recoverPanic(&err)
+	return s.registry.GetChainDetailsWithProvenance(selector)
+}
+
+func (s *SafeRegistry) SelectorFromChainID(chainID uint64) (selector uint64, err error) {
+	defer recoverPanic(&err)
+	return s.registry.SelectorFromChainID(chainID)
+}
+
+func (s *SafeRegistry) SelectorFromChainIDWithProvenance(chainID uint64) (selector uint64, provenance Provenance, err error) {
+	defer recoverPanic(&err)
+	return s.registry.SelectorFromChainIDWithProvenance(chainID)
+}
+
+func (s *SafeRegistry) ChainBySelector(selector uint64) (ch Chain, exists bool, err error) {
+	defer recoverPanic(&err)
+	ch, exists = s.registry.ChainBySelector(selector)
+	return ch, exists, nil
+}
+
+func (s *SafeRegistry) ChainByEvmChainID(evmChainID uint64) (ch Chain, exists bool, err error) {
+	defer recoverPanic(&err)
+	ch, exists = s.registry.ChainByEvmChainID(evmChainID)
+	return ch, exists, nil
+}
+
+func (s *SafeRegistry) ChainByName(name string) (ch Chain, exists bool, err error) {
+	defer recoverPanic(&err)
+	ch, exists = s.registry.ChainByName(name)
+	return ch, exists, nil
+}
+
+func (s *SafeRegistry) ChainByNameMatching(name string, policy MatchPolicy) (ch Chain, exists bool, err error) {
+	defer recoverPanic(&err)
+	ch, exists = s.registry.ChainByNameMatching(name, policy)
+	return ch, exists, nil
+}
+
+func (s *SafeRegistry) ChainNames() (names []string, err error) {
+	defer recoverPanic(&err)
+	return s.registry.ChainNames(), nil
+}
+
+func (s *SafeRegistry) ResolveSelector(ctx context.Context, selector uint64) (details ChainDetails, provenance Provenance, err error) {
+	defer recoverPanic(&err)
+	return s.registry.ResolveSelector(ctx, selector)
+}
+
+func (s *SafeRegistry) Fingerprint() (fingerprint string, err error) {
+	defer recoverPanic(&err)
+	return s.registry.Fingerprint(), nil
+}
@@ -0,0 +1,48 @@
+package chain_selectors
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSafeRegistryRecoversPanics(t *testing.T) {
+	panicking := RemoteResolverFunc(func(context.Context, uint64) (ChainDetails, error) {
+		panic("resolver invariant violated")
+	})
+	registry := NewSafeRegistry(NewRegistry(WithRemoteResolver(panicking)))
+
+	_, _, err := registry.ResolveSelector(context.Background(), 42)
+	var panicErr *PanicError
+	require.ErrorAs(t, err, &panicErr)
+	assert.Equal(t, "resolver invariant violated", panicErr.Value)
+	assert.NotEmpty(t, panicErr.Stack)
+
+	registry.Registry().OnChange(func(ChangeSet) { panic("listener failed") })
+	err = registry.LoadYAML([]byte("selectors:\n  77001:\n    selector: 11\n    name: devnet-a\n"))
+	require.ErrorAs(t, err, &panicErr)
+	assert.Equal(t, "listener failed", panicErr.Value)
+
+	// The registry keeps serving lookups after a recovered panic.
+	ch, exists, err := registry.ChainByName("devnet-a")
+	require.NoError(t, err)
+	require.True(t, exists)
+	assert.Equal(t, uint64(11), ch.Selector)
+
+	details, err := registry.GetChainDetailsBySelector(ETHEREUM_MAINNET.Selector)
+	require.NoError(t, err)
+	assert.Equal(t, ETHEREUM_MAINNET.Name, details.ChainName)
+}
+
+func TestSafeRegistryReturnsLookupErrors(t *testing.T) {
+	registry := NewSafeRegistry(nil)
+
+	_, err := registry.GetChainDetailsBySelector(1)
+	assert.ErrorIs(t, err, ErrChainNotFound)
+
+	_, exists, err := registry.ChainBySelector(1)
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
@@ -16,6 +16,50 @@ const (
 	FamilyTon      = "ton"
 )
 
+var allFamilies = []string{FamilyEVM, FamilySolana, FamilyAptos, FamilySui, FamilyTron, FamilyTon}
+
+var (
+	chainDetailsBySelector = onceValue(loadChainDetailsBySelector)
+	testChainsBySelector   = onceValue(loadTestChainsBySelector)
+)
+
+func loadTestChainsBySelector() map[uint64]ChainDetails {
+	output := make(map[uint64]ChainDetails, len(evmTestSelectorsMap())+len(solanaTestSelectorsMap()))
+	for _, v := range evmTestSelectorsMap() {
+		output[v.ChainSelector] = v
+	}
+	for _, v := range solanaTestSelectorsMap() {
+		output[v.ChainSelector] = v
+	}
+	return output
+}
+
+func loadChainDetailsBySelector() map[uint64]ChainDetails {
+	output := make(map[uint64]ChainDetails)
+	for _, v := range evmChainIdToChainSelector() {
+		output[v.ChainSelector] = v
+	}
+	for selector, v := range evmChains().forkDetails {
+		output[selector] = v
+	}
+	for _, v := range solanaChainIdToChainSelector() {
+		output[v.ChainSelector] = v
+	}
+	for _, v := range aptosSelectorsMap() {
+		output[v.ChainSelector] = v
+	}
+	for _, v := range suiSelectorsMap() {
+		output[v.ChainSelector] = v
+	}
+	for _, v := range tronSelectorsMap() {
+		output[v.ChainSelector] = v
+	}
+	for _, v := range tonSelectorsMap() {
+		output[v.ChainSelector] = v
+	}
+	return output
+}
+
 type chainInfo struct {
 	Family       string
 	ChainID      string
@@ -23,19 +67,31 @@ type chainInfo struct {
 }
 
 func getChainInfo(selector uint64) (chainInfo, error) {
+	if info, exist := indexedChainInfo(selector); exist {
+		return info, nil
+	}
+	return resolveChainInfo(selector)
+}
+
+// resolveChainInfo looks the selector up in the family datasets one after another, it backs
+// the lookup index and resolves the selectors the index does not hold, such as custom ones.
+func resolveChainInfo(selector uint64) (chainInfo, error) {
 	// check EVM
-	_, exist := evmChainsBySelector[selector]
+	_, exist := evmChainsBySelector()[selector]
 	if exist {
 		family := FamilyEVM
 
-		evmChainId, err := ChainIdFromSelector(selector)
+		evmChainId, err := chainIdFromSelector(selector)
 		if err != nil {
 			return chainInfo{}, fmt.Errorf("failed to get %v chain ID from selector %d: %w", family, selector, err)
 		}
 
-		details, exist := evmChainIdToChainSelector[evmChainId]
+		details, exist := evmChains().forkDetails[selector]
+		if !exist {
+			details, exist = evmChainIdToChainSelector()[evmChainId]
+		}
 		if !exist {
-			return chainInfo{}, fmt.Errorf("invalid chain id %d for %s", evmChainId, family)
+			return chainInfo{}, chainIDNotFoundError(family, uint64(evmChainId))
 		}
 
 		return chainInfo{
@@ -46,7 +102,7 @@ func getChainInfo(selector uint64) (chainInfo, error) {
 	}
 
 	// check solana
-	_, exist = solanaChainsBySelector[selector]
+	_, exist = solanaChainsBySelector()[selector]
 	if exist {
 		family := FamilySolana
 
@@ -55,9 +111,9 @@ func getChainInfo(selector uint64) (chainInfo, error) {
 			return chainInfo{}, fmt.Errorf("failed to get %s chain ID from selector %d: %w", chainID, selector, err)
 		}
 
-		details, exist := solanaChainIdToChainSelector[chainID]
+		details, exist := solanaChainIdToChainSelector()[chainID]
 		if !exist {
-			return chainInfo{}, fmt.Errorf("invalid chain id %s for %s", chainID, family)
+			return chainInfo{}, notFoundError(InputChainID, family, chainID)
 		}
 
 		return chainInfo{
@@ -69,7 +125,7 @@ func getChainInfo(selector uint64) (chainInfo, error) {
 	}
 
 	// check aptos
-	_, exist = aptosChainsBySelector[selector]
+	_, exist = aptosChainsBySelector()[selector]
 	if exist {
 		family := FamilyAptos
 
@@ -78,9 +134,9 @@ func getChainInfo(selector uint64) (chainInfo, error) {
 			return chainInfo{}, fmt.Errorf("failed to get %v chain ID from selector %d: %w", chainID, selector, err)
 		}
 
-		details, exist := aptosSelectorsMap[chainID]
+		details, exist := aptosSelectorsMap()[chainID]
 		if !exist {
-			return chainInfo{}, fmt.Errorf("invalid chain id %d for %s", chainID, family)
+			return chainInfo{}, chainIDNotFoundError(family, uint64(chainID))
 		}
 
 		return chainInfo{
@@ -91,7 +147,7 @@ func getChainInfo(selector uint64) (chainInfo, error) {
 	}
 
 	// check sui
-	_, exist = suiChainsBySelector[selector]
+	_, exist = suiChainsBySelector()[selector]
 	if exist {
 		family := FamilySui
 
@@ -100,9 +156,9 @@ func getChainInfo(selector uint64) (chainInfo, error) {
 			return chainInfo{}, fmt.Errorf("failed to get %v chain ID from selector %d: %w", chainID, selector, err)
 		}
 
-		details, exist := suiSelectorsMap[chainID]
+		details, exist := suiSelectorsMap()[chainID]
 		if !exist {
-			return chainInfo{}, fmt.Errorf("invalid chain id %d for %s", chainID, family)
+			return chainInfo{}, chainIDNotFoundError(family, uint64(chainID))
 		}
 
 		return chainInfo{
@@ -113,7 +169,7 @@ func getChainInfo(selector uint64) (chainInfo, error) {
 	}
 
 	// check tron
-	_, exist = tronChainIdBySelector[selector]
+	_, exist = tronChainIdBySelector()[selector]
 	if exist {
 		family := FamilyTron
 
@@ -122,9 +178,9 @@ func getChainInfo(selector uint64) (chainInfo, error) {
 			return chainInfo{}, fmt.Errorf("failed to get %v chain ID from selector %d: %w", chainID, selector, err)
 		}
 
-		details, exist := tronSelectorsMap[chainID]
+		details, exist := tronSelectorsMap()[chainID]
 		if !exist {
-			return chainInfo{}, fmt.Errorf("invalid chain id %d for %s", chainID, family)
+			return chainInfo{}, chainIDNotFoundError(family, uint64(chainID))
 		}
 
 		return chainInfo{
@@ -135,7 +191,7 @@ func getChainInfo(selector uint64) (chainInfo, error) {
 	}
 
 	// check ton
-	_, exist = tonChainIdBySelector[selector]
+	_, exist = tonChainIdBySelector()[selector]
 	if exist {
 		family := FamilyTon
 
@@ -144,9 +200,9 @@ func getChainInfo(selector uint64) (chainInfo, error) {
 			return chainInfo{}, fmt.Errorf("failed to get %v chain ID from selector %d: %w", chainID, selector, err)
 		}
 
-		details, exist := tonSelectorsMap[chainID]
+		details, exist := tonSelectorsMap()[chainID]
 		if !exist {
-			return chainInfo{}, fmt.Errorf("invalid chain id %d for %s", chainID, family)
+			return chainInfo{}, notFoundError(InputChainID, family, strconv.FormatInt(int64(chainID), 10))
 		}
 
 		return chainInfo{
@@ -160,18 +216,19 @@ func getChainInfo(selector uint64) (chainInfo, error) {
 	if isCustomSelector(selector) {
 		chainID, err := extractChainIdFromCustomSelector(selector)
 		if err == nil {
+			emitCustomSelector(CustomSelectorResolved, chainID, selector)
 			return chainInfo{
 				Family:  FamilyEVM,
-				ChainID: fmt.Sprintf("%d", chainID),
+				ChainID: strconv.FormatUint(chainID, 10),
 				ChainDetails: ChainDetails{
 					ChainSelector: selector,
-					ChainName:     generateCustomChainName(chainID),
+					ChainName:     customChainName(chainID),
 				},
 			}, nil
 		}
 	}
 
-	return chainInfo{}, fmt.Errorf("unknown chain selector %d", selector)
+	return chainInfo{}, &LookupError{Input: strconv.FormatUint(selector, 10), InputKind: InputSelector, Reason: reasonNotFound}
 }
 
 func GetSelectorFamily(selector uint64) (string, error) {
@@ -186,7 +243,7 @@ func GetSelectorFamily(selector uint64) (string, error) {
 		return FamilyEVM, nil
 	}
 
-	return "", fmt.Errorf("unknown chain selector %d", selector)
+	return "", selectorNotFoundError("", selector)
 }
 
 func GetChainIDFromSelector(selector uint64) (string, error) {
@@ -203,7 +260,64 @@ func GetChainIDFromSelector(selector uint64) (string, error) {
 		}
 	}
 
-	return "", fmt.Errorf("unknown chain selector %d", selector)
+	return "", selectorNotFoundError("", selector)
+}
+
+// GetChainDetailsBySelector returns the details of any official, test or custom chain in a single lookup.
+func GetChainDetailsBySelector(selector uint64) (ChainDetails, error) {
+	if info, exist := indexedChainInfo(selector); exist {
+		return info.ChainDetails, nil
+	}
+
+	if isCustomSelector(selector) {
+		chainID, err := extractChainIdFromCustomSelector(selector)
+		if err == nil {
+			emitCustomSelector(CustomSelectorResolved, chainID, selector)
+			return ChainDetails{
+				ChainSelector: selector,
+				ChainName:     customChainName(chainID),
+			}, nil
+		}
+	}
+
+	return ChainDetails{}, selectorNotFoundError("", selector)
+}
+
+// GetChainDetailsBySelectors looks up the details of every selector, in order. The details of
+// the selectors that cannot be resolved are left empty and the returned *MultiError indexes
+// their failures, so callers can use the resolved ones.
+func GetChainDetailsBySelectors(selectors []uint64) ([]ChainDetails, error) {
+	details := make([]ChainDetails, len(selectors))
+	var errs MultiError
+	for i, selector := range selectors {
+		d, err := GetChainDetailsBySelector(selector)
+		if err != nil {
+			errs.add(i, "", err)
+			continue
+		}
+		details[i] = d
+	}
+	return details, errs.errOrNil()
+}
+
+// IsTestChain reports whether the selector belongs to a chain defined in the test selector files.
+// Custom chain selectors are treated as test chains, the official selectors assigned in the
+// custom range before it was reserved are not.
+func IsTestChain(selector uint64) bool {
+	if _, exist := testChainsBySelector()[selector]; exist {
+		return true
+	}
+	_, grandfathered := grandfatheredCustomRangeSelectors[selector]
+	return isCustomSelector(selector) && !grandfathered
+}
+
+// TestChainsBySelector returns the details of every chain defined in the test selector files, keyed by selector.
+func TestChainsBySelector() map[uint64]ChainDetails {
+	copyMap := make(map[uint64]ChainDetails, len(testChainsBySelector()))
+	for k, v := range testChainsBySelector() {
+		copyMap[k] = v
+	}
+	return copyMap
 }
 
 func GetChainDetailsByChainIDAndFamily(chainID string, family string) (ChainDetails, error) {
@@ -211,67 +325,74 @@ func GetChainDetailsByChainIDAndFamily(chainID string, family string) (ChainDeta
 	case FamilyEVM:
 		evmChainId, err := strconv.ParseUint(chainID, 10, 64)
 		if err != nil {
-			return ChainDetails{}, fmt.Errorf("invalid chain id %s for %s sk", chainID, family)
+			return ChainDetails{}, lookupError(InputChainID, family, chainID, reasonMalformed)
 		}
 
-		details, exist := evmChainIdToChainSelector[evmChainId]
+		details, exist := evmChainIdToChainSelector()[evmChainId]
 		if !exist {
 			if isCustomChain(evmChainId) {
-				selector := generateCustomChainSelector(evmChainId)
-				name := generateCustomChainName(evmChainId)
+				selector, err := customChainSelector(evmChainId)
+				if err != nil {
+					return ChainDetails{}, err
+				}
+				if err := checkCustomGeneration(evmChainId); err != nil {
+					return ChainDetails{}, err
+				}
+				name := customChainName(evmChainId)
 
 				fmt.Printf("🔧 Generated custom chain selector: %s (ID: %d, Selector: %d)\n",
 					name, evmChainId, selector)
+				emitCustomSelector(CustomSelectorGenerated, evmChainId, selector)
 
 				return ChainDetails{
 					ChainSelector: selector,
 					ChainName:     name,
 				}, nil
 			}
-			return ChainDetails{}, fmt.Errorf("invalid chain id %s for %s sk", chainID, family)
+			return ChainDetails{}, notFoundError(InputChainID, family, chainID)
 		}
 
 		return details, nil
 	case FamilySolana:
-		details, exist := solanaChainIdToChainSelector[chainID]
+		details, exist := solanaChainIdToChainSelector()[chainID]
 		if !exist {
-			return ChainDetails{}, fmt.Errorf("invalid chain id %s for %s", chainID, family)
+			return ChainDetails{}, notFoundError(InputChainID, family, chainID)
 		}
 
 		return details, nil
 	case FamilyAptos:
 		aptosChainId, err := strconv.ParseUint(chainID, 10, 64)
 		if err != nil {
-			return ChainDetails{}, fmt.Errorf("invalid chain id %s for %s", chainID, family)
+			return ChainDetails{}, lookupError(InputChainID, family, chainID, reasonMalformed)
 		}
 
-		details, exist := aptosSelectorsMap[aptosChainId]
+		details, exist := aptosSelectorsMap()[aptosChainId]
 		if !exist {
-			return ChainDetails{}, fmt.Errorf("invalid chain id %s for %s", chainID, family)
+			return ChainDetails{}, notFoundError(InputChainID, family, chainID)
 		}
 
 		return details, nil
 	case FamilySui:
 		suiChainId, err := strconv.ParseUint(chainID, 10, 64)
 		if err != nil {
-			return ChainDetails{}, fmt.Errorf("invalid chain id %s for %s", chainID, family)
+			return ChainDetails{}, lookupError(InputChainID, family, chainID, reasonMalformed)
 		}
 
-		details, exist := suiSelectorsMap[suiChainId]
+		details, exist := suiSelectorsMap()[suiChainId]
 		if !exist {
-			return ChainDetails{}, fmt.Errorf("invalid chain id %s for %s", chainID, family)
+			return ChainDetails{}, notFoundError(InputChainID, family, chainID)
 		}
 
 		return details, nil
 	case FamilyTron:
 		tronChainId, err := strconv.ParseUint(chainID, 10, 64)
 		if err != nil {
-			return ChainDetails{}, fmt.Errorf("invalid chain id %s for %s", chainID, family)
+			return ChainDetails{}, lookupError(InputChainID, family, chainID, reasonMalformed)
 		}
 
-		details, exist := tronSelectorsMap[tronChainId]
+		details, exist := tronSelectorsMap()[tronChainId]
 		if !exist {
-			return ChainDetails{}, fmt.Errorf("invalid chain id %s for %s", chainID, family)
+			return ChainDetails{}, notFoundError(InputChainID, family, chainID)
 		}
 
 		return details, nil
@@ -279,15 +400,23 @@ func GetChainDetailsByChainIDAndFamily(chainID string, family string) (ChainDeta
 	case FamilyTon:
 		tonChainId, err := strconv.ParseInt(chainID, 10, 32)
 		if err != nil {
-			return ChainDetails{}, fmt.Errorf("invalid chain id %s for %s", chainID, family)
+			return ChainDetails{}, lookupError(InputChainID, family, chainID, reasonMalformed)
 		}
-		details, exist := tonSelectorsMap[int32(tonChainId)]
+		details, exist := tonSelectorsMap()[int32(tonChainId)]
 		if !exist {
-			return ChainDetails{}, fmt.Errorf("invalid chain id %s for %s", chainID, family)
+			return ChainDetails{}, notFoundError(InputChainID, family, chainID)
 		}
 
 		return details, nil
 	default:
-		return ChainDetails{}, fmt.Errorf("family %s is not yet support", family)
+		return ChainDetails{}, lookupError(InputChainID, family, chainID, reasonUnsupportedFamily)
 	}
 }
+
+// knownSelectors returns the sorted selectors of every chain defined for the given family.
+func knownSelectors(family string) []uint64 {
+	indexed := familyIndex(family).selectors
+	selectors := make([]uint64, len(indexed))
+	copy(selectors, indexed)
+	return selectors
+}
@@ -0,0 +1,71 @@
+package chain_selectors
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetChainDetailsBySelector(t *testing.T) {
+	t.Run("official and test chains of every family", func(t *testing.T) {
+		for _, family := range allFamilies {
+			for _, selector := range knownSelectors(family) {
+				info, err := getChainInfo(selector)
+				require.NoError(t, err)
+
+				details, err := GetChainDetailsBySelector(selector)
+				require.NoError(t, err)
+				assert.Equal(t, info.ChainDetails, details)
+			}
+		}
+	})
+
+	t.Run("custom chain", func(t *testing.T) {
+		selector := generateCustomChainSelector(9388201)
+		details, err := GetChainDetailsBySelector(selector)
+		require.NoError(t, err)
+		assert.Equal(t, ChainDetails{ChainSelector: selector, ChainName: "custom-testnet-9388201"}, details)
+	})
+
+	t.Run("unknown selector", func(t *testing.T) {
+		_, err := GetChainDetailsBySelector(rand.Uint64() &^ 0xF000000000000000)
+		require.Error(t, err)
+	})
+}
+
+func Test_IsTestChain(t *testing.T) {
+	tests := []struct {
+		name     string
+		selector uint64
+		test     bool
+	}{
+		{"evm test chain", TEST_90000001.Selector, true},
+		{"named evm test chain", GETH_DEVNET_2.Selector, true},
+		{"solana test chain", TEST_22222222222222222222222222222222222222222222.Selector, true},
+		{"custom chain", generateCustomChainSelector(9388201), true},
+		{"evm mainnet", ETHEREUM_MAINNET.Selector, false},
+		{"evm public testnet", ETHEREUM_TESTNET_SEPOLIA.Selector, false},
+		{"solana mainnet", SOLANA_MAINNET.Selector, false},
+		{"unknown selector", 120398123, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.test, IsTestChain(test.selector))
+		})
+	}
+}
+
+func Test_TestChainsBySelector(t *testing.T) {
+	chains := TestChainsBySelector()
+	assert.Len(t, chains, len(evmTestSelectorsMap())+len(solanaTestSelectorsMap()))
+	for _, chainID := range TestChainIds() {
+		details := evmTestSelectorsMap()[chainID]
+		assert.Equal(t, details, chains[details.ChainSelector])
+	}
+
+	delete(chains, TEST_90000001.Selector)
+	assert.True(t, IsTestChain(TEST_90000001.Selector))
+}
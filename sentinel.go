@@ -0,0 +1,39 @@
+package chain_selectors
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+const (
+	// SelectorNone stands for no chain, e.g. an unset destination. It is the zero value of a
+	// selector and never identifies a chain.
+	SelectorNone uint64 = 0
+	// SelectorWildcard stands for any chain, e.g. a rate limit applying to every destination.
+	// It never identifies a chain.
+	SelectorWildcard uint64 = math.MaxUint64
+)
+
+// ErrSentinelSelector is matched, with errors.Is, by the errors of ValidateChainSelector.
+var ErrSentinelSelector = errors.New("sentinel selector")
+
+// IsSentinelSelector reports whether the selector is SelectorNone or SelectorWildcard, rather
+// than the selector of a chain.
+func IsSentinelSelector(selector uint64) bool {
+	return selector == SelectorNone || selector == SelectorWildcard
+}
+
+// ValidateChainSelector checks that the selector may identify a chain, for protocols storing
+// SelectorNone or SelectorWildcard next to chain selectors to reject them where a chain is
+// required. It does not check that the chain is known.
+func ValidateChainSelector(selector uint64) error {
+	switch selector {
+	case SelectorNone:
+		return fmt.Errorf("%w: selector %d stands for no chain", ErrSentinelSelector, selector)
+	case SelectorWildcard:
+		return fmt.Errorf("%w: selector %d stands for any chain", ErrSentinelSelector, selector)
+	default:
+		return nil
+	}
+}
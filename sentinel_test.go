@@ -0,0 +1,33 @@
+package chain_selectors
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSentinelSelectors(t *testing.T) {
+	assert.True(t, IsSentinelSelector(SelectorNone))
+	assert.True(t, IsSentinelSelector(SelectorWildcard))
+	assert.False(t, IsSentinelSelector(ETHEREUM_MAINNET.Selector))
+
+	assert.ErrorIs(t, ValidateChainSelector(SelectorNone), ErrSentinelSelector)
+	assert.EqualError(t, ValidateChainSelector(SelectorWildcard), "sentinel selector: selector 18446744073709551615 stands for any chain")
+	assert.NoError(t, ValidateChainSelector(ETHEREUM_MAINNET.Selector))
+
+	// sentinels are never assigned to chains
+	assert.Error(t, ValidateOfficialSelector(SelectorWildcard))
+	_, exists := ChainBySelector(SelectorWildcard)
+	assert.False(t, exists)
+}
+
+func TestRegistryRejectsSentinelSelectors(t *testing.T) {
+	registry := NewRegistry()
+	err := registry.LoadYAML([]byte(fmt.Sprintf("selectors:\n  77001:\n    selector: %d\n    name: devnet-wildcard\n", SelectorWildcard)))
+	var multi *MultiError
+	require.True(t, errors.As(err, &multi))
+	assert.ErrorIs(t, multi.ForIndex(0), ErrSentinelSelector)
+}
@@ -0,0 +1,139 @@
+package server
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"net/http"
+	"strings"
+)
+
+// ErrUnauthenticated is returned by an Authenticator that cannot identify the caller.
+var ErrUnauthenticated = errors.New("unauthenticated")
+
+// Authenticator identifies the principal, e.g. a team or a service, a request is made by.
+type Authenticator interface {
+	Authenticate(r *http.Request) (principal string, err error)
+}
+
+// AuthenticatorFunc adapts a function to the Authenticator interface.
+type AuthenticatorFunc func(r *http.Request) (string, error)
+
+func (f AuthenticatorFunc) Authenticate(r *http.Request) (string, error) {
+	return f(r)
+}
+
+// APIKeys authenticates requests by the static API key they carry as "Authorization: Bearer
+// <key>" or in the X-API-Key header. keys maps every accepted key to its principal.
+func APIKeys(keys map[string]string) Authenticator {
+	return AuthenticatorFunc(func(r *http.Request) (string, error) {
+		key := r.Header.Get("X-API-Key")
+		if bearer, found := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer "); found {
+			key = bearer
+		}
+		if key == "" {
+			return "", ErrUnauthenticated
+		}
+		// every key is compared, so the time taken does not tell how close the key is
+		principal := ""
+		for candidate, p := range keys {
+			if subtle.ConstantTimeCompare([]byte(candidate), []byte(key)) == 1 {
+				principal = p
+			}
+		}
+		if principal == "" {
+			return "", ErrUnauthenticated
+		}
+		return principal, nil
+	})
+}
+
+// ClientCertificates authenticates requests by the TLS client certificate they were made with,
+// the principal being the common name of its subject. The certificate must have been verified
+// by the server, configure its tls.Config with ClientAuth tls.RequireAndVerifyClientCert and
+// the ClientCAs trusted to issue them.
+func ClientCertificates() Authenticator {
+	return AuthenticatorFunc(func(r *http.Request) (string, error) {
+		if r.TLS == nil || len(r.TLS.VerifiedChains) == 0 || len(r.TLS.VerifiedChains[0]) == 0 {
+			return "", ErrUnauthenticated
+		}
+		principal := r.TLS.VerifiedChains[0][0].Subject.CommonName
+		if principal == "" {
+			return "", ErrUnauthenticated
+		}
+		return principal, nil
+	})
+}
+
+// AnyOf authenticates requests with the first of the authenticators that identifies the
+// caller, e.g. to accept both client certificates and API keys.
+func AnyOf(authenticators ...Authenticator) Authenticator {
+	return AuthenticatorFunc(func(r *http.Request) (string, error) {
+		for _, authenticator := range authenticators {
+			if principal, err := authenticator.Authenticate(r); err == nil {
+				return principal, nil
+			}
+		}
+		return "", ErrUnauthenticated
+	})
+}
+
+// WithAuthenticator rejects the requests the authenticator does not identify the caller of
+// with 401 Unauthorized. Handlers can get the principal with PrincipalFromContext.
+func WithAuthenticator(authenticator Authenticator) Option {
+	return func(s *Server) {
+		s.authenticator = authenticator
+	}
+}
+
+// WithPrivateChain restricts the chain identified by the selector to the principals, its
+// allowlist: other callers are served as if the registry did not resolve it. This lets a
+// shared server expose the devnets of a team to that team only. It requires an Authenticator,
+// without one no caller is allowed. Calls for the same chain extend its allowlist. The chain is
+// still counted by the statistics served at StatsPath.
+func WithPrivateChain(selector uint64, principals ...string) Option {
+	return func(s *Server) {
+		if s.allowlists == nil {
+			s.allowlists = make(map[uint64]map[string]bool)
+		}
+		if s.allowlists[selector] == nil {
+			s.allowlists[selector] = make(map[string]bool)
+		}
+		for _, principal := range principals {
+			s.allowlists[selector][principal] = true
+		}
+	}
+}
+
+type principalKey struct{}
+
+// PrincipalFromContext returns the principal authenticated for the request of the context.
+func PrincipalFromContext(ctx context.Context) (string, bool) {
+	principal, ok := ctx.Value(principalKey{}).(string)
+	return principal, ok
+}
+
+// authenticate returns the request with its principal, it answers 401 Unauthorized and
+// returns nil if the request is rejected.
+func (s *Server) authenticate(w http.ResponseWriter, r *http.Request) *http.Request {
+	if s.authenticator == nil {
+		return r
+	}
+	principal, err := s.authenticator.Authenticate(r)
+	if err != nil {
+		w.Header().Set("WWW-Authenticate", `Bearer realm="chain-selectors"`)
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return nil
+	}
+	return r.WithContext(context.WithValue(r.Context(), principalKey{}, principal))
+}
+
+// visible reports whether the caller of the request may see the chain.
+func (s *Server) visible(r *http.Request, selector uint64) bool {
+	allowlist, private := s.allowlists[selector]
+	if !private {
+		return true
+	}
+	principal, authenticated := PrincipalFromContext(r.Context())
+	return authenticated && allowlist[principal]
+}
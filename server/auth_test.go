@@ -0,0 +1,88 @@
+package server
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	chainselectors "github.com/fravlaca/chain-selectors"
+)
+
+func TestAPIKeys(t *testing.T) {
+	authenticator := APIKeys(map[string]string{"key-a": "team-a", "key-b": "team-b"})
+
+	for _, test := range []struct {
+		name      string
+		header    string
+		value     string
+		principal string
+	}{
+		{name: "bearer", header: "Authorization", value: "Bearer key-a", principal: "team-a"},
+		{name: "header", header: "X-API-Key", value: "key-b", principal: "team-b"},
+		{name: "unknown key", header: "X-API-Key", value: "key-c"},
+		{name: "basic auth", header: "Authorization", value: "Basic a2V5LWE6"},
+		{name: "missing"},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, ChainsPath, nil)
+			if test.header != "" {
+				r.Header.Set(test.header, test.value)
+			}
+			principal, err := authenticator.Authenticate(r)
+			if test.principal == "" {
+				require.ErrorIs(t, err, ErrUnauthenticated)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.principal, principal)
+		})
+	}
+}
+
+func TestClientCertificates(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, ChainsPath, nil)
+	_, err := ClientCertificates().Authenticate(r)
+	require.ErrorIs(t, err, ErrUnauthenticated)
+
+	r.TLS = &tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{{Subject: pkix.Name{CommonName: "team-a"}}}}}
+	principal, err := AnyOf(APIKeys(nil), ClientCertificates()).Authenticate(r)
+	require.NoError(t, err)
+	assert.Equal(t, "team-a", principal)
+}
+
+func TestPrivateChains(t *testing.T) {
+	registry := chainselectors.NewRegistry()
+	require.NoError(t, registry.LoadYAML([]byte("selectors:\n  77001:\n    selector: 11\n    name: devnet-a\n")))
+	srv := New(registry,
+		WithAuthenticator(APIKeys(map[string]string{"key-a": "team-a", "key-b": "team-b"})),
+		WithPrivateChain(11, "team-a"),
+	)
+
+	request := func(key, target string) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, target, nil)
+		if key != "" {
+			r.Header.Set("X-API-Key", key)
+		}
+		srv.ServeHTTP(recorder, r)
+		return recorder
+	}
+
+	assert.Equal(t, http.StatusUnauthorized, request("", ChainsPath).Code)
+	assert.Equal(t, http.StatusUnauthorized, request("key-c", ChainsPath).Code)
+
+	assert.Equal(t, http.StatusOK, request("key-a", ChainPath+"11").Code)
+	assert.Equal(t, http.StatusNotFound, request("key-b", ChainPath+"11").Code)
+
+	recorder := request("key-b", ChainsPath+"?environment=devnet&limit=1000")
+	require.Equal(t, http.StatusOK, recorder.Code)
+	assert.NotContains(t, recorder.Body.String(), "devnet-a")
+	assert.Equal(t, "private, no-cache", recorder.Header().Get("Cache-Control"))
+	assert.Contains(t, request("key-a", ChainsPath+"?environment=devnet&limit=1000").Body.String(), "devnet-a")
+}
@@ -0,0 +1,52 @@
+package server
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// corsMaxAge is how long browsers may cache the answer to a preflight request, in seconds.
+const corsMaxAge = 600
+
+// WithCORS lets web pages of the origins, e.g. "https://app.example.com", query the server
+// from browsers. "*" allows every origin, without credentials. Preflight requests are
+// answered without authentication, browsers never send credentials with them.
+func WithCORS(origins ...string) Option {
+	return func(s *Server) {
+		if s.corsOrigins == nil {
+			s.corsOrigins = make(map[string]bool)
+		}
+		for _, origin := range origins {
+			s.corsOrigins[origin] = true
+		}
+	}
+}
+
+// cors sets the CORS headers of the response and reports whether the request was a preflight
+// request, answered already.
+func (s *Server) cors(w http.ResponseWriter, r *http.Request) bool {
+	if s.corsOrigins == nil {
+		return false
+	}
+	w.Header().Add("Vary", "Origin")
+	origin := r.Header.Get("Origin")
+	if origin == "" || (!s.corsOrigins["*"] && !s.corsOrigins[origin]) {
+		return false
+	}
+
+	w.Header().Set("Access-Control-Allow-Origin", origin)
+	w.Header().Set("Access-Control-Expose-Headers", "ETag")
+	// client certificates are only sent to origins listed explicitly, never to any origin
+	if s.authenticator != nil && s.corsOrigins[origin] {
+		w.Header().Set("Access-Control-Allow-Credentials", "true")
+	}
+	if r.Method != http.MethodOptions || r.Header.Get("Access-Control-Request-Method") == "" {
+		return false
+	}
+	w.Header().Set("Access-Control-Allow-Methods", strings.Join([]string{http.MethodGet, http.MethodHead}, ", "))
+	w.Header().Set("Access-Control-Allow-Headers", "Authorization, X-API-Key, If-None-Match")
+	w.Header().Set("Access-Control-Max-Age", strconv.Itoa(corsMaxAge))
+	w.WriteHeader(http.StatusNoContent)
+	return true
+}
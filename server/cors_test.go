@@ -0,0 +1,68 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	chainselectors "github.com/fravlaca/chain-selectors"
+)
+
+func TestCORS(t *testing.T) {
+	srv := New(chainselectors.NewRegistry(),
+		WithAuthenticator(APIKeys(map[string]string{"key-a": "team-a"})),
+		WithCORS("https://app.example.com"),
+	)
+
+	preflight := httptest.NewRequest(http.MethodOptions, ChainsPath, nil)
+	preflight.Header.Set("Origin", "https://app.example.com")
+	preflight.Header.Set("Access-Control-Request-Method", http.MethodGet)
+	recorder := httptest.NewRecorder()
+	srv.ServeHTTP(recorder, preflight)
+	assert.Equal(t, http.StatusNoContent, recorder.Code, "preflight requests are not authenticated")
+	assert.Equal(t, "https://app.example.com", recorder.Header().Get("Access-Control-Allow-Origin"))
+	assert.Contains(t, recorder.Header().Get("Access-Control-Allow-Headers"), "Authorization")
+
+	request := httptest.NewRequest(http.MethodGet, ChainsPath, nil)
+	request.Header.Set("Origin", "https://app.example.com")
+	request.Header.Set("X-API-Key", "key-a")
+	recorder = httptest.NewRecorder()
+	srv.ServeHTTP(recorder, request)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "https://app.example.com", recorder.Header().Get("Access-Control-Allow-Origin"))
+	assert.Equal(t, "ETag", recorder.Header().Get("Access-Control-Expose-Headers"))
+
+	request.Header.Set("Origin", "https://evil.example.com")
+	recorder = httptest.NewRecorder()
+	srv.ServeHTTP(recorder, request)
+	assert.Empty(t, recorder.Header().Get("Access-Control-Allow-Origin"))
+	assert.Equal(t, "Origin", recorder.Header().Get("Vary"))
+}
+
+func TestCompactFormat(t *testing.T) {
+	srv := New(chainselectors.NewRegistry())
+	selector := strconv.FormatUint(chainselectors.ETHEREUM_MAINNET.Selector, 10)
+
+	recorder := get(t, srv, ChainPath+selector+"?format=compact", nil)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	assert.JSONEq(t, `{"selector":"`+selector+`","family":"evm","chain_id":"1","name":"ethereum-mainnet"}`, recorder.Body.String())
+
+	recorder = get(t, srv, ChainsPath+"?format=compact&limit=2", nil)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	var page struct {
+		Chains     []map[string]any `json:"chains"`
+		NextCursor string           `json:"next_cursor"`
+	}
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &page))
+	require.Len(t, page.Chains, 2)
+	assert.IsType(t, "", page.Chains[0]["selector"])
+	assert.NotContains(t, page.Chains[0], "tags")
+	assert.NotEmpty(t, page.NextCursor)
+
+	assert.Equal(t, http.StatusBadRequest, get(t, srv, ChainsPath+"?format=xml", nil).Code)
+}
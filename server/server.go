@@ -0,0 +1,386 @@
+// Package server serves a chain selectors Registry over HTTP, so services and tooling that do
+// not embed the package, or need the chains loaded into a shared registry, can query it.
+//
+//	http.ListenAndServe(":8080", server.New(registry))
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	chainselectors "github.com/fravlaca/chain-selectors"
+)
+
+const (
+	// StatsPath serves the composition of the registry, see Stats.
+	StatsPath = "/stats"
+	// ChainsPath serves the chains of the registry, a page at a time, see ChainsPage.
+	ChainsPath = "/chains"
+	// ChainPath followed by a selector serves the Chain identified by the selector.
+	ChainPath = ChainsPath + "/"
+	// DeltaPath serves the chainselectors.Delta from the fingerprint given as from parameter
+	// to the current chains, see Registry.ComputeDelta.
+	DeltaPath = "/delta"
+)
+
+const (
+	// DefaultPageSize is the number of chains served by ChainsPath without a limit parameter.
+	DefaultPageSize = 100
+	// MaxPageSize is the largest limit parameter accepted by ChainsPath.
+	MaxPageSize = 1000
+)
+
+// Server is an http.Handler serving a Registry.
+type Server struct {
+	registry *chainselectors.Registry
+	mux      *http.ServeMux
+	// etag is the quoted fingerprint of the registry, updated when chains are loaded
+	etag atomic.Pointer[string]
+
+	authenticator Authenticator
+	// allowlists holds the principals allowed to see each private chain
+	allowlists  map[uint64]map[string]bool
+	corsOrigins map[string]bool
+}
+
+// Option configures a Server.
+type Option func(*Server)
+
+// New creates a Server serving the registry, configured with the options.
+func New(registry *chainselectors.Registry, opts ...Option) *Server {
+	s := &Server{registry: registry, mux: http.NewServeMux()}
+	for _, opt := range opts {
+		opt(s)
+	}
+	s.mux.HandleFunc(StatsPath, s.handleStats)
+	s.mux.HandleFunc(ChainsPath, s.handleChains)
+	s.mux.HandleFunc(ChainPath, s.handleChain)
+	s.mux.HandleFunc(DeltaPath, s.handleDelta)
+
+	s.updateETag()
+	registry.OnChange(func(chainselectors.ChangeSet) { s.updateETag() })
+	return s
+}
+
+func (s *Server) updateETag() {
+	etag := strconv.Quote(s.registry.Fingerprint())
+	s.etag.Store(&etag)
+}
+
+// notModified sets the ETag of the dataset responses, the fingerprint of the registry, and
+// answers 304 Not Modified if the request holds it in If-None-Match.
+func (s *Server) notModified(w http.ResponseWriter, r *http.Request) bool {
+	etag := *s.etag.Load()
+	w.Header().Set("ETag", etag)
+	if s.allowlists != nil {
+		// callers see different chains for the same etag, shared caches must not serve them
+		w.Header().Set("Cache-Control", "private, no-cache")
+	} else {
+		w.Header().Set("Cache-Control", "no-cache")
+	}
+	if !etagMatches(r.Header.Get("If-None-Match"), etag) {
+		return false
+	}
+	w.WriteHeader(http.StatusNotModified)
+	return true
+}
+
+// etagMatches reports whether the If-None-Match header matches the etag, with the weak
+// comparison RFC 9110 requires for it.
+func etagMatches(ifNoneMatch, etag string) bool {
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == "*" || candidate == etag {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if s.cors(w, r) {
+		return
+	}
+	if r = s.authenticate(w, r); r != nil {
+		s.mux.ServeHTTP(w, r)
+	}
+}
+
+// Stats is the body served at StatsPath.
+type Stats struct {
+	Total           int            `json:"total"`
+	ByFamily        map[string]int `json:"by_family"`
+	ByEnvironment   map[string]int `json:"by_environment"`
+	DatasetVersion  string         `json:"dataset_version"`
+	Fingerprint     string         `json:"fingerprint"`
+	LastSync        *time.Time     `json:"last_sync"`
+	LastSyncVersion string         `json:"last_sync_version,omitempty"`
+}
+
+func (s *Server) handleStats(w http.ResponseWriter, r *http.Request) {
+	if !allowRead(w, r) {
+		return
+	}
+	stats := s.registry.Stats()
+	body := Stats{
+		Total:           stats.Total,
+		ByFamily:        stats.ByFamily,
+		ByEnvironment:   stats.ByEnvironment,
+		DatasetVersion:  stats.DatasetVersion,
+		Fingerprint:     stats.Fingerprint,
+		LastSyncVersion: stats.LastSyncVersion,
+	}
+	if !stats.LastSync.IsZero() {
+		body.LastSync = &stats.LastSync
+	}
+	writeJSON(w, http.StatusOK, body)
+}
+
+// Chain is a chain served at ChainsPath.
+type Chain struct {
+	Selector    uint64   `json:"selector"`
+	Family      string   `json:"family"`
+	ChainID     string   `json:"chain_id"`
+	Name        string   `json:"name"`
+	Environment string   `json:"environment"`
+	Tags        []string `json:"tags,omitempty"`
+}
+
+// CompactChain is a chain served with FormatCompact. The selector is a string as selectors
+// do not fit in the numbers of JavaScript.
+type CompactChain struct {
+	Selector uint64 `json:"selector,string"`
+	Family   string `json:"family"`
+	ChainID  string `json:"chain_id"`
+	Name     string `json:"name,omitempty"`
+}
+
+// FormatCompact, passed as the format parameter of ChainsPath and ChainPath, serves chains as
+// CompactChain, for browsers. The page of chains is then a ChainsPage of CompactChain.
+const FormatCompact = "compact"
+
+// ChainsPage is the body served at ChainsPath. The chains are sorted by selector, NextCursor is
+// passed as the cursor parameter to get the next page and is empty on the last one.
+type ChainsPage struct {
+	Chains     []Chain `json:"chains"`
+	NextCursor string  `json:"next_cursor,omitempty"`
+}
+
+type compactChainsPage struct {
+	Chains     []CompactChain `json:"chains"`
+	NextCursor string         `json:"next_cursor,omitempty"`
+}
+
+// handleChains serves the chains matching the family, environment, tag and name_prefix
+// parameters, at most limit of them starting after cursor. Every filter given must match.
+// Pages are tagged with the fingerprint of the registry, so polling clients revalidating with
+// If-None-Match get a 304 until chains are loaded.
+func (s *Server) handleChains(w http.ResponseWriter, r *http.Request) {
+	if !allowRead(w, r) {
+		return
+	}
+	query := r.URL.Query()
+	family, environment, tag, namePrefix := query.Get("family"), query.Get("environment"), query.Get("tag"), query.Get("name_prefix")
+
+	limit := DefaultPageSize
+	if raw := query.Get("limit"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed < 1 || parsed > MaxPageSize {
+			http.Error(w, "limit must be between 1 and "+strconv.Itoa(MaxPageSize), http.StatusBadRequest)
+			return
+		}
+		limit = parsed
+	}
+	// the cursor is the selector of the last chain served, pages stay consistent when chains
+	// are loaded in between, chains sorting before the cursor are just not served
+	var after uint64
+	if raw := query.Get("cursor"); raw != "" {
+		parsed, err := strconv.ParseUint(raw, 10, 64)
+		if err != nil {
+			http.Error(w, "invalid cursor", http.StatusBadRequest)
+			return
+		}
+		after = parsed
+	}
+	compact, ok := parseFormat(w, r)
+	if !ok {
+		return
+	}
+	if s.notModified(w, r) {
+		return
+	}
+
+	page := ChainsPage{Chains: []Chain{}}
+	for _, ch := range s.registry.Chains() {
+		if !s.visible(r, ch.Selector) ||
+			(after != 0 && ch.Selector <= after) ||
+			(family != "" && ch.Family != family) ||
+			(environment != "" && ch.Environment != environment) ||
+			(namePrefix != "" && !strings.HasPrefix(ch.Name, namePrefix)) {
+			continue
+		}
+		served := newChain(ch)
+		if tag != "" && !contains(served.Tags, tag) {
+			continue
+		}
+		if len(page.Chains) == limit {
+			page.NextCursor = strconv.FormatUint(page.Chains[limit-1].Selector, 10)
+			break
+		}
+		page.Chains = append(page.Chains, served)
+	}
+	if compact {
+		body := compactChainsPage{Chains: make([]CompactChain, len(page.Chains)), NextCursor: page.NextCursor}
+		for i, ch := range page.Chains {
+			body.Chains[i] = ch.compact()
+		}
+		writeJSON(w, http.StatusOK, body)
+		return
+	}
+	writeJSON(w, http.StatusOK, page)
+}
+
+// handleChain serves the chain identified by the selector following ChainPath, tagged with the
+// fingerprint of the registry like the pages of chains.
+func (s *Server) handleChain(w http.ResponseWriter, r *http.Request) {
+	if !allowRead(w, r) {
+		return
+	}
+	selector, err := strconv.ParseUint(strings.TrimPrefix(r.URL.Path, ChainPath), 10, 64)
+	if err != nil {
+		http.Error(w, "invalid selector", http.StatusBadRequest)
+		return
+	}
+	compact, ok := parseFormat(w, r)
+	if !ok {
+		return
+	}
+	if s.notModified(w, r) {
+		return
+	}
+
+	chains := s.registry.Chains()
+	i := sort.Search(len(chains), func(i int) bool { return chains[i].Selector >= selector })
+	if i == len(chains) || chains[i].Selector != selector || !s.visible(r, selector) {
+		http.Error(w, "chain not found", http.StatusNotFound)
+		return
+	}
+	if compact {
+		writeJSON(w, http.StatusOK, newChain(chains[i]).compact())
+		return
+	}
+	writeJSON(w, http.StatusOK, newChain(chains[i]))
+}
+
+// handleDelta serves the delta from the fingerprint of the from parameter, 404 Not Found if the
+// registry does not remember it. Private chains the caller may not see are left out, so the
+// caller cannot apply the delta and syncs the whole dataset instead.
+func (s *Server) handleDelta(w http.ResponseWriter, r *http.Request) {
+	if !allowRead(w, r) {
+		return
+	}
+	from := r.URL.Query().Get("from")
+	if from == "" {
+		http.Error(w, "missing from parameter", http.StatusBadRequest)
+		return
+	}
+	delta, err := s.registry.ComputeDelta(from)
+	if errors.Is(err, chainselectors.ErrUnknownFingerprint) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if s.allowlists != nil {
+		w.Header().Set("Cache-Control", "private, no-cache")
+		delta.Added = s.visibleDeltaChains(r, delta.Added)
+		delta.Changed = s.visibleDeltaChains(r, delta.Changed)
+		delta.Removed, delta.RemovedSelectors = s.visibleRemovedChains(r, delta.Removed, delta.RemovedSelectors)
+	}
+	writeJSON(w, http.StatusOK, delta)
+}
+
+func (s *Server) visibleDeltaChains(r *http.Request, chains []chainselectors.DeltaChain) []chainselectors.DeltaChain {
+	var visible []chainselectors.DeltaChain
+	for _, ch := range chains {
+		if s.visible(r, ch.Selector) {
+			visible = append(visible, ch)
+		}
+	}
+	return visible
+}
+
+// visibleRemovedChains returns the chain ids and selectors of the removed chains the caller may
+// see.
+func (s *Server) visibleRemovedChains(r *http.Request, chainIDs, selectors []uint64) (visibleIDs, visibleSelectors []uint64) {
+	for i, chainID := range chainIDs {
+		if i < len(selectors) && s.visible(r, selectors[i]) {
+			visibleIDs = append(visibleIDs, chainID)
+			visibleSelectors = append(visibleSelectors, selectors[i])
+		}
+	}
+	return visibleIDs, visibleSelectors
+}
+
+func (c Chain) compact() CompactChain {
+	return CompactChain{Selector: c.Selector, Family: c.Family, ChainID: c.ChainID, Name: c.Name}
+}
+
+// parseFormat returns whether the request asks for FormatCompact, it answers 400 Bad Request
+// and returns false for unknown formats.
+func parseFormat(w http.ResponseWriter, r *http.Request) (compact, ok bool) {
+	switch format := r.URL.Query().Get("format"); format {
+	case "":
+		return false, true
+	case FormatCompact:
+		return true, true
+	default:
+		http.Error(w, "unknown format "+strconv.Quote(format), http.StatusBadRequest)
+		return false, false
+	}
+}
+
+func newChain(ch chainselectors.RegistryChain) Chain {
+	return Chain{
+		Selector:    ch.Selector,
+		Family:      ch.Family,
+		ChainID:     ch.ChainID,
+		Name:        ch.Name,
+		Environment: ch.Environment,
+		Tags:        chainselectors.TagsOf(ch.Selector),
+	}
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// allowRead rejects the request unless it is a GET or HEAD.
+func allowRead(w http.ResponseWriter, r *http.Request) bool {
+	if r.Method == http.MethodGet || r.Method == http.MethodHead {
+		return true
+	}
+	w.Header().Set("Allow", "GET, HEAD")
+	http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	return false
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,165 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	chainselectors "github.com/fravlaca/chain-selectors"
+)
+
+func get(t *testing.T, handler http.Handler, target string, body any) *httptest.ResponseRecorder {
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, target, nil))
+	if body != nil && recorder.Code == http.StatusOK {
+		require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), body))
+	}
+	return recorder
+}
+
+func TestStats(t *testing.T) {
+	registry := chainselectors.NewRegistry()
+	srv := New(registry)
+
+	var stats Stats
+	require.Equal(t, http.StatusOK, get(t, srv, StatsPath, &stats).Code)
+	assert.Equal(t, registry.Fingerprint(), stats.Fingerprint)
+	assert.Equal(t, chainselectors.EmbeddedDatasetVersion(), stats.DatasetVersion)
+	assert.Positive(t, stats.ByFamily[chainselectors.FamilyEVM])
+	assert.Positive(t, stats.ByEnvironment[chainselectors.EnvironmentMainnet])
+	assert.Nil(t, stats.LastSync)
+
+	source := chainselectors.DatasetSourceFunc(func(context.Context) ([]byte, error) {
+		return []byte("selectors:\n  99101:\n    selector: 9910100000000000001\n    name: remote-devnet\n"), nil
+	})
+	require.NoError(t, chainselectors.NewRemoteSync(registry, source).Sync(context.Background()))
+
+	var synced Stats
+	require.Equal(t, http.StatusOK, get(t, srv, StatsPath, &synced).Code)
+	assert.Equal(t, stats.Total+1, synced.Total)
+	assert.Equal(t, stats.ByEnvironment[chainselectors.EnvironmentDevnet]+1, synced.ByEnvironment[chainselectors.EnvironmentDevnet])
+	require.NotNil(t, synced.LastSync)
+	assert.NotEmpty(t, synced.LastSyncVersion)
+
+	recorder := httptest.NewRecorder()
+	srv.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, StatsPath, nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+}
+
+func TestChainsPagination(t *testing.T) {
+	registry := chainselectors.NewRegistry()
+	srv := New(registry)
+
+	var all []Chain
+	cursor := ""
+	for pages := 0; ; pages++ {
+		require.Less(t, pages, 100)
+		var page ChainsPage
+		require.Equal(t, http.StatusOK, get(t, srv, ChainsPath+"?limit=50&cursor="+cursor, &page).Code)
+		all = append(all, page.Chains...)
+		if page.NextCursor == "" {
+			break
+		}
+		cursor = page.NextCursor
+	}
+
+	require.Len(t, all, len(registry.Chains()))
+	for i := 1; i < len(all); i++ {
+		require.Less(t, all[i-1].Selector, all[i].Selector)
+	}
+}
+
+func TestChainsFilters(t *testing.T) {
+	srv := New(chainselectors.NewRegistry())
+
+	var page ChainsPage
+	require.Equal(t, http.StatusOK, get(t, srv, ChainsPath+"?family=evm&environment=mainnet&tag=op-stack&limit=1000", &page).Code)
+	require.NotEmpty(t, page.Chains)
+	for _, ch := range page.Chains {
+		assert.Equal(t, chainselectors.FamilyEVM, ch.Family)
+		assert.Equal(t, chainselectors.EnvironmentMainnet, ch.Environment)
+		assert.Contains(t, ch.Tags, "op-stack")
+	}
+
+	require.Equal(t, http.StatusOK, get(t, srv, ChainsPath+"?name_prefix=ethereum-testnet-", &page).Code)
+	require.NotEmpty(t, page.Chains)
+	for _, ch := range page.Chains {
+		assert.True(t, strings.HasPrefix(ch.Name, "ethereum-testnet-"), ch.Name)
+	}
+
+	require.Equal(t, http.StatusOK, get(t, srv, ChainsPath+"?family=unknown", &page).Code)
+	assert.Empty(t, page.Chains)
+	assert.Empty(t, page.NextCursor)
+
+	assert.Equal(t, http.StatusBadRequest, get(t, srv, ChainsPath+"?limit=0", nil).Code)
+	assert.Equal(t, http.StatusBadRequest, get(t, srv, ChainsPath+"?limit=1001", nil).Code)
+	assert.Equal(t, http.StatusBadRequest, get(t, srv, ChainsPath+"?cursor=abc", nil).Code)
+}
+
+func TestChainsConditionalGet(t *testing.T) {
+	registry := chainselectors.NewRegistry()
+	srv := New(registry)
+
+	first := get(t, srv, ChainsPath, nil)
+	require.Equal(t, http.StatusOK, first.Code)
+	etag := first.Header().Get("ETag")
+	assert.Equal(t, `"`+registry.Fingerprint()+`"`, etag)
+
+	conditional := func(ifNoneMatch string) int {
+		recorder := httptest.NewRecorder()
+		request := httptest.NewRequest(http.MethodGet, ChainsPath+"?limit=10", nil)
+		request.Header.Set("If-None-Match", ifNoneMatch)
+		srv.ServeHTTP(recorder, request)
+		return recorder.Code
+	}
+	assert.Equal(t, http.StatusNotModified, conditional(etag))
+	assert.Equal(t, http.StatusNotModified, conditional(`"other", W/`+etag))
+	assert.Equal(t, http.StatusOK, conditional(`"other"`))
+
+	require.NoError(t, registry.LoadYAML([]byte("selectors:\n  77001:\n    selector: 11\n    name: devnet-a\n")))
+	assert.Equal(t, http.StatusOK, conditional(etag), "loading chains changes the etag")
+	assert.Equal(t, `"`+registry.Fingerprint()+`"`, get(t, srv, ChainsPath, nil).Header().Get("ETag"))
+}
+
+func TestChain(t *testing.T) {
+	srv := New(chainselectors.NewRegistry())
+
+	var ch Chain
+	require.Equal(t, http.StatusOK, get(t, srv, ChainPath+strconv.FormatUint(chainselectors.ETHEREUM_MAINNET.Selector, 10), &ch).Code)
+	assert.Equal(t, chainselectors.ETHEREUM_MAINNET.Name, ch.Name)
+	assert.Equal(t, "1", ch.ChainID)
+
+	assert.Equal(t, http.StatusNotFound, get(t, srv, ChainPath+"11", nil).Code)
+	assert.Equal(t, http.StatusBadRequest, get(t, srv, ChainPath+"ethereum", nil).Code)
+}
+
+func TestDelta(t *testing.T) {
+	registry := chainselectors.NewRegistry()
+	srv := New(registry, WithPrivateChain(12))
+	base := registry.Fingerprint()
+	require.NoError(t, registry.LoadYAML([]byte("selectors:\n  77001:\n    selector: 11\n    name: devnet-a\n  77002:\n    selector: 12\n    name: devnet-b\n")))
+
+	var delta chainselectors.Delta
+	require.Equal(t, http.StatusOK, get(t, srv, DeltaPath+"?from="+base, &delta).Code)
+	assert.Equal(t, base, delta.From)
+	assert.Equal(t, registry.Fingerprint(), delta.To)
+	require.Len(t, delta.Added, 1, "private chains are left out")
+	assert.Equal(t, "devnet-a", delta.Added[0].Name)
+
+	loaded := registry.Fingerprint()
+	require.NoError(t, registry.ApplyDelta(chainselectors.Delta{From: loaded, To: base, Removed: []uint64{77001, 77002}}))
+	delta = chainselectors.Delta{}
+	require.Equal(t, http.StatusOK, get(t, srv, DeltaPath+"?from="+loaded, &delta).Code)
+	assert.Equal(t, []uint64{77001}, delta.Removed, "removed private chains are left out")
+	assert.Equal(t, []uint64{11}, delta.RemovedSelectors)
+
+	assert.Equal(t, http.StatusNotFound, get(t, srv, DeltaPath+"?from=unknown", nil).Code)
+	assert.Equal(t, http.StatusBadRequest, get(t, srv, DeltaPath, nil).Code)
+}
@@ -1,40 +1,40 @@
 package chain_selectors
 
 import (
-	_ "embed"
 	"fmt"
 
 	"github.com/mr-tron/base58"
 	"gopkg.in/yaml.v3"
+
+	"github.com/fravlaca/chain-selectors/solana"
 )
 
 //go:generate go run genchains_solana.go
 
-//go:embed selectors_solana.yml
-var solanaSelectorsYml []byte
-
-//go:embed test_selectors_solana.yml
-var testSelectorsSolanaYml []byte
-
 var (
-	solanaSelectorsMap           = parseSolanaYml(solanaSelectorsYml)
-	solanaTestSelectorsMap       = parseSolanaYml(testSelectorsSolanaYml)
-	solanaChainIdToChainSelector = loadAllSolanaSelectors()
-	solanaChainsBySelector       = make(map[uint64]SolanaChain)
+	solanaSelectorsYml     = solana.SelectorsYAML()
+	testSelectorsSolanaYml = solana.TestSelectorsYAML()
 )
 
-func init() {
-	for _, v := range SolanaALL {
-		solanaChainsBySelector[v.Selector] = v
-	}
-}
+var (
+	solanaSelectorsMap           = onceValue(func() map[string]ChainDetails { return parseSolanaYml(solanaSelectorsYml) })
+	solanaTestSelectorsMap       = onceValue(func() map[string]ChainDetails { return parseSolanaYml(testSelectorsSolanaYml) })
+	solanaChainIdToChainSelector = onceValue(loadAllSolanaSelectors)
+	solanaChainsBySelector       = onceValue(func() map[uint64]SolanaChain {
+		output := make(map[uint64]SolanaChain, len(SolanaALL))
+		for _, v := range SolanaALL {
+			output[v.Selector] = v
+		}
+		return output
+	})
+)
 
 func loadAllSolanaSelectors() map[string]ChainDetails {
-	output := make(map[string]ChainDetails, len(solanaSelectorsMap)+len(solanaTestSelectorsMap))
-	for k, v := range solanaSelectorsMap {
+	output := make(map[string]ChainDetails, len(solanaSelectorsMap())+len(solanaTestSelectorsMap()))
+	for k, v := range solanaSelectorsMap() {
 		output[k] = v
 	}
-	for k, v := range solanaTestSelectorsMap {
+	for k, v := range solanaTestSelectorsMap() {
 		output[k] = v
 	}
 	return output
@@ -68,17 +68,17 @@ func validateSolanaChainID(data map[string]ChainDetails) {
 }
 
 func SolanaChainIdToChainSelector() map[string]uint64 {
-	copyMap := make(map[string]uint64, len(solanaChainIdToChainSelector))
-	for k, v := range solanaChainIdToChainSelector {
+	copyMap := make(map[string]uint64, len(solanaChainIdToChainSelector()))
+	for k, v := range solanaChainIdToChainSelector() {
 		copyMap[k] = v.ChainSelector
 	}
 	return copyMap
 }
 
 func SolanaNameFromChainId(chainId string) (string, error) {
-	details, exist := solanaChainIdToChainSelector[chainId]
+	details, exist := solanaChainIdToChainSelector()[chainId]
 	if !exist {
-		return "", fmt.Errorf("chain name not found for chain %v", chainId)
+		return "", notFoundError(InputChainID, FamilySolana, fmt.Sprint(chainId))
 	}
 	if details.ChainName == "" {
 		return chainId, nil
@@ -87,16 +87,16 @@ func SolanaNameFromChainId(chainId string) (string, error) {
 }
 
 func SolanaChainIdFromSelector(selector uint64) (string, error) {
-	chain, exist := solanaChainsBySelector[selector]
+	chain, exist := solanaChainsBySelector()[selector]
 	if !exist {
-		return "", fmt.Errorf("chain not found for selector %d", selector)
+		return "", selectorNotFoundError(FamilySolana, selector)
 	}
 
 	return chain.ChainID, nil
 }
 
 func SolanaChainBySelector(selector uint64) (SolanaChain, bool) {
-	chain, exists := solanaChainsBySelector[selector]
+	chain, exists := solanaChainsBySelector()[selector]
 
 	return chain, exists
 }
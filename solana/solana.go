@@ -0,0 +1,65 @@
+// Package solana holds the dataset of the Solana chains, selectors_solana.yml and test_selectors_solana.yml, for programs
+// resolving Solana chains only: importing it does not embed the datasets of the other families,
+// which the root chain_selectors package aggregates.
+package solana
+
+import (
+	_ "embed"
+
+	"github.com/fravlaca/chain-selectors/internal/familydata"
+)
+
+//go:embed selectors_solana.yml
+var selectorsYml []byte
+
+//go:embed test_selectors_solana.yml
+var testSelectorsYml []byte
+
+var index = familydata.Lazy[string](selectorsYml, testSelectorsYml)
+
+// Chain is a Solana chain of the dataset.
+type Chain struct {
+	ChainID  string
+	Selector uint64
+	Name     string
+	// Test marks the chains of test_selectors_solana.yml.
+	Test bool
+}
+
+// Chains returns the chains of the dataset sorted by chain id.
+func Chains() []Chain {
+	chains := index().Chains
+	output := make([]Chain, len(chains))
+	for i, ch := range chains {
+		output[i] = Chain(ch)
+	}
+	return output
+}
+
+// BySelector returns the chain identified by the selector.
+func BySelector(selector uint64) (Chain, bool) {
+	ch, exists := index().BySelector[selector]
+	return Chain(ch), exists
+}
+
+// ByChainID returns the chain with the chain id.
+func ByChainID(chainID string) (Chain, bool) {
+	ch, exists := index().ByChainID[chainID]
+	return Chain(ch), exists
+}
+
+// ByName returns the chain with the name.
+func ByName(name string) (Chain, bool) {
+	ch, exists := index().ByName[name]
+	return Chain(ch), exists
+}
+
+// SelectorsYAML returns the embedded selectors_solana.yml. The returned slice must not be modified.
+func SelectorsYAML() []byte {
+	return selectorsYml
+}
+
+// TestSelectorsYAML returns the embedded test_selectors_solana.yml. The returned slice must not be modified.
+func TestSelectorsYAML() []byte {
+	return testSelectorsYml
+}
@@ -0,0 +1,34 @@
+package solana
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLookups(t *testing.T) {
+	chains := Chains()
+	require.NotEmpty(t, chains)
+	for i, ch := range chains {
+		if i > 0 {
+			assert.Less(t, chains[i-1].ChainID, ch.ChainID)
+		}
+		bySelector, exists := BySelector(ch.Selector)
+		require.True(t, exists, "selector %d", ch.Selector)
+		assert.Equal(t, ch, bySelector)
+
+		byChainID, exists := ByChainID(ch.ChainID)
+		require.True(t, exists)
+		assert.Equal(t, ch, byChainID)
+
+		if ch.Name != "" {
+			byName, exists := ByName(ch.Name)
+			require.True(t, exists)
+			assert.Equal(t, ch, byName)
+		}
+	}
+
+	_, exists := BySelector(0)
+	assert.False(t, exists)
+}
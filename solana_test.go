@@ -55,7 +55,7 @@ func Test_YmlAreValid(t *testing.T) {
 }
 
 func Test_SolanaChainSelectors(t *testing.T) {
-	for selector, chain := range solanaChainsBySelector {
+	for selector, chain := range solanaChainsBySelector() {
 		family, err := GetSelectorFamily(selector)
 		require.NoError(t, err,
 			"selector %v should be returned as solana family, but received %v",
@@ -76,7 +76,7 @@ func Test_SolanaChainSelectors(t *testing.T) {
 }
 
 func Test_SolanaGetChainDetailsByChainIDAndFamily(t *testing.T) {
-	for k, v := range solanaSelectorsMap {
+	for k, v := range solanaSelectorsMap() {
 		details, err := GetChainDetailsByChainIDAndFamily(k, FamilySolana)
 		assert.NoError(t, err)
 		assert.Equal(t, v, details)
@@ -84,7 +84,7 @@ func Test_SolanaGetChainDetailsByChainIDAndFamily(t *testing.T) {
 }
 
 func Test_SolanaGetChainIDByChainSelector(t *testing.T) {
-	for k, v := range solanaSelectorsMap {
+	for k, v := range solanaSelectorsMap() {
 		chainID, err := GetChainIDFromSelector(v.ChainSelector)
 		assert.NoError(t, err)
 		assert.Equal(t, chainID, fmt.Sprintf("%v", k))
@@ -92,8 +92,8 @@ func Test_SolanaGetChainIDByChainSelector(t *testing.T) {
 }
 
 func Test_SolanaNoOverlapBetweenRealAndTestChains(t *testing.T) {
-	for k, _ := range solanaSelectorsMap {
-		_, exist := solanaTestSelectorsMap[k]
+	for k, _ := range solanaSelectorsMap() {
+		_, exist := solanaTestSelectorsMap()[k]
 		assert.False(t, exist, "Chain %d is duplicated between real and test chains", k)
 	}
 }
@@ -0,0 +1,73 @@
+package chain_selectors
+
+import "time"
+
+// RegistryStats summarises the composition of the chain registry.
+type RegistryStats struct {
+	// Total is the number of official and test chains across all families.
+	Total int
+	// ByFamily counts chains per family.
+	ByFamily map[string]int
+	// ByEnvironment counts chains per environment, see GetSelectorEnvironment.
+	ByEnvironment map[string]int
+	// CustomRegistered is the number of custom chains registered with RegisterCustomChain.
+	CustomRegistered int
+	// DatasetVersion is the version of the embedded datasets, see EmbeddedDatasetVersion.
+	DatasetVersion string
+
+	// Fingerprint, LastSync and LastSyncVersion are only set by Registry.Stats. LastSync is
+	// when a RemoteSync last loaded a dataset into the registry, zero if it never did, and
+	// LastSyncVersion the version of that dataset.
+	Fingerprint     string
+	LastSync        time.Time
+	LastSyncVersion string
+}
+
+// Stats returns the number of known chains per family and environment, together with the
+// number of custom chains registered in this process.
+func Stats() RegistryStats {
+	stats := RegistryStats{
+		ByFamily:       make(map[string]int, len(allFamilies)),
+		ByEnvironment:  make(map[string]int),
+		DatasetVersion: EmbeddedDatasetVersion(),
+	}
+
+	for _, family := range allFamilies {
+		for _, selector := range knownSelectors(family) {
+			environment, err := GetSelectorEnvironment(selector)
+			if err != nil {
+				continue
+			}
+			stats.Total++
+			stats.ByFamily[family]++
+			stats.ByEnvironment[environment]++
+		}
+	}
+
+	stats.CustomRegistered = customChains.count()
+
+	return stats
+}
+
+// Stats counts the chains the registry resolves, see Registry.Fingerprint, by family and
+// environment. Unlike the package level Stats, it counts the chains loaded into the registry
+// and not the ones it excludes.
+func (r *Registry) Stats() RegistryStats {
+	stats := RegistryStats{
+		ByFamily:         make(map[string]int, len(allFamilies)),
+		ByEnvironment:    make(map[string]int),
+		CustomRegistered: customChains.count(),
+		DatasetVersion:   EmbeddedDatasetVersion(),
+		Fingerprint:      r.Fingerprint(),
+	}
+	for _, ch := range r.Chains() {
+		stats.Total++
+		stats.ByFamily[ch.Family]++
+		stats.ByEnvironment[ch.Environment]++
+	}
+
+	r.mu.Lock()
+	stats.LastSync, stats.LastSyncVersion = r.lastSync.LoadedAt, r.lastSync.DatasetVersion
+	r.mu.Unlock()
+	return stats
+}
@@ -0,0 +1,50 @@
+package chain_selectors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Stats(t *testing.T) {
+	stats := Stats()
+
+	assert.Equal(t, len(ALL), stats.ByFamily[FamilyEVM])
+	assert.Equal(t, len(SolanaALL), stats.ByFamily[FamilySolana])
+	assert.Equal(t, len(AptosALL), stats.ByFamily[FamilyAptos])
+	assert.Equal(t, len(SuiALL), stats.ByFamily[FamilySui])
+	assert.Equal(t, len(TronALL), stats.ByFamily[FamilyTron])
+	assert.Equal(t, len(TonALL), stats.ByFamily[FamilyTon])
+
+	total := 0
+	for _, count := range stats.ByFamily {
+		total += count
+	}
+	assert.Equal(t, total, stats.Total)
+
+	total = 0
+	for _, count := range stats.ByEnvironment {
+		total += count
+	}
+	assert.Equal(t, stats.Total, total)
+	assert.Positive(t, stats.ByEnvironment[EnvironmentMainnet])
+	assert.Positive(t, stats.ByEnvironment[EnvironmentTestnet])
+}
+
+func Test_StatsCountsRegisteredCustomChains(t *testing.T) {
+	restoreCustomChains(t)
+	before := Stats().CustomRegistered
+	RegisterCustomChain(987654321001, "stats-devnet")
+	assert.Equal(t, before+1, Stats().CustomRegistered)
+}
+
+func Test_RegistryStats(t *testing.T) {
+	registry := NewRegistry()
+	stats := registry.Stats()
+	assert.Equal(t, Stats().Total, stats.Total)
+	assert.Equal(t, registry.Fingerprint(), stats.Fingerprint)
+	assert.True(t, stats.LastSync.IsZero())
+
+	withoutTests := NewRegistry(WithTestChains(false)).Stats()
+	assert.Equal(t, stats.Total-len(evmTestSelectorsMap())-len(solanaTestSelectorsMap()), withoutTests.Total)
+}
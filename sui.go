@@ -1,28 +1,28 @@
 package chain_selectors
 
 import (
-	_ "embed"
 	"fmt"
 
 	"gopkg.in/yaml.v3"
+
+	"github.com/fravlaca/chain-selectors/sui"
 )
 
 //go:generate go run genchains_sui.go
 
-//go:embed selectors_sui.yml
-var suiSelectorsYml []byte
+var suiSelectorsYml = sui.SelectorsYAML()
 
 var (
-	suiSelectorsMap     = parseSuiYml(suiSelectorsYml)
-	suiChainsBySelector = make(map[uint64]SuiChain)
+	suiSelectorsMap     = onceValue(func() map[uint64]ChainDetails { return parseSuiYml(suiSelectorsYml) })
+	suiChainsBySelector = onceValue(func() map[uint64]SuiChain {
+		output := make(map[uint64]SuiChain, len(SuiALL))
+		for _, v := range SuiALL {
+			output[v.Selector] = v
+		}
+		return output
+	})
 )
 
-func init() {
-	for _, v := range SuiALL {
-		suiChainsBySelector[v.Selector] = v
-	}
-}
-
 func parseSuiYml(ymlFile []byte) map[uint64]ChainDetails {
 	type ymlData struct {
 		SelectorsBySuiChainId map[uint64]ChainDetails `yaml:"selectors"`
@@ -43,17 +43,17 @@ func validateSuiChainID(data map[uint64]ChainDetails) {
 }
 
 func SuiChainIdToChainSelector() map[uint64]uint64 {
-	copyMap := make(map[uint64]uint64, len(suiSelectorsMap))
-	for k, v := range suiSelectorsMap {
+	copyMap := make(map[uint64]uint64, len(suiSelectorsMap()))
+	for k, v := range suiSelectorsMap() {
 		copyMap[k] = v.ChainSelector
 	}
 	return copyMap
 }
 
 func SuiNameFromChainId(chainId uint64) (string, error) {
-	details, exist := suiSelectorsMap[chainId]
+	details, exist := suiSelectorsMap()[chainId]
 	if !exist {
-		return "", fmt.Errorf("chain name not found for chain %v", chainId)
+		return "", notFoundError(InputChainID, FamilySui, fmt.Sprint(chainId))
 	}
 	if details.ChainName == "" {
 		return fmt.Sprint(chainId), nil
@@ -62,15 +62,15 @@ func SuiNameFromChainId(chainId uint64) (string, error) {
 }
 
 func SuiChainIdFromSelector(selector uint64) (uint64, error) {
-	chain, exist := suiChainsBySelector[selector]
+	chain, exist := suiChainsBySelector()[selector]
 	if !exist {
-		return 0, fmt.Errorf("chain id not found for selector %d", selector)
+		return 0, selectorNotFoundError(FamilySui, selector)
 	}
 
 	return chain.ChainID, nil
 }
 
 func SuiChainBySelector(selector uint64) (SuiChain, bool) {
-	chain, exist := suiChainsBySelector[selector]
+	chain, exist := suiChainsBySelector()[selector]
 	return chain, exist
 }
@@ -48,7 +48,7 @@ func Test_SuiYmlAreValid(t *testing.T) {
 }
 
 func Test_SuiChainSelectors(t *testing.T) {
-	for selector, chain := range suiChainsBySelector {
+	for selector, chain := range suiChainsBySelector() {
 		family, err := GetSelectorFamily(selector)
 		require.NoError(t, err,
 			"selector %v should be returned as sui family, but received %v",
@@ -68,7 +68,7 @@ func Test_SuiChainSelectors(t *testing.T) {
 }
 
 func Test_SuiGetChainDetailsByChainIDAndFamily(t *testing.T) {
-	for k, v := range suiSelectorsMap {
+	for k, v := range suiSelectorsMap() {
 		details, err := GetChainDetailsByChainIDAndFamily(fmt.Sprint(k), FamilySui)
 		assert.NoError(t, err)
 		assert.Equal(t, v, details)
@@ -76,7 +76,7 @@ func Test_SuiGetChainDetailsByChainIDAndFamily(t *testing.T) {
 }
 
 func Test_SuiGetChainIDByChainSelector(t *testing.T) {
-	for k, v := range suiSelectorsMap {
+	for k, v := range suiSelectorsMap() {
 		chainID, err := GetChainIDFromSelector(v.ChainSelector)
 		assert.NoError(t, err)
 		assert.Equal(t, chainID, fmt.Sprintf("%v", k))
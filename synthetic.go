@@ -0,0 +1,100 @@
+package chain_selectors
+
+import (
+	"fmt"
+	"math/rand"
+	"strconv"
+	"strings"
+)
+
+const (
+	// SyntheticChainIDStart is the first chain id of the range reserved for the chains generated
+	// by GenerateSyntheticChains.
+	SyntheticChainIDStart = uint64(7_800_000_000)
+	// SyntheticChainIDCount is the number of chain ids in the synthetic range, the most chains
+	// GenerateSyntheticChains can generate at once.
+	SyntheticChainIDCount = uint64(1 << 24)
+)
+
+// SyntheticChain is a fake chain generated by GenerateSyntheticChains.
+type SyntheticChain struct {
+	Chain    Chain
+	Metadata ChainMetadata
+}
+
+// Spec returns the spec registering the chain and its metadata with RegisterCustomChains.
+func (s SyntheticChain) Spec() CustomChainSpec {
+	metadata := s.Metadata.clone()
+	return CustomChainSpec{ChainID: s.Chain.EvmChainID, Name: s.Chain.Name, Metadata: &metadata}
+}
+
+var (
+	syntheticAdjectives = []string{"amber", "brisk", "cobalt", "dusky", "ember", "frosty", "gilded", "hollow", "ivory", "jade", "keen", "lunar", "misty", "noble", "onyx", "plucky"}
+	syntheticNouns      = []string{"badger", "comet", "delta", "falcon", "glacier", "harbor", "lynx", "meadow", "nebula", "orchid", "pebble", "quarry", "raven", "summit", "tundra", "willow"}
+)
+
+// GenerateSyntheticChains generates n fake EVM chains for load testing systems at scales beyond
+// the number of real chains. The same seed always generates the same chains. Their chain ids
+// are distinct and taken from the synthetic range, their selectors are the ones generated for
+// custom chains and their names, e.g. "synthetic-testnet-cobalt-delta-18", and metadata are
+// derived from the chain id. Register them with RegisterCustomChains for GetChainMetadata to
+// return their metadata.
+func GenerateSyntheticChains(seed int64, n int) ([]SyntheticChain, error) {
+	if n < 0 || uint64(n) > SyntheticChainIDCount {
+		return nil, fmt.Errorf("can't generate %d synthetic chains, the synthetic range holds %d", n, SyntheticChainIDCount)
+	}
+
+	rng := rand.New(rand.NewSource(seed))
+	// swapped holds the displaced entries of a sparse Fisher-Yates shuffle of the range, so
+	// drawing distinct chain ids takes O(n) whatever the size of the range.
+	swapped := make(map[uint64]uint64, n)
+	offsetAt := func(i uint64) uint64 {
+		if offset, exists := swapped[i]; exists {
+			return offset
+		}
+		return i
+	}
+
+	chains := make([]SyntheticChain, n)
+	for i := range chains {
+		index := uint64(i)
+		pick := index + uint64(rng.Int63n(int64(SyntheticChainIDCount-index)))
+		offset := offsetAt(pick)
+		swapped[pick] = offsetAt(index)
+
+		chain := syntheticChain(SyntheticChainIDStart + offset)
+		if err := chain.Spec().validate(); err != nil {
+			return nil, fmt.Errorf("synthetic chain %d: %w", chain.Chain.EvmChainID, err)
+		}
+		chains[i] = chain
+	}
+	return chains, nil
+}
+
+// syntheticChain derives the chain with the given chain id from the synthetic range.
+func syntheticChain(chainID uint64) SyntheticChain {
+	offset := chainID - SyntheticChainIDStart
+	adjective := syntheticAdjectives[offset%uint64(len(syntheticAdjectives))]
+	noun := syntheticNouns[offset/uint64(len(syntheticAdjectives))%uint64(len(syntheticNouns))]
+	number := strconv.FormatUint(offset, 10)
+
+	name := "synthetic-testnet-" + adjective + "-" + noun + "-" + number
+	displayName := "Synthetic " + strings.ToUpper(adjective[:1]) + adjective[1:] + " " + strings.ToUpper(noun[:1]) + noun[1:] + " " + number
+	return SyntheticChain{
+		Chain: Chain{
+			EvmChainID: chainID,
+			Selector:   generateCustomChainSelector(chainID),
+			Name:       name,
+			VarName:    strings.ToUpper(strings.ReplaceAll(name, "-", "_")),
+		},
+		Metadata: ChainMetadata{
+			DisplayName: displayName,
+			NativeCurrency: NativeCurrency{
+				Name:     displayName + " Ether",
+				Symbol:   "S" + strings.ToUpper(adjective[:1]+noun[:1]) + number,
+				Decimals: 18,
+			},
+			RPCs: []string{"https://rpc." + name + ".invalid"},
+		},
+	}
+}
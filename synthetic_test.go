@@ -0,0 +1,69 @@
+package chain_selectors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateSyntheticChains(t *testing.T) {
+	chains, err := GenerateSyntheticChains(42, 1000)
+	require.NoError(t, err)
+	require.Len(t, chains, 1000)
+
+	again, err := GenerateSyntheticChains(42, 1000)
+	require.NoError(t, err)
+	assert.Equal(t, chains, again, "the same seed generates the same chains")
+
+	other, err := GenerateSyntheticChains(43, 1000)
+	require.NoError(t, err)
+	assert.NotEqual(t, chains, other)
+
+	chainIDs := make(map[uint64]struct{}, len(chains))
+	names := make(map[string]struct{}, len(chains))
+	for _, ch := range chains {
+		chainIDs[ch.Chain.EvmChainID] = struct{}{}
+		names[ch.Chain.Name] = struct{}{}
+
+		assert.Equal(t, RangeKindSynthetic, RangeFor(ch.Chain.Selector).Kind, ch.Chain.Name)
+		chainID, err := extractChainIdFromCustomSelector(ch.Chain.Selector)
+		require.NoError(t, err)
+		assert.Equal(t, ch.Chain.EvmChainID, chainID)
+
+		environment := environmentFromName(ch.Chain.Name)
+		assert.Equal(t, EnvironmentTestnet, environment, ch.Chain.Name)
+		assert.NotEmpty(t, ch.Metadata.DisplayName)
+		assert.NotEmpty(t, ch.Metadata.NativeCurrency.Symbol)
+	}
+	assert.Len(t, chainIDs, len(chains))
+	assert.Len(t, names, len(chains))
+
+	_, err = GenerateSyntheticChains(1, int(SyntheticChainIDCount)+1)
+	require.Error(t, err)
+	_, err = GenerateSyntheticChains(1, -1)
+	require.Error(t, err)
+}
+
+func TestRegisterSyntheticChains(t *testing.T) {
+	chains, err := GenerateSyntheticChains(7, 3)
+	require.NoError(t, err)
+
+	specs := make([]CustomChainSpec, len(chains))
+	for i, ch := range chains {
+		specs[i] = ch.Spec()
+	}
+	selectors, err := RegisterCustomChains(specs)
+	require.NoError(t, err)
+
+	for i, ch := range chains {
+		assert.Equal(t, ch.Chain.Selector, selectors[i])
+
+		name, _ := customChains.name(ch.Chain.EvmChainID)
+		assert.Equal(t, ch.Chain.Name, name)
+
+		metadata, err := GetChainMetadata(ch.Chain.Selector)
+		require.NoError(t, err)
+		assert.Equal(t, ch.Metadata, metadata)
+	}
+}
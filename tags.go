@@ -0,0 +1,87 @@
+package chain_selectors
+
+import (
+	_ "embed"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+)
+
+//go:embed tags.yml
+var tagsYml []byte
+
+var tagFormat = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+var (
+	tagsBySelector = onceValue(func() map[uint64][]string { return parseTagsYml(tagsYml) })
+	selectorsByTag = onceValue(func() map[string][]uint64 { return loadSelectorsByTag(tagsBySelector()) })
+)
+
+func parseTagsYml(ymlFile []byte) map[uint64][]string {
+	type ymlData struct {
+		Tags map[uint64][]string `yaml:"tags"`
+	}
+
+	var data ymlData
+	err := yaml.Unmarshal(ymlFile, &data)
+	if err != nil {
+		panic(err)
+	}
+
+	validateTags(data.Tags)
+	for _, tags := range data.Tags {
+		sort.Strings(tags)
+	}
+	return data.Tags
+}
+
+func validateTags(data map[uint64][]string) {
+	for selector, tags := range data {
+		for _, tag := range tags {
+			if !tagFormat.MatchString(tag) {
+				panic(fmt.Errorf("invalid tag %q for selector %d", tag, selector))
+			}
+		}
+	}
+}
+
+func loadSelectorsByTag(tags map[uint64][]string) map[string][]uint64 {
+	output := make(map[string][]uint64)
+	for selector, chainTags := range tags {
+		for _, tag := range chainTags {
+			output[tag] = append(output[tag], selector)
+		}
+	}
+	for _, selectors := range output {
+		sort.Slice(selectors, func(i, j int) bool { return selectors[i] < selectors[j] })
+	}
+	return output
+}
+
+// ChainsByTag returns the sorted selectors of all chains carrying the tag.
+func ChainsByTag(tag string) []uint64 {
+	selectors := selectorsByTag()[tag]
+	output := make([]uint64, len(selectors))
+	copy(output, selectors)
+	return output
+}
+
+// TagsOf returns the sorted tags of the chain identified by the selector.
+func TagsOf(selector uint64) []string {
+	tags := tagsBySelector()[selector]
+	output := make([]string, len(tags))
+	copy(output, tags)
+	return output
+}
+
+// AllTags returns every tag used in the dataset, sorted.
+func AllTags() []string {
+	tags := make([]string, 0, len(selectorsByTag()))
+	for tag := range selectorsByTag() {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+	return tags
+}
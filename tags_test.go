@@ -0,0 +1,45 @@
+package chain_selectors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaggedSelectorsAreKnownChains(t *testing.T) {
+	for selector := range tagsBySelector() {
+		_, err := getChainInfo(selector)
+		assert.NoError(t, err, "tags.yml references unknown selector %d", selector)
+	}
+}
+
+func Test_ChainsByTag(t *testing.T) {
+	zk := ChainsByTag("zk")
+	assert.Contains(t, zk, ETHEREUM_MAINNET_ZKSYNC_1.Selector)
+	assert.NotContains(t, zk, ETHEREUM_MAINNET_ARBITRUM_1.Selector)
+
+	assert.Empty(t, ChainsByTag("non-existing"))
+}
+
+func Test_TagsOf(t *testing.T) {
+	assert.Equal(t, []string{"op-stack", "optimistic", "rollup"}, TagsOf(ETHEREUM_MAINNET_OPTIMISM_1.Selector))
+	assert.Empty(t, TagsOf(ETHEREUM_MAINNET.Selector))
+
+	tags := TagsOf(ETHEREUM_MAINNET_OPTIMISM_1.Selector)
+	tags[0] = "modified"
+	assert.Equal(t, "op-stack", TagsOf(ETHEREUM_MAINNET_OPTIMISM_1.Selector)[0])
+}
+
+func Test_ParseTagsYmlRejectsInvalidTags(t *testing.T) {
+	require.Panics(t, func() {
+		parseTagsYml([]byte("tags:\n  1: [ \"Not A Tag\" ]\n"))
+	})
+}
+
+func Test_AllTags(t *testing.T) {
+	tags := AllTags()
+	assert.Contains(t, tags, "zk")
+	assert.Contains(t, tags, "optimistic")
+	assert.IsIncreasing(t, tags)
+}
@@ -0,0 +1,102 @@
+package chain_selectors
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// CustomSelectorEventKind tells whether a custom selector was generated or resolved.
+type CustomSelectorEventKind string
+
+const (
+	// CustomSelectorGenerated is emitted when a selector is generated for a custom chain id,
+	// e.g. by GetCustomChainSelector or RegisterCustomChain.
+	CustomSelectorGenerated CustomSelectorEventKind = "generated"
+	// CustomSelectorResolved is emitted when a custom selector is resolved back to its chain,
+	// e.g. by GetChainIDFromSelector or ChainBySelector.
+	CustomSelectorResolved CustomSelectorEventKind = "resolved"
+)
+
+// CustomSelectorEvent reports the use of a custom chain by the process.
+type CustomSelectorEvent struct {
+	Kind     CustomSelectorEventKind
+	Selector uint64
+	ChainID  uint64
+	// Name is the name the chain was registered under, or the generated one.
+	Name string
+	// Labels are the labels set with SetTelemetryLabels, shared by every event and read-only.
+	Labels map[string]string
+}
+
+type customSelectorHook struct {
+	id int
+	fn func(CustomSelectorEvent)
+}
+
+// telemetry holds the custom selector hooks. Hooks and labels are replaced rather than
+// modified so emitting an event only loads them.
+var telemetry struct {
+	mu     sync.Mutex
+	nextID int
+	hooks  atomic.Pointer[[]customSelectorHook]
+	labels atomic.Pointer[map[string]string]
+}
+
+// OnCustomSelector registers fn to be called whenever the process generates or resolves a
+// custom selector, so platform teams can inventory the private chains in use across services.
+// fn is called synchronously by the goroutine doing the lookup, possibly concurrently, and
+// must be fast. The returned function unregisters fn.
+func OnCustomSelector(fn func(CustomSelectorEvent)) (cancel func()) {
+	telemetry.mu.Lock()
+	defer telemetry.mu.Unlock()
+	telemetry.nextID++
+	id := telemetry.nextID
+	hooks := append(currentCustomSelectorHooks(), customSelectorHook{id: id, fn: fn})
+	telemetry.hooks.Store(&hooks)
+
+	return func() {
+		telemetry.mu.Lock()
+		defer telemetry.mu.Unlock()
+		var remaining []customSelectorHook
+		for _, hook := range currentCustomSelectorHooks() {
+			if hook.id != id {
+				remaining = append(remaining, hook)
+			}
+		}
+		telemetry.hooks.Store(&remaining)
+	}
+}
+
+// currentCustomSelectorHooks returns a copy of the registered hooks.
+func currentCustomSelectorHooks() []customSelectorHook {
+	if hooks := telemetry.hooks.Load(); hooks != nil {
+		return append([]customSelectorHook(nil), *hooks...)
+	}
+	return nil
+}
+
+// SetTelemetryLabels sets the labels attached to every CustomSelectorEvent, e.g. the name and
+// environment of the service. The map is copied.
+func SetTelemetryLabels(labels map[string]string) {
+	copied := make(map[string]string, len(labels))
+	for key, value := range labels {
+		copied[key] = value
+	}
+	telemetry.labels.Store(&copied)
+}
+
+// emitCustomSelector calls the registered hooks, it costs a single atomic load without hooks.
+func emitCustomSelector(kind CustomSelectorEventKind, chainID, selector uint64) {
+	hooks := telemetry.hooks.Load()
+	if hooks == nil || len(*hooks) == 0 {
+		return
+	}
+
+	event := CustomSelectorEvent{Kind: kind, Selector: selector, ChainID: chainID, Name: customChainName(chainID)}
+	if labels := telemetry.labels.Load(); labels != nil {
+		event.Labels = *labels
+	}
+	for _, hook := range *hooks {
+		hook.fn(event)
+	}
+}
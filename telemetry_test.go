@@ -0,0 +1,43 @@
+package chain_selectors
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOnCustomSelector(t *testing.T) {
+	var mu sync.Mutex
+	var events []CustomSelectorEvent
+	cancel := OnCustomSelector(func(event CustomSelectorEvent) {
+		mu.Lock()
+		defer mu.Unlock()
+		events = append(events, event)
+	})
+	SetTelemetryLabels(map[string]string{"service": "router"})
+	t.Cleanup(func() { SetTelemetryLabels(nil) })
+
+	const chainID = 9388205
+	selector, err := GetCustomChainSelector(chainID)
+	require.NoError(t, err)
+	_, err = GetChainIDFromSelector(selector)
+	require.NoError(t, err)
+	_, err = selectorFromChainId(ETHEREUM_MAINNET.EvmChainID)
+	require.NoError(t, err)
+
+	mu.Lock()
+	assert.Equal(t, []CustomSelectorEvent{
+		{Kind: CustomSelectorGenerated, Selector: selector, ChainID: chainID, Name: "custom-testnet-9388205", Labels: map[string]string{"service": "router"}},
+		{Kind: CustomSelectorResolved, Selector: selector, ChainID: chainID, Name: "custom-testnet-9388205", Labels: map[string]string{"service": "router"}},
+	}, events, "official chains emit no event")
+	mu.Unlock()
+
+	cancel()
+	_, err = GetChainIDFromSelector(selector)
+	require.NoError(t, err)
+	mu.Lock()
+	assert.Len(t, events, 2)
+	mu.Unlock()
+}
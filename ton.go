@@ -1,28 +1,28 @@
 package chain_selectors
 
 import (
-	_ "embed"
 	"fmt"
 
 	"gopkg.in/yaml.v3"
+
+	"github.com/fravlaca/chain-selectors/ton"
 )
 
 //go:generate go run genchains_ton.go
 
-//go:embed selectors_ton.yml
-var tonSelectorsYml []byte
+var tonSelectorsYml = ton.SelectorsYAML()
 
 var (
-	tonSelectorsMap      = parseTonYml(tonSelectorsYml)
-	tonChainIdBySelector = make(map[uint64]int32)
+	tonSelectorsMap      = onceValue(func() map[int32]ChainDetails { return parseTonYml(tonSelectorsYml) })
+	tonChainIdBySelector = onceValue(func() map[uint64]int32 {
+		output := make(map[uint64]int32, len(tonSelectorsMap()))
+		for k, v := range tonSelectorsMap() {
+			output[v.ChainSelector] = k
+		}
+		return output
+	})
 )
 
-func init() {
-	for k, v := range tonSelectorsMap {
-		tonChainIdBySelector[v.ChainSelector] = k
-	}
-}
-
 func parseTonYml(ymlFile []byte) map[int32]ChainDetails {
 	type ymlData struct {
 		SelectorsByTonChainId map[int32]ChainDetails `yaml:"selectors"`
@@ -38,17 +38,17 @@ func parseTonYml(ymlFile []byte) map[int32]ChainDetails {
 }
 
 func TonChainIdToChainSelector() map[int32]uint64 {
-	copyMap := make(map[int32]uint64, len(tonSelectorsMap))
-	for k, v := range tonSelectorsMap {
+	copyMap := make(map[int32]uint64, len(tonSelectorsMap()))
+	for k, v := range tonSelectorsMap() {
 		copyMap[k] = v.ChainSelector
 	}
 	return copyMap
 }
 
 func TonNameFromChainId(chainId int32) (string, error) {
-	details, exist := tonSelectorsMap[chainId]
+	details, exist := tonSelectorsMap()[chainId]
 	if !exist {
-		return "", fmt.Errorf("chain name not found for chain %v", chainId)
+		return "", notFoundError(InputChainID, FamilyTon, fmt.Sprint(chainId))
 	}
 	if details.ChainName == "" {
 		return fmt.Sprint(chainId), nil
@@ -57,9 +57,9 @@ func TonNameFromChainId(chainId int32) (string, error) {
 }
 
 func TonChainIdFromSelector(selector uint64) (int32, error) {
-	chainId, exist := tonChainIdBySelector[selector]
+	chainId, exist := tonChainIdBySelector()[selector]
 	if !exist {
-		return 0, fmt.Errorf("chain id not found for selector %d", selector)
+		return 0, selectorNotFoundError(FamilyTon, selector)
 	}
 
 	return chainId, nil
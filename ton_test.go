@@ -47,7 +47,7 @@ func Test_TonYmlAreValid(t *testing.T) {
 }
 
 func Test_TonChainSelectors(t *testing.T) {
-	for selector, chainId := range tonChainIdBySelector {
+	for selector, chainId := range tonChainIdBySelector() {
 		family, err := GetSelectorFamily(selector)
 		require.NoError(t, err,
 			"selector %v should be returned as ton family, but received %v",
@@ -62,7 +62,7 @@ func Test_TonChainSelectors(t *testing.T) {
 }
 
 func Test_TonGetChainDetailsByChainIDAndFamily(t *testing.T) {
-	for k, v := range tonSelectorsMap {
+	for k, v := range tonSelectorsMap() {
 		details, err := GetChainDetailsByChainIDAndFamily(fmt.Sprint(k), FamilyTon)
 		assert.NoError(t, err)
 		assert.Equal(t, v, details)
@@ -70,7 +70,7 @@ func Test_TonGetChainDetailsByChainIDAndFamily(t *testing.T) {
 }
 
 func Test_TonGetChainIDByChainSelector(t *testing.T) {
-	for k, v := range tonSelectorsMap {
+	for k, v := range tonSelectorsMap() {
 		chainID, err := GetChainIDFromSelector(v.ChainSelector)
 		assert.NoError(t, err)
 		assert.Equal(t, chainID, fmt.Sprintf("%v", k))
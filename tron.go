@@ -1,28 +1,28 @@
 package chain_selectors
 
 import (
-	_ "embed"
 	"fmt"
 
 	"gopkg.in/yaml.v3"
+
+	"github.com/fravlaca/chain-selectors/tron"
 )
 
 //go:generate go run genchains_tron.go
 
-//go:embed selectors_tron.yml
-var tronSelectorsYml []byte
+var tronSelectorsYml = tron.SelectorsYAML()
 
 var (
-	tronSelectorsMap      = parseTronYml(tronSelectorsYml)
-	tronChainIdBySelector = make(map[uint64]uint64)
+	tronSelectorsMap      = onceValue(func() map[uint64]ChainDetails { return parseTronYml(tronSelectorsYml) })
+	tronChainIdBySelector = onceValue(func() map[uint64]uint64 {
+		output := make(map[uint64]uint64, len(tronSelectorsMap()))
+		for k, v := range tronSelectorsMap() {
+			output[v.ChainSelector] = k
+		}
+		return output
+	})
 )
 
-func init() {
-	for k, v := range tronSelectorsMap {
-		tronChainIdBySelector[v.ChainSelector] = k
-	}
-}
-
 func parseTronYml(ymlFile []byte) map[uint64]ChainDetails {
 	type ymlData struct {
 		SelectorsByTronChainId map[uint64]ChainDetails `yaml:"selectors"`
@@ -38,17 +38,17 @@ func parseTronYml(ymlFile []byte) map[uint64]ChainDetails {
 }
 
 func TronChainIdToChainSelector() map[uint64]uint64 {
-	copyMap := make(map[uint64]uint64, len(tronSelectorsMap))
-	for k, v := range tronSelectorsMap {
+	copyMap := make(map[uint64]uint64, len(tronSelectorsMap()))
+	for k, v := range tronSelectorsMap() {
 		copyMap[k] = v.ChainSelector
 	}
 	return copyMap
 }
 
 func TronNameFromChainId(chainId uint64) (string, error) {
-	details, exist := tronSelectorsMap[chainId]
+	details, exist := tronSelectorsMap()[chainId]
 	if !exist {
-		return "", fmt.Errorf("chain name not found for chain %v", chainId)
+		return "", notFoundError(InputChainID, FamilyTron, fmt.Sprint(chainId))
 	}
 	if details.ChainName == "" {
 		return fmt.Sprint(chainId), nil
@@ -57,9 +57,9 @@ func TronNameFromChainId(chainId uint64) (string, error) {
 }
 
 func TronChainIdFromSelector(selector uint64) (uint64, error) {
-	chainId, exist := tronChainIdBySelector[selector]
+	chainId, exist := tronChainIdBySelector()[selector]
 	if !exist {
-		return 0, fmt.Errorf("chain id not found for selector %d", selector)
+		return 0, selectorNotFoundError(FamilyTron, selector)
 	}
 
 	return chainId, nil
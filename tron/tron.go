@@ -0,0 +1,57 @@
+// Package tron holds the dataset of the Tron chains, selectors_tron.yml, for programs
+// resolving Tron chains only: importing it does not embed the datasets of the other families,
+// which the root chain_selectors package aggregates.
+package tron
+
+import (
+	_ "embed"
+
+	"github.com/fravlaca/chain-selectors/internal/familydata"
+)
+
+//go:embed selectors_tron.yml
+var selectorsYml []byte
+
+var index = familydata.Lazy[uint64](selectorsYml, nil)
+
+// Chain is a Tron chain of the dataset.
+type Chain struct {
+	ChainID  uint64
+	Selector uint64
+	Name     string
+	// Test is always false, the Tron family has no test chains.
+	Test bool
+}
+
+// Chains returns the chains of the dataset sorted by chain id.
+func Chains() []Chain {
+	chains := index().Chains
+	output := make([]Chain, len(chains))
+	for i, ch := range chains {
+		output[i] = Chain(ch)
+	}
+	return output
+}
+
+// BySelector returns the chain identified by the selector.
+func BySelector(selector uint64) (Chain, bool) {
+	ch, exists := index().BySelector[selector]
+	return Chain(ch), exists
+}
+
+// ByChainID returns the chain with the chain id.
+func ByChainID(chainID uint64) (Chain, bool) {
+	ch, exists := index().ByChainID[chainID]
+	return Chain(ch), exists
+}
+
+// ByName returns the chain with the name.
+func ByName(name string) (Chain, bool) {
+	ch, exists := index().ByName[name]
+	return Chain(ch), exists
+}
+
+// SelectorsYAML returns the embedded selectors_tron.yml. The returned slice must not be modified.
+func SelectorsYAML() []byte {
+	return selectorsYml
+}
@@ -54,7 +54,7 @@ func Test_TronYmlAreValid(t *testing.T) {
 }
 
 func Test_TronChainSelectors(t *testing.T) {
-	for selector, chainId := range tronChainIdBySelector {
+	for selector, chainId := range tronChainIdBySelector() {
 		family, err := GetSelectorFamily(selector)
 		require.NoError(t, err,
 			"selector %v should be returned as tron family, but received %v",
@@ -69,7 +69,7 @@ func Test_TronChainSelectors(t *testing.T) {
 }
 
 func Test_TronGetChainDetailsByChainIDAndFamily(t *testing.T) {
-	for k, v := range tronSelectorsMap {
+	for k, v := range tronSelectorsMap() {
 		details, err := GetChainDetailsByChainIDAndFamily(fmt.Sprint(k), FamilyTron)
 		assert.NoError(t, err)
 		assert.Equal(t, v, details)
@@ -77,7 +77,7 @@ func Test_TronGetChainDetailsByChainIDAndFamily(t *testing.T) {
 }
 
 func Test_TronGetChainIDByChainSelector(t *testing.T) {
-	for k, v := range tronSelectorsMap {
+	for k, v := range tronSelectorsMap() {
 		chainID, err := GetChainIDFromSelector(v.ChainSelector)
 		assert.NoError(t, err)
 		assert.Equal(t, chainID, fmt.Sprintf("%v", k))
@@ -0,0 +1,192 @@
+package chain_selectors
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ErrTxDone is returned by the methods of a RegistryTx committed or rolled back already.
+var ErrTxDone = errors.New("registry transaction has already been committed or rolled back")
+
+// RegistryTx stages loads and custom chain registrations that are applied together by Commit
+// or not at all. Until then lookups, on the registry and package level, do not observe them.
+// A RegistryTx is safe for concurrent use.
+type RegistryTx struct {
+	registry *Registry
+
+	mu    sync.Mutex
+	done  bool
+	files []stagedFile
+	// state holds the staged chains on top of the registry at Begin, to fail steps early
+	state *registryState
+	specs []CustomChainSpec
+	// registered holds the custom chains registered at Begin with the staged specs
+	registered map[uint64]string
+}
+
+type stagedFile struct {
+	chains     map[uint64]ChainDetails
+	provenance Provenance
+}
+
+// Begin starts a transaction on the registry, e.g. to load every override file of a directory
+// without leaving the registry half merged if one of them is invalid:
+//
+//	tx := registry.Begin()
+//	defer tx.Rollback()
+//	for _, file := range files {
+//		if err := tx.LoadYAML(file); err != nil {
+//			return err
+//		}
+//	}
+//	return tx.Commit()
+func (r *Registry) Begin() *RegistryTx {
+	return &RegistryTx{
+		registry:   r,
+		state:      r.loadState(),
+		registered: *customChains.names.Load(),
+	}
+}
+
+// LoadYAML stages the load of the file like Registry.LoadYAML. It fails, staging nothing, if
+// the file is invalid or conflicts with the registry or the steps staged before.
+func (tx *RegistryTx) LoadYAML(ymlFile []byte) error {
+	chains, err := decodeSelectorsYml(ymlFile)
+	if err != nil {
+		return fmt.Errorf("failed to decode selectors: %w", err)
+	}
+
+	tx.mu.Lock()
+	defer tx.mu.Unlock()
+	if tx.done {
+		return ErrTxDone
+	}
+	provenance := Provenance{Source: ProvenanceOverride, DatasetVersion: datasetVersion(ymlFile)}
+	next, err := tx.state.withEVMChains(chains, provenance)
+	if err != nil {
+		return err
+	}
+	tx.state = next
+	tx.files = append(tx.files, stagedFile{chains: chains, provenance: provenance})
+	return nil
+}
+
+// RegisterCustomChains stages the registration of the custom chains like RegisterCustomChains.
+// It fails, staging nothing, if a spec is invalid or conflicts with the registered custom
+// chains or the specs staged before.
+func (tx *RegistryTx) RegisterCustomChains(specs []CustomChainSpec) error {
+	tx.mu.Lock()
+	defer tx.mu.Unlock()
+	if tx.done {
+		return ErrTxDone
+	}
+	if err := validateCustomChainSpecs(tx.registered, specs); err != nil {
+		return err
+	}
+
+	registered := make(map[uint64]string, len(tx.registered)+len(specs))
+	for chainID, name := range tx.registered {
+		registered[chainID] = name
+	}
+	for _, spec := range specs {
+		registered[spec.ChainID] = spec.Name
+		tx.specs = append(tx.specs, spec)
+	}
+	tx.registered = registered
+	return nil
+}
+
+// Commit applies the staged steps, in order, on top of the current registry, which may have
+// changed since Begin. If any step fails now, nothing is applied and the error is returned;
+// the transaction is done either way. Listeners registered with OnChange are notified once,
+// with the changes of all steps.
+//
+// The custom chains are registered just before the loaded chains become visible, a lookup
+// running concurrently with Commit may observe the former without the latter.
+func (tx *RegistryTx) Commit() error {
+	tx.mu.Lock()
+	defer tx.mu.Unlock()
+	if tx.done {
+		return ErrTxDone
+	}
+	tx.done = true
+
+	r := tx.registry
+	r.mu.Lock()
+	first := r.loadState()
+	states := []*registryState{first}
+	now := time.Now()
+	for i := range tx.files {
+		tx.files[i].provenance.LoadedAt = now
+		next, err := states[i].withEVMChains(tx.files[i].chains, tx.files[i].provenance)
+		if err != nil {
+			r.mu.Unlock()
+			return fmt.Errorf("step %d no longer applies: %w", i, err)
+		}
+		states = append(states, next)
+	}
+
+	specs := dedupeCustomChainSpecs(tx.specs)
+	var added map[uint64]bool
+	if len(specs) > 0 {
+		var err error
+		if added, err = customChains.registerAll(specs); err != nil {
+			r.mu.Unlock()
+			return fmt.Errorf("custom chains no longer apply: %w", err)
+		}
+	}
+
+	last := states[len(states)-1]
+	r.publish(last)
+	listeners := r.listeners
+	var auditErr error
+	for i := range tx.files {
+		if err := r.auditLoad(states[i], states[i+1], ProvenanceOverride, tx.files[i].provenance); err != nil {
+			auditErr = err
+			break
+		}
+	}
+	r.mu.Unlock()
+
+	r.notify(listeners, diffStates(first, last, ProvenanceOverride))
+	if len(specs) > 0 {
+		if _, err := customChainsRegistered(specs, added); err != nil && auditErr == nil {
+			auditErr = err
+		}
+	}
+	return auditErr
+}
+
+// Rollback discards the staged steps. It returns ErrTxDone if the transaction was committed or
+// rolled back already, so it can be deferred right after Begin.
+func (tx *RegistryTx) Rollback() error {
+	tx.mu.Lock()
+	defer tx.mu.Unlock()
+	if tx.done {
+		return ErrTxDone
+	}
+	tx.done = true
+	tx.files, tx.specs, tx.state, tx.registered = nil, nil, nil, nil
+	return nil
+}
+
+// dedupeCustomChainSpecs merges the specs staged for the same chain id, steps may register a
+// chain again under the same name but registerAll rejects duplicates within a batch. Like
+// successive registrations, the last metadata given wins.
+func dedupeCustomChainSpecs(specs []CustomChainSpec) []CustomChainSpec {
+	index := make(map[uint64]int, len(specs))
+	var deduped []CustomChainSpec
+	for _, spec := range specs {
+		if i, exists := index[spec.ChainID]; exists {
+			if spec.Metadata != nil {
+				deduped[i].Metadata = spec.Metadata
+			}
+			continue
+		}
+		index[spec.ChainID] = len(deduped)
+		deduped = append(deduped, spec)
+	}
+	return deduped
+}
@@ -0,0 +1,72 @@
+package chain_selectors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistryTxCommit(t *testing.T) {
+	restoreCustomChains(t)
+	registry := NewRegistry()
+	var changes []ChangeSet
+	registry.OnChange(func(c ChangeSet) { changes = append(changes, c) })
+
+	tx := registry.Begin()
+	require.NoError(t, tx.LoadYAML([]byte("selectors:\n  77001:\n    selector: 11\n    name: devnet-a\n")))
+	require.NoError(t, tx.LoadYAML([]byte("selectors:\n  77002:\n    selector: 12\n    name: devnet-b\n")))
+	require.NoError(t, tx.RegisterCustomChains([]CustomChainSpec{{ChainID: 5500000501, Name: "tx-devnet"}}))
+	require.NoError(t, tx.RegisterCustomChains([]CustomChainSpec{{ChainID: 5500000501, Name: "tx-devnet"}}))
+
+	_, exists := registry.ChainBySelector(11)
+	assert.False(t, exists, "staged chains are not visible before Commit")
+	_, registered := customChains.name(5500000501)
+	assert.False(t, registered)
+
+	require.NoError(t, tx.Commit())
+	_, exists = registry.ChainBySelector(11)
+	assert.True(t, exists)
+	_, exists = registry.ChainBySelector(12)
+	assert.True(t, exists)
+	name, _ := customChains.name(5500000501)
+	assert.Equal(t, "tx-devnet", name)
+
+	require.Len(t, changes, 1, "listeners are notified once per transaction")
+	assert.Len(t, changes[0].Added, 2)
+
+	require.ErrorIs(t, tx.Commit(), ErrTxDone)
+	require.ErrorIs(t, tx.LoadYAML([]byte("selectors: {}\n")), ErrTxDone)
+}
+
+func TestRegistryTxRollback(t *testing.T) {
+	registry := NewRegistry()
+
+	tx := registry.Begin()
+	require.NoError(t, tx.LoadYAML([]byte("selectors:\n  77001:\n    selector: 11\n    name: devnet-a\n")))
+	require.Error(t, tx.LoadYAML([]byte("selectors:\n  77002:\n    selector: 11\n    name: devnet-b\n")), "steps conflicting with staged ones fail")
+	require.Error(t, tx.RegisterCustomChains([]CustomChainSpec{{ChainID: 1, Name: "tx-official"}}))
+	require.NoError(t, tx.Rollback())
+	require.ErrorIs(t, tx.Commit(), ErrTxDone)
+
+	_, exists := registry.ChainBySelector(11)
+	assert.False(t, exists)
+}
+
+func TestRegistryTxCommitFailsAtomically(t *testing.T) {
+	registry := NewRegistry()
+
+	tx := registry.Begin()
+	require.NoError(t, tx.LoadYAML([]byte("selectors:\n  77001:\n    selector: 11\n    name: devnet-a\n")))
+	require.NoError(t, tx.RegisterCustomChains([]CustomChainSpec{{ChainID: 5500000601, Name: "tx-conflicting"}}))
+
+	// a load since Begin takes the selector of the staged chain
+	require.NoError(t, registry.LoadYAML([]byte("selectors:\n  77009:\n    selector: 11\n    name: devnet-z\n")))
+
+	require.Error(t, tx.Commit())
+	ch, exists := registry.ChainBySelector(11)
+	require.True(t, exists)
+	assert.Equal(t, uint64(77009), ch.EvmChainID)
+	_, registered := customChains.name(5500000601)
+	assert.False(t, registered, "custom chains are not registered if a load fails")
+}
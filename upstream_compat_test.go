@@ -0,0 +1,59 @@
+//go:build !chainsel_strict_api
+
+package chain_selectors
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/fravlaca/chain-selectors/internal/apicompat"
+)
+
+func TestUpstreamAPIIsProvided(t *testing.T) {
+	f, err := os.Open("upstream_api.txt")
+	require.NoError(t, err)
+	defer f.Close()
+	pinned, err := apicompat.Read(f)
+	require.NoError(t, err)
+	require.NotEmpty(t, pinned)
+
+	current, err := apicompat.Extract(".")
+	require.NoError(t, err)
+	assert.Empty(t, apicompat.Missing(pinned, current), "upstream symbols missing or changed, see go run ./cmd/apicompat")
+}
+
+// TestUpstreamBehaviourForOfficialChains checks the upstream lookups resolve official chains
+// exactly as upstream does, the custom chain extensions only apply to unknown chains.
+func TestUpstreamBehaviourForOfficialChains(t *testing.T) {
+	for chainID, details := range evmChainIdToChainSelector() {
+		selector, err := SelectorFromChainId(chainID)
+		require.NoError(t, err)
+		assert.Equal(t, details.ChainSelector, selector)
+
+		id, err := ChainIdFromSelector(selector)
+		require.NoError(t, err)
+		assert.Equal(t, chainID, id)
+
+		family, err := GetSelectorFamily(selector)
+		require.NoError(t, err)
+		assert.Equal(t, FamilyEVM, family)
+
+		name, err := NameFromChainId(chainID)
+		require.NoError(t, err)
+		if details.ChainName != "" {
+			assert.Equal(t, details.ChainName, name)
+			id, err = ChainIdFromName(name)
+			require.NoError(t, err)
+			assert.Equal(t, chainID, id)
+		}
+	}
+
+	for chainID, details := range solanaChainIdToChainSelector() {
+		id, err := SolanaChainIdFromSelector(details.ChainSelector)
+		require.NoError(t, err)
+		assert.Equal(t, chainID, id)
+	}
+}